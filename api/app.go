@@ -1,14 +1,20 @@
 package api
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"regexp"
 	"slices"
 	"time"
 
 	"github.com/dezswap/dezswap-api/api/docs"
+	"github.com/dezswap/dezswap-api/api/grpcserver"
 	"github.com/dezswap/dezswap-api/api/mcpserver"
+	"github.com/dezswap/dezswap-api/api/metrics"
+	"github.com/dezswap/dezswap-api/api/ratelimit"
+	"github.com/dezswap/dezswap-api/api/tracing"
 	v1 "github.com/dezswap/dezswap-api/api/v1"
 	"github.com/dezswap/dezswap-api/pkg"
 
@@ -19,17 +25,23 @@ import (
 
 	"github.com/dezswap/dezswap-api/configs"
 	"github.com/dezswap/dezswap-api/pkg/cache"
+	dbapi "github.com/dezswap/dezswap-api/pkg/db/api"
 	"github.com/dezswap/dezswap-api/pkg/logging"
 	"github.com/evalphobia/logrus_sentry"
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger" // gin-swagger middleware
+	"google.golang.org/grpc"
 	// swagger embed files
 )
 
 const ApiVersion = "v1"
 
+// shutdownTimeout bounds the requests and the streams left on shutdown
+const shutdownTimeout = 10 * time.Second
+
 var AppVersion = "dev"
 
 type app struct {
@@ -39,14 +51,24 @@ type app struct {
 	logger logging.Logger
 }
 
-func RunServer(c configs.Config, cache cache.Cache, db *gorm.DB) {
+// RunServer serves the API until ctx is done or a server fails, the servers are stopped together
+func RunServer(ctx context.Context, c configs.Config, cache cache.Cache, db *gorm.DB) {
 	serverConfig := c.Api.Server
 	networkMetadata, err := pkg.GetNetworkMetadata(serverConfig.ChainId)
 	if err != nil {
 		panic(err)
 	}
+	if c.Api.Router.Contract != "" {
+		networkMetadata, err = networkMetadata.WithRouterAddress(serverConfig.ChainId, c.Api.Router.Contract)
+		if err != nil {
+			panic(err)
+		}
+	}
 
 	logger := logging.New(c.Api.Server.Name, c.Log)
+	if _, err := networkMetadata.GetRouterAddress(serverConfig.ChainId); err != nil {
+		logger.Warnf("swap txs are not built without the router contract of %s, set api.router.contract: %v", serverConfig.ChainId, err)
+	}
 	app := app{
 		gin.Default(),
 		c.Api,
@@ -54,11 +76,55 @@ func RunServer(c configs.Config, cache cache.Cache, db *gorm.DB) {
 		logger,
 	}
 
+	if c.Api.Tracing.Enabled {
+		shutdown, err := tracing.Setup(c.Api.Tracing, serverConfig.Name, AppVersion)
+		if err != nil {
+			panic(err)
+		}
+		defer shutdown(context.Background()) //nolint:errcheck
+		if err := db.Use(tracing.NewGormPlugin()); err != nil {
+			panic(err)
+		}
+		// before the reporter, the reports carry the trace ids as well
+		logging.AddHookToLogger(app.logger, tracing.NewLogHook())
+	}
+
 	gin.SetMode(serverConfig.Mode)
-	app.setMiddlewares(cache)
+	app.setMiddlewares(cache, dbapi.NewApiKeyDbRepo(db))
+	if c.Api.Metrics.Enabled {
+		if err := db.Use(metrics.NewGormPlugin()); err != nil {
+			panic(err)
+		}
+		app.engine.GET(c.Api.Metrics.Path, gin.WrapH(metrics.Handler()))
+	}
 
 	v1Router := app.engine.Group(ApiVersion)
-	v1.RegisterRoutes(v1Router, serverConfig.ChainId, serverConfig.CoinGeckoApiKey, AppVersion, app.NetworkMetadata, db, cache, app.logger)
+	grpcClients := make([]pkg.GrpcClient, 0, len(c.Api.Nodes))
+	for _, node := range c.Api.Nodes {
+		client, err := pkg.NewGrpcClient(fmt.Sprintf("%s:%s", node.Host, node.Port), node.UseTls)
+		if err != nil {
+			panic(err)
+		}
+		grpcClients = append(grpcClients, client)
+	}
+	services := v1.RegisterRoutes(v1Router, serverConfig.ChainId, serverConfig.CoinGeckoApiKey, AppVersion, app.NetworkMetadata, db, cache, grpcClients, c.Api.Router, c.Api.Depth, c.Api.Stream, c.Api.Graphql, c.Api.Status, app.logger)
+
+	var grpcServer *grpc.Server
+	var grpcLis net.Listener
+	if c.Api.GrpcServer.Port != "" {
+		grpcLis, err = net.Listen("tcp", fmt.Sprintf(":%s", c.Api.GrpcServer.Port))
+		if err != nil {
+			panic(err)
+		}
+		// the calls are limited as the requests, in the buckets of the same keys and IPs
+		var opts []grpc.ServerOption
+		if c.Api.RateLimit.Enabled {
+			if opts, err = ratelimit.ServerOptions(c.Api.RateLimit, dbapi.NewApiKeyDbRepo(db), cache, app.logger); err != nil {
+				panic(err)
+			}
+		}
+		grpcServer = grpcserver.New(services, app.logger, opts...)
+	}
 
 	if c.Sentry.DSN != "" {
 		if err := app.configureReporter(c.Sentry.DSN, serverConfig.ChainId, map[string]string{
@@ -79,10 +145,11 @@ func RunServer(c configs.Config, cache cache.Cache, db *gorm.DB) {
 		panic(err)
 	}
 
-	app.run()
+	app.run(ctx, grpcServer, grpcLis)
 }
 
-func (app *app) run() {
+// run serves the HTTP server and the gRPC server when it is given, a failure of either stops the other
+func (app *app) run(ctx context.Context, grpcServer *grpc.Server, grpcLis net.Listener) {
 	type NotFound struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
@@ -91,12 +158,69 @@ func (app *app) run() {
 	app.engine.NoRoute(func(c *gin.Context) {
 		c.JSON(http.StatusNotFound, NotFound{Code: http.StatusNotFound, Message: "Not Found"})
 	})
-	if err := app.engine.Run(fmt.Sprintf(":%s", app.config.Server.Port)); err != nil {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	server := &http.Server{Addr: fmt.Sprintf(":%s", app.config.Server.Port), Handler: app.engine.Handler()}
+	var grpcErr error
+	grpcDone := make(chan struct{})
+	if grpcServer != nil {
+		go func() {
+			defer close(grpcDone)
+			if grpcErr = grpcServer.Serve(grpcLis); grpcErr != nil {
+				app.logger.Error(errors.Wrap(grpcErr, "grpc server"))
+			}
+			cancel()
+		}()
+	}
+	shutdown := make(chan struct{})
+	go func() {
+		defer close(shutdown)
+		<-ctx.Done()
+		shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancelShutdown()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			app.logger.Warn(errors.Wrap(err, "http server shutdown"))
+		}
+	}()
+
+	err := server.ListenAndServe()
+	cancel()
+	<-shutdown
+	if grpcServer != nil {
+		// the streams are cut once the timeout is over
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout):
+			grpcServer.Stop()
+		}
+		<-grpcDone
+	}
+	if !errors.Is(err, http.ErrServerClosed) {
 		panic(err)
 	}
+	if grpcErr != nil {
+		panic(grpcErr)
+	}
 }
 
-func (app *app) setMiddlewares(cache cache.Cache) {
+func (app *app) setMiddlewares(cache cache.Cache, keys ratelimit.KeyRepo) {
+	// gin trusts every proxy by default, anyone could set the client IP of the limits by X-Forwarded-For
+	if err := app.engine.SetTrustedProxies(app.config.Server.TrustedProxies); err != nil {
+		panic(err)
+	}
+	// the responses of the recovery and of the limits count as well
+	if app.config.Metrics.Enabled {
+		app.engine.Use(metrics.Middleware())
+	}
+	if app.config.Tracing.Enabled {
+		app.engine.Use(tracing.Middleware())
+	}
 	app.engine.Use(gin.CustomRecovery(func(c *gin.Context, err any) {
 		app.logger.Error(err)
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
@@ -118,39 +242,81 @@ func (app *app) setMiddlewares(cache cache.Cache) {
 		}
 		return false
 	}
-	conf.AllowMethods = []string{"GET", "OPTIONS"}
-	conf.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type"}
+	// POST carries the GraphQL queries
+	conf.AllowMethods = []string{"GET", "POST", "OPTIONS"}
+	// Last-Event-ID resumes the event stream on reconnect
+	conf.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Last-Event-ID", ratelimit.KeyHeader}
+	conf.ExposeHeaders = append(conf.ExposeHeaders, ratelimit.Headers...)
 	if app.config.MCP.Enabled {
-		// Allow MCP Streamable HTTP methods and protocol headers for browser preflight.
+		// Allow MCP Streamable HTTP protocol headers for browser preflight.
 		// See https://modelcontextprotocol.io/specification/2025-11-25/basic/transports#streamable-http
-		conf.AllowMethods = []string{"GET", "POST", "OPTIONS"}
-		conf.AllowHeaders = append(conf.AllowHeaders, "Accept", "MCP-Protocol-Version", "Mcp-Session-Id", "Last-Event-ID")
+		conf.AllowHeaders = append(conf.AllowHeaders, "Accept", "MCP-Protocol-Version", "Mcp-Session-Id")
 		// Expose Mcp-Session-Id so browser MCP clients can continue the session.
 		conf.ExposeHeaders = append(conf.ExposeHeaders, "Mcp-Session-Id")
 	}
 	app.engine.Use(cors.New(conf))
+	// cached responses count as well, the limits go before the cache
+	if app.config.RateLimit.Enabled {
+		if cache == nil {
+			panic("api.rate_limit needs the cache, configure api.cache or disable the rate limits")
+		}
+		if len(app.config.Server.TrustedProxies) == 0 {
+			app.logger.Warn("api.rate_limit is enabled without api.server.trusted_proxies, the clients behind a proxy share the bucket of its IP")
+		}
+		limits, err := ratelimit.Middleware(app.config.RateLimit, app.mcpPath(), keys, cache, app.logger)
+		if err != nil {
+			panic(err)
+		}
+		app.engine.Use(limits)
+	}
 	if cache != nil {
 		app.engine.Use(gin_cache.Cache(cache, time.Second*time.Duration(app.BlockSecond),
 			gin_cache.WithCacheStrategyByRequest(func(c *gin.Context) (bool, gin_cache.Strategy) {
-				mcpPath := app.config.MCP.Path
-				if mcpPath == "" {
-					mcpPath = mcpserver.DefaultPath
+				// the body of a POST, a GraphQL query for one, is not a part of the key
+				if c.Request.Method != http.MethodGet {
+					return false, gin_cache.Strategy{}
 				}
 				// MCP requests share one URL but vary by body and session headers, so skip response caching.
-				if app.config.MCP.Enabled && c.Request.URL.Path == mcpPath {
+				if c.Request.URL.Path == app.mcpPath() {
+					return false, gin_cache.Strategy{}
+				}
+				// the event stream never completes as a response
+				if c.Request.URL.Path == fmt.Sprintf("/%s%s", ApiVersion, v1.StreamPath) {
+					return false, gin_cache.Strategy{}
+				}
+				// the status is of the moment, a cached one hides the failures
+				switch c.Request.URL.Path {
+				case fmt.Sprintf("/%s%s", ApiVersion, v1.HealthPath), fmt.Sprintf("/%s%s", ApiVersion, v1.ReadyPath):
+					return false, gin_cache.Strategy{}
+				}
+				if app.config.Metrics.Enabled && c.Request.URL.Path == app.config.Metrics.Path {
 					return false, gin_cache.Strategy{}
 				}
 				return true, gin_cache.Strategy{
 					CacheKey: c.Request.Host + c.Request.RequestURI,
 				}
 			}),
-			gin_cache.WithDiscardHeaders(gin_cache.CorsHeaders()),
+			// the limits of the caller are set before the cache, a cached response must not carry the ones of its first caller
+			gin_cache.WithDiscardHeaders(append(gin_cache.CorsHeaders(), ratelimit.Headers...)),
+			gin_cache.WithOnHitCache(metrics.CacheHit),
+			gin_cache.WithOnMissCache(metrics.CacheMiss),
 		))
 	}
 	app.engine.UseRawPath = true
 	app.engine.UnescapePathValues = true
 }
 
+// mcpPath is the path of the MCP endpoint, empty when it is not mounted
+func (app *app) mcpPath() string {
+	if !app.config.MCP.Enabled {
+		return ""
+	}
+	if app.config.MCP.Path == "" {
+		return mcpserver.DefaultPath
+	}
+	return app.config.MCP.Path
+}
+
 func (app *app) configureReporter(dsn, env string, tags map[string]string) error {
 	hook, err := logrus_sentry.NewSentryHook(dsn, []logrus.Level{
 		logrus.WarnLevel,
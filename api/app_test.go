@@ -1,12 +1,17 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/dezswap/dezswap-api/api/ratelimit"
 	"github.com/dezswap/dezswap-api/configs"
+	"github.com/dezswap/dezswap-api/pkg"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/dezswap/dezswap-api/pkg/cache/memory"
 	"github.com/dezswap/dezswap-api/pkg/logging"
 	"github.com/gin-gonic/gin"
 )
@@ -25,7 +30,7 @@ func TestSetMiddlewares_AllowsMCPPreflightHeaders(t *testing.T) {
 		},
 		logger: logging.New("test", configs.LogConfig{}),
 	}
-	app.setMiddlewares(nil)
+	app.setMiddlewares(nil, nil)
 	app.engine.POST("/mcp", func(c *gin.Context) {
 		c.Status(http.StatusOK)
 	})
@@ -62,6 +67,129 @@ func TestSetMiddlewares_AllowsMCPPreflightHeaders(t *testing.T) {
 	}
 }
 
+func TestSetMiddlewares_RateLimitsByRemoteAddr(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		// spoofed is the status of a request with another X-Forwarded-For after the bucket of the proxy is empty
+		spoofed int
+	}{
+		{name: "no proxy is trusted by default", spoofed: http.StatusTooManyRequests},
+		{name: "the client ip of a trusted proxy", trustedProxies: []string{"10.0.0.0/8"}, spoofed: http.StatusOK},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			app := app{
+				engine: gin.New(),
+				config: configs.ApiConfig{
+					Server: configs.ApiServerConfig{TrustedProxies: tc.trustedProxies},
+					RateLimit: configs.RateLimitConfig{
+						Enabled: true,
+						Ip:      configs.RateLimitTier{Rate: 0.001, Burst: 1},
+					},
+				},
+				logger: logging.New("test", configs.LogConfig{}),
+			}
+			app.setMiddlewares(memory.NewMemoryCache(ctx, cache.NewByteCodec()), nil)
+			app.engine.POST("/v1/graphql", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			serve := func(forwardedFor string) int {
+				req := httptest.NewRequest(http.MethodPost, "/v1/graphql", nil)
+				req.RemoteAddr = "10.0.0.1:1234"
+				req.Header.Set("X-Forwarded-For", forwardedFor)
+				rec := httptest.NewRecorder()
+				app.engine.ServeHTTP(rec, req)
+				return rec.Code
+			}
+			if code := serve("1.1.1.1"); code != http.StatusOK {
+				t.Fatalf("expected the first request to pass, got %d", code)
+			}
+			if code := serve("2.2.2.2"); code != tc.spoofed {
+				t.Fatalf("expected %d with another X-Forwarded-For, got %d", tc.spoofed, code)
+			}
+		})
+	}
+}
+
+func TestSetMiddlewares_RateLimitWithoutCache(t *testing.T) {
+	app := app{
+		engine: gin.New(),
+		config: configs.ApiConfig{RateLimit: configs.RateLimitConfig{Enabled: true}},
+		logger: logging.New("test", configs.LogConfig{}),
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected the rate limits without the cache to fail")
+		}
+	}()
+	app.setMiddlewares(nil, nil)
+}
+
+func TestSetMiddlewares_CachedResponsesKeepTheLimitsOfTheCaller(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	app := app{
+		engine: gin.New(),
+		config: configs.ApiConfig{
+			RateLimit: configs.RateLimitConfig{
+				Enabled: true,
+				Ip:      configs.RateLimitTier{Rate: 0.001, Burst: 5},
+				Keys:    []configs.ApiKeyConfig{{Name: "bot", Key: "bot-key", RateLimitTier: configs.RateLimitTier{Rate: 0.001, Burst: 10}}},
+			},
+		},
+		NetworkMetadata: pkg.NetworkMetadata{BlockSecond: 60},
+		logger:          logging.New("test", configs.LogConfig{}),
+	}
+	app.setMiddlewares(memory.NewMemoryCache(ctx, cache.NewByteCodec()), nil)
+	handled := 0
+	app.engine.GET("/v1/pairs", func(c *gin.Context) {
+		handled++
+		c.String(http.StatusOK, "pairs")
+	})
+
+	serve := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/v1/pairs", nil)
+		req.RemoteAddr = "1.2.3.4:1234"
+		if key != "" {
+			req.Header.Set(ratelimit.KeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		app.engine.ServeHTTP(rec, req)
+		return rec
+	}
+	for _, tc := range []struct {
+		key       string
+		limit     string
+		remaining string
+	}{
+		{"", "5", "4"},
+		{"bot-key", "10", "9"},
+		{"", "5", "3"},
+	} {
+		rec := serve(tc.key)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected %d, got %d", http.StatusOK, rec.Code)
+		}
+		if got := rec.Header().Get("X-RateLimit-Limit"); got != tc.limit {
+			t.Fatalf("expected the limit %s of key(%s), got %s", tc.limit, tc.key, got)
+		}
+		if got := rec.Header().Get("X-RateLimit-Remaining"); got != tc.remaining {
+			t.Fatalf("expected the remaining %s of key(%s), got %s", tc.remaining, tc.key, got)
+		}
+	}
+	if handled != 1 {
+		t.Fatalf("expected the cached response to be served, handled %d times", handled)
+	}
+}
+
 func containsHeader(values []string, want string) bool {
 	for _, value := range values {
 		for part := range strings.SplitSeq(value, ",") {
@@ -15,9 +15,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/coingecko/pairs": {
+        "/adapters/defillama/tvl": {
             "get": {
-                "description": "get Pairs",
+                "description": "get the daily TVL series and the current TVL by the token in USD in the format of DefiLlama",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,23 +25,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "coingecko"
+                    "adapters"
                 ],
-                "summary": "All Pairs",
+                "summary": "DefiLlama TVL",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/coingecko.PairRes"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.BadRequestError"
+                            "$ref": "#/definitions/adapter.DefiLlamaTvlRes"
                         }
                     },
                     "500": {
@@ -53,9 +44,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/coingecko/pairs/{address}": {
+        "/adapters/defillama/volume": {
             "get": {
-                "description": "get Pair by Address",
+                "description": "get the daily volume series in USD in the format of DefiLlama",
                 "consumes": [
                     "application/json"
                 ],
@@ -63,29 +54,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "coingecko"
-                ],
-                "summary": "Get a pair",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Pair Address",
-                        "name": "address",
-                        "in": "path",
-                        "required": true
-                    }
+                    "adapters"
                 ],
+                "summary": "DefiLlama volume",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/coingecko.PairRes"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.BadRequestError"
+                            "$ref": "#/definitions/adapter.DefiLlamaVolumeRes"
                         }
                     },
                     "500": {
@@ -97,9 +73,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/coingecko/tickers": {
+        "/adapters/geckoterminal/tvl": {
             "get": {
-                "description": "get Tickers",
+                "description": "get the daily TVL series and the current TVL by the token in USD in the format of GeckoTerminal",
                 "consumes": [
                     "application/json"
                 ],
@@ -107,23 +83,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "coingecko"
+                    "adapters"
                 ],
-                "summary": "All Tickers",
+                "summary": "GeckoTerminal TVL",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/coingecko.TickerRes"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.BadRequestError"
+                            "$ref": "#/definitions/adapter.GeckoTerminalTvlRes"
                         }
                     },
                     "500": {
@@ -135,9 +102,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/coingecko/tickers/{id}": {
+        "/adapters/geckoterminal/volume": {
             "get": {
-                "description": "get Ticker by Id",
+                "description": "get the daily volume series in USD in the format of GeckoTerminal",
                 "consumes": [
                     "application/json"
                 ],
@@ -145,29 +112,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "coingecko"
-                ],
-                "summary": "Get a ticker",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Ticker ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "adapters"
                 ],
+                "summary": "GeckoTerminal volume",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/coingecko.TickerRes"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.BadRequestError"
+                            "$ref": "#/definitions/adapter.GeckoTerminalVolumeRes"
                         }
                     },
                     "500": {
@@ -179,9 +131,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/coinmarketcap/tickers": {
+        "/coingecko/historical_trades": {
             "get": {
-                "description": "get Tickers",
+                "description": "get the latest swaps of the ticker, the limit is capped at 1000 and 0 returns up to the cap",
                 "consumes": [
                     "application/json"
                 ],
@@ -189,14 +141,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "coinmarketcap"
+                    "coingecko"
+                ],
+                "summary": "Historical trades",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ticker ID",
+                        "name": "ticker_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "buy",
+                            "sell"
+                        ],
+                        "type": "string",
+                        "description": "Trade type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of trades",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Start time in milliseconds",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "End time in milliseconds",
+                        "name": "end_time",
+                        "in": "query"
+                    }
                 ],
-                "summary": "All Tickers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/coinmarketcap.TickersRes"
+                            "$ref": "#/definitions/coingecko.HistoricalTradesRes"
                         }
                     },
                     "400": {
@@ -214,9 +203,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/coinmarketcap/tickers/{id}": {
+        "/coingecko/orderbook": {
             "get": {
-                "description": "get Ticker by Id",
+                "description": "get the orderbook synthesized from the constant product curve of the pool at the configured levels",
                 "consumes": [
                     "application/json"
                 ],
@@ -224,23 +213,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "coinmarketcap"
+                    "coingecko"
                 ],
-                "summary": "Get a ticker",
+                "summary": "Orderbook",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Ticker ID",
-                        "name": "id",
-                        "in": "path",
+                        "name": "ticker_id",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of orders on both sides, half of it rounded up on each side, 0 returns every level",
+                        "name": "depth",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/coinmarketcap.TickerRes"
+                            "$ref": "#/definitions/coingecko.OrderbookRes"
                         }
                     },
                     "400": {
@@ -249,6 +244,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputil.BadRequestError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -258,9 +259,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/chart/pools/{address}/{type}": {
+        "/coingecko/pairs": {
             "get": {
-                "description": "get Charts data",
+                "description": "get Pairs",
                 "consumes": [
                     "application/json"
                 ],
@@ -268,49 +269,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dashboard"
-                ],
-                "summary": "Charts of Dezswap's Pool",
-                "parameters": [
-                    {
-                        "enum": [
-                            "year",
-                            "quarter",
-                            "month"
-                        ],
-                        "type": "string",
-                        "description": "default(empty) value is all",
-                        "name": "duration",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Pool Address",
-                        "name": "address",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "volume",
-                            "tvl",
-                            "apr",
-                            "fee"
-                        ],
-                        "type": "string",
-                        "description": "chart type",
-                        "name": "type",
-                        "in": "path",
-                        "required": true
-                    }
+                    "coingecko"
                 ],
+                "summary": "All Pairs",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dashboard.ChartItem"
+                                "$ref": "#/definitions/coingecko.PairRes"
                             }
                         }
                     },
@@ -329,9 +297,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/chart/tokens/{address}/{type}": {
+        "/coingecko/pairs/{address}": {
             "get": {
-                "description": "get Charts data",
+                "description": "get Pair by Address",
                 "consumes": [
                     "application/json"
                 ],
@@ -339,49 +307,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dashboard"
+                    "coingecko"
                 ],
-                "summary": "Charts of Dezswap's Pool related a given token",
+                "summary": "Get a pair",
                 "parameters": [
-                    {
-                        "enum": [
-                            "year",
-                            "quarter",
-                            "month"
-                        ],
-                        "type": "string",
-                        "description": "default(empty) value is all",
-                        "name": "duration",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "Token Address",
+                        "description": "Pair Address",
                         "name": "address",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "enum": [
-                            "volume",
-                            "tvl",
-                            "price"
-                        ],
-                        "type": "string",
-                        "description": "chart type",
-                        "name": "type",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dashboard.ChartItem"
-                            }
+                            "$ref": "#/definitions/coingecko.PairRes"
                         }
                     },
                     "400": {
@@ -399,9 +341,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/chart/{type}": {
+        "/coingecko/tickers": {
             "get": {
-                "description": "get Charts data",
+                "description": "get Tickers",
                 "consumes": [
                     "application/json"
                 ],
@@ -409,42 +351,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dashboard"
-                ],
-                "summary": "Charts of Dezswap's Pools",
-                "parameters": [
-                    {
-                        "enum": [
-                            "year",
-                            "quarter",
-                            "month"
-                        ],
-                        "type": "string",
-                        "description": "default(empty) value is all",
-                        "name": "duration",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "volume",
-                            "tvl",
-                            "apr",
-                            "fee"
-                        ],
-                        "type": "string",
-                        "description": "chart type",
-                        "name": "type",
-                        "in": "path",
-                        "required": true
-                    }
+                    "coingecko"
                 ],
+                "summary": "All Tickers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dashboard.ChartItem"
+                                "$ref": "#/definitions/coingecko.TickerRes"
                             }
                         }
                     },
@@ -463,9 +379,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/pools": {
+        "/coingecko/tickers/{id}": {
             "get": {
-                "description": "get Pools data of dezswap (address, tvl, volume, fee, apr)",
+                "description": "get Ticker by Id",
                 "consumes": [
                     "application/json"
                 ],
@@ -473,25 +389,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dashboard"
+                    "coingecko"
                 ],
-                "summary": "Dezswap's Pools",
+                "summary": "Get a ticker",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "token address",
-                        "name": "token",
-                        "in": "query"
+                        "description": "Ticker ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dashboard.PoolRes"
-                            }
+                            "$ref": "#/definitions/coingecko.TickerRes"
                         }
                     },
                     "400": {
@@ -509,9 +423,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/pools/{address}": {
+        "/coinmarketcap/assets": {
             "get": {
-                "description": "get Pool's detail information of dezswap",
+                "description": "get the assets of the market pairs keyed by the address",
                 "consumes": [
                     "application/json"
                 ],
@@ -519,35 +433,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dashboard"
-                ],
-                "summary": "Dezswap's Pool Detail",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Pool Address",
-                        "name": "address",
-                        "in": "path",
-                        "required": true
-                    }
+                    "coinmarketcap"
                 ],
+                "summary": "Assets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dashboard.PoolDetailRes"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.BadRequestError"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.NotFoundError"
+                            "$ref": "#/definitions/coinmarketcap.AssetsRes"
                         }
                     },
                     "500": {
@@ -559,9 +452,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/recent": {
+        "/coinmarketcap/summary": {
             "get": {
-                "description": "get Recent",
+                "description": "get the 24h summary of every market pair",
                 "consumes": [
                     "application/json"
                 ],
@@ -569,20 +462,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dashboard"
+                    "coinmarketcap"
                 ],
-                "summary": "Recent 24H data with it's change rate",
+                "summary": "Summary",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dashboard.RecentRes"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.BadRequestError"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/coinmarketcap.SummaryRes"
+                            }
                         }
                     },
                     "500": {
@@ -594,9 +484,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/statistics": {
+        "/coinmarketcap/tickers": {
             "get": {
-                "description": "get Statistic data of dezswap (address count, tx count, fee)",
+                "description": "get Tickers",
                 "consumes": [
                     "application/json"
                 ],
@@ -604,17 +494,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dashboard"
+                    "coinmarketcap"
                 ],
-                "summary": "Dezswap's statistics",
+                "summary": "All Tickers",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dashboard.StatisticResItem"
-                            }
+                            "$ref": "#/definitions/coinmarketcap.TickersRes"
                         }
                     },
                     "400": {
@@ -632,9 +519,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/tokens": {
+        "/coinmarketcap/tickers/{id}": {
             "get": {
-                "description": "get Tokens data of dezswap (address, price, priceChange, volume, tvl)",
+                "description": "get Ticker by Id",
                 "consumes": [
                     "application/json"
                 ],
@@ -642,17 +529,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dashboard"
+                    "coinmarketcap"
+                ],
+                "summary": "Get a ticker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ticker ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Dezswap's Tokens",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dashboard.TokenRes"
-                            }
+                            "$ref": "#/definitions/coinmarketcap.TickerRes"
                         }
                     },
                     "400": {
@@ -670,9 +563,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/tokens/{address}": {
+        "/coinmarketcap/trades/{market_pair}": {
             "get": {
-                "description": "get Token data of dezswap (address, price, price_change, volume_24h,  volume_24h_change, volume_7d, volume_7d_change, tvl)",
+                "description": "get the swaps of the market pair in 24h, the latest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -680,14 +573,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "dashboard"
+                    "coinmarketcap"
                 ],
-                "summary": "Dezswap's Token Stats",
+                "summary": "Trades",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "token address",
-                        "name": "address",
+                        "description": "Market pair in the form of base_quote",
+                        "name": "market_pair",
                         "in": "path",
                         "required": true
                     }
@@ -696,7 +589,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dashboard.TokenRes"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/coinmarketcap.TradeRes"
+                            }
                         }
                     },
                     "400": {
@@ -705,12 +601,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputil.BadRequestError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.NotFoundError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -720,9 +610,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/dashboard/txs": {
+        "/dashboard/chart/pools/{address}/{type}": {
             "get": {
-                "description": "get Transactions data of dezswap",
+                "description": "get Charts data",
                 "consumes": [
                     "application/json"
                 ],
@@ -732,30 +622,38 @@ const docTemplate = `{
                 "tags": [
                     "dashboard"
                 ],
-                "summary": "Dezswap's Transactions",
+                "summary": "Charts of Dezswap's Pool",
                 "parameters": [
                     {
+                        "enum": [
+                            "year",
+                            "quarter",
+                            "month"
+                        ],
                         "type": "string",
-                        "description": "Pool address",
-                        "name": "pool",
+                        "description": "default(empty) value is all",
+                        "name": "duration",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Token addresses",
-                        "name": "token",
-                        "in": "query"
+                        "description": "Pool Address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "enum": [
-                            "swap",
-                            "add",
-                            "remove"
+                            "volume",
+                            "tvl",
+                            "apr",
+                            "fee"
                         ],
                         "type": "string",
-                        "description": "Transaction type, empty value is for all types",
+                        "description": "chart type",
                         "name": "type",
-                        "in": "query"
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -764,7 +662,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dashboard.TxRes"
+                                "$ref": "#/definitions/dashboard.ChartItem"
                             }
                         }
                     },
@@ -783,29 +681,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/health": {
-            "get": {
-                "description": "Checks overall service and dependency health",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "status"
-                ],
-                "summary": "Health check",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/controller.HealthResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/notices": {
+        "/dashboard/chart/tokens/{address}/{type}": {
             "get": {
-                "description": "get Notices",
+                "description": "get Charts data",
                 "consumes": [
                     "application/json"
                 ],
@@ -813,39 +691,39 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notice"
+                    "dashboard"
                 ],
-                "summary": "Notices of the chain",
+                "summary": "Charts of Dezswap's Pool related a given token",
                 "parameters": [
                     {
+                        "enum": [
+                            "year",
+                            "quarter",
+                            "month"
+                        ],
                         "type": "string",
-                        "description": "target chain name e.g. (dimension, cube)",
-                        "name": "chain",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "the starting timestamp in Unix timestamp format e.g. 1696917605 (default: three months prior to the current time)",
-                        "name": "startTs",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "condition to get items after the id",
-                        "name": "after",
+                        "description": "default(empty) value is all",
+                        "name": "duration",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "the number of items to return (default: 10)",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Token Address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "order of items to return (default: descending order)",
-                        "name": "asc",
-                        "in": "query"
+                        "enum": [
+                            "volume",
+                            "tvl",
+                            "price"
+                        ],
+                        "type": "string",
+                        "description": "chart type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -854,7 +732,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/notice.noticeItem"
+                                "$ref": "#/definitions/dashboard.ChartItem"
                             }
                         }
                     },
@@ -873,21 +751,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/pairs": {
+        "/dashboard/chart/{type}": {
             "get": {
-                "description": "get Pairs",
+                "description": "get Charts data",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "All Pairs",
+                "tags": [
+                    "dashboard"
+                ],
+                "summary": "Charts of Dezswap's Pools",
+                "parameters": [
+                    {
+                        "enum": [
+                            "year",
+                            "quarter",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "default(empty) value is all",
+                        "name": "duration",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "volume",
+                            "tvl",
+                            "apr",
+                            "fee"
+                        ],
+                        "type": "string",
+                        "description": "chart type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.PairsRes"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dashboard.ChartItem"
+                            }
                         }
                     },
                     "400": {
@@ -905,30 +815,79 @@ const docTemplate = `{
                 }
             }
         },
-        "/pairs/{address}": {
+        "/dashboard/pools": {
             "get": {
-                "description": "get Pair by Address",
+                "description": "get Pools data of dezswap (address, tvl, volume, fee, apr)",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get a pair",
+                "tags": [
+                    "dashboard"
+                ],
+                "summary": "Dezswap's Pools",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pair Address",
-                        "name": "address",
-                        "in": "path",
-                        "required": true
+                        "description": "token address",
+                        "name": "token",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.PairRes"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dashboard.PoolRes"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/dashboard/pools/{address}": {
+            "get": {
+                "description": "get Pool's detail information of dezswap",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dashboard"
+                ],
+                "summary": "Dezswap's Pool Detail",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dashboard.PoolDetailRes"
                         }
                     },
                     "400": {
@@ -952,23 +911,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/pools": {
+        "/dashboard/recent": {
             "get": {
-                "description": "get Pools",
+                "description": "get Recent",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "All Pools",
+                "tags": [
+                    "dashboard"
+                ],
+                "summary": "Recent 24H data with it's change rate",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dashboard.RecentRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/dashboard/statistics": {
+            "get": {
+                "description": "get Statistic data of dezswap (address count, tx count, fee)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dashboard"
+                ],
+                "summary": "Dezswap's statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/controller.PoolRes"
+                                "$ref": "#/definitions/dashboard.StatisticResItem"
                             }
                         }
                     },
@@ -987,20 +984,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/pools/{address}": {
+        "/dashboard/tokens": {
             "get": {
-                "description": "get Pool by Address",
+                "description": "get Tokens data of dezswap (address, price, priceChange, volume, tvl)",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get a pool",
+                "tags": [
+                    "dashboard"
+                ],
+                "summary": "Dezswap's Tokens",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dashboard.TokenRes"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/dashboard/tokens/{address}": {
+            "get": {
+                "description": "get Token data of dezswap (address, price, price_change, volume_24h,  volume_24h_change, volume_7d, volume_7d_change, tvl)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dashboard"
+                ],
+                "summary": "Dezswap's Token Stats",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pool Address",
+                        "description": "token address",
                         "name": "address",
                         "in": "path",
                         "required": true
@@ -1010,7 +1048,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.PoolRes"
+                            "$ref": "#/definitions/dashboard.TokenRes"
                         }
                     },
                     "400": {
@@ -1034,9 +1072,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/routes": {
+        "/dashboard/txs": {
             "get": {
-                "description": "get routes based on the given token address",
+                "description": "get Transactions data of dezswap",
                 "consumes": [
                     "application/json"
                 ],
@@ -1044,26 +1082,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "router"
+                    "dashboard"
                 ],
-                "summary": "All Routes",
+                "summary": "Dezswap's Transactions",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Offer token address",
-                        "name": "from",
+                        "description": "Pool address",
+                        "name": "pool",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Ask token Address",
-                        "name": "to",
+                        "description": "Token addresses",
+                        "name": "token",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "description": "Number of hops between the starting token and the ending token",
-                        "name": "hopCount",
+                        "enum": [
+                            "swap",
+                            "add",
+                            "remove"
+                        ],
+                        "type": "string",
+                        "description": "Transaction type, empty value is for all types",
+                        "name": "type",
                         "in": "query"
                     }
                 ],
@@ -1073,7 +1116,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/router.RouteRes"
+                                "$ref": "#/definitions/dashboard.TxRes"
                             }
                         }
                     },
@@ -1092,27 +1135,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/stats": {
+        "/depth": {
             "get": {
-                "description": "get pair stats",
+                "description": "get the constant product depths of every pool at the configured percentages on the latest indexed reserves",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "All pair stats",
+                "tags": [
+                    "depth"
+                ],
+                "summary": "All depths",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.StatsRes"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.BadRequestError"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/depth.DepthRes"
+                            }
                         }
                     },
                     "500": {
@@ -1124,26 +1167,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/stats/{period}": {
+        "/depth/{address}": {
             "get": {
-                "description": "get a pair stat by period",
+                "description": "get the constant product depth of the pair at the configured percentages on the latest indexed reserves",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get a stat",
+                "tags": [
+                    "depth"
+                ],
+                "summary": "Get a depth",
                 "parameters": [
                     {
-                        "enum": [
-                            "24h",
-                            "7d",
-                            "1mon"
-                        ],
                         "type": "string",
-                        "description": "period 24h,7d,1mon",
-                        "name": "period",
+                        "description": "Pair Address",
+                        "name": "address",
                         "in": "path",
                         "required": true
                     }
@@ -1152,7 +1193,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.StatRes"
+                            "$ref": "#/definitions/depth.DepthRes"
                         }
                     },
                     "400": {
@@ -1176,24 +1217,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/tokens": {
+        "/dexscreener/asset": {
             "get": {
-                "description": "get Tokens",
+                "description": "get the asset by the token address",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "All Tokens",
+                "tags": [
+                    "dexscreener"
+                ],
+                "summary": "Get an asset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token address",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/controller.TokenRes"
-                            }
+                            "$ref": "#/definitions/dexscreener.AssetRes"
                         }
                     },
                     "400": {
@@ -1217,22 +1267,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/tokens/{address}": {
+        "/dexscreener/events": {
             "get": {
-                "description": "get Token by Address",
+                "description": "get the swaps, joins and exits between the blocks inclusive, the reserves are at the end of the block.\nA range over 10000 blocks is rejected.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "Get a token",
+                "tags": [
+                    "dexscreener"
+                ],
+                "summary": "Events",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Token Address",
-                        "name": "address",
-                        "in": "path",
+                        "type": "integer",
+                        "description": "From block",
+                        "name": "fromBlock",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "To block",
+                        "name": "toBlock",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -1240,7 +1300,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/controller.TokenRes"
+                            "$ref": "#/definitions/dexscreener.EventsRes"
                         }
                     },
                     "400": {
@@ -1249,12 +1309,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/httputil.BadRequestError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/httputil.NotFoundError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1264,551 +1318,3450 @@ const docTemplate = `{
                 }
             }
         },
-        "/version": {
+        "/dexscreener/latest-block": {
             "get": {
-                "description": "Returns the current application version",
+                "description": "get the latest block of which events are all indexed, timed by the nodes",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "status"
+                    "dexscreener"
                 ],
-                "summary": "Get application version",
+                "summary": "Latest block",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/dexscreener.LatestBlockRes"
                         }
-                    }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/dexscreener/pair": {
+            "get": {
+                "description": "get the pair by the pair address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "dexscreener"
+                ],
+                "summary": "Get a pair",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pair address",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dexscreener.PairRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "get": {
+                "description": "run a GraphQL query of the pairs, the pools, the tokens, the txs and their time series, the schema is served by introspection.\nA query deeper than the depth limit is rejected before the execution, a field of the query estimated to resolve more objects than the complexity limit fails before it resolves anything, a time series or txs query counts as 500 objects.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "GraphQL query",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Query of a GET request",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operation of a GET request",
+                        "name": "operationName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON encoded variables of a GET request",
+                        "name": "variables",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Query of a POST request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/gql.Req"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/gql.Res"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "run a GraphQL query of the pairs, the pools, the tokens, the txs and their time series, the schema is served by introspection.\nA query deeper than the depth limit is rejected before the execution, a field of the query estimated to resolve more objects than the complexity limit fails before it resolves anything, a time series or txs query counts as 500 objects.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "GraphQL query",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Query of a GET request",
+                        "name": "query",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Operation of a GET request",
+                        "name": "operationName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "JSON encoded variables of a GET request",
+                        "name": "variables",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Query of a POST request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/gql.Req"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/gql.Res"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Checks overall service and dependency health with their latency, the freshness of the indexed data, the last CoinGecko price fetch and the aggregator routes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "status"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/notices": {
+            "get": {
+                "description": "get Notices",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notice"
+                ],
+                "summary": "Notices of the chain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "target chain name e.g. (dimension, cube)",
+                        "name": "chain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the starting timestamp in Unix timestamp format e.g. 1696917605 (default: three months prior to the current time)",
+                        "name": "startTs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "condition to get items after the id",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "the number of items to return (default: 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "order of items to return (default: descending order)",
+                        "name": "asc",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/notice.noticeItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pairs": {
+            "get": {
+                "description": "get Pairs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "All Pairs",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.PairsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pairs/{address}": {
+            "get": {
+                "description": "get Pair by Address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a pair",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pair Address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.PairRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pools": {
+            "get": {
+                "description": "get Pools",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "All Pools",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/controller.PoolRes"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/pools/{address}": {
+            "get": {
+                "description": "get Pool by Address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a pool",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pool Address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.PoolRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/ready": {
+            "get": {
+                "description": "Checks the dependencies and fails when the indexed data is staler than the configured threshold",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "status"
+                ],
+                "summary": "Readiness check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/controller.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/routes": {
+            "get": {
+                "description": "get routes based on the given token address, routes are quoted and sorted by the return amount when the amount is given with both from and to, or by the required offer amount when the askAmount is given instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "router"
+                ],
+                "summary": "All Routes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Offer token address",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ask token Address",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of hops between the starting token and the ending token",
+                        "name": "hopCount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Offer amount in the smallest unit to quote the routes",
+                        "name": "amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ask amount in the smallest unit to quote the required offer amount of the routes",
+                        "name": "askAmount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/router.RouteRes"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/simulate/onchain/reverse-swap": {
+            "get": {
+                "description": "query the reverse simulation to the pair contract through the node, the result is cached per block",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "simulate"
+                ],
+                "summary": "Simulate a reverse swap on-chain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Offer token address",
+                        "name": "offer",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ask token address",
+                        "name": "ask",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ask amount in the smallest unit",
+                        "name": "amount",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Block height to query, the latest height of the node by default",
+                        "name": "height",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/simulate.SwapSimulationRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadGatewayError"
+                        }
+                    }
+                }
+            }
+        },
+        "/simulate/onchain/swap": {
+            "get": {
+                "description": "query the simulation to the pair contract through the node, the result is cached per block",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "simulate"
+                ],
+                "summary": "Simulate a swap on-chain",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Offer token address",
+                        "name": "offer",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ask token address",
+                        "name": "ask",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Offer amount in the smallest unit",
+                        "name": "amount",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Block height to query, the latest height of the node by default",
+                        "name": "height",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/simulate.SwapSimulationRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadGatewayError"
+                        }
+                    }
+                }
+            }
+        },
+        "/simulate/provide": {
+            "get": {
+                "description": "simulate the lp tokens minted, the share of the pool and the refund of the excess deposit on the latest indexed reserves of the pair",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "simulate"
+                ],
+                "summary": "Simulate a liquidity provision",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pair address",
+                        "name": "pair",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deposit amount of the asset0 of the pair in the smallest unit",
+                        "name": "amount0",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deposit amount of the asset1 of the pair in the smallest unit",
+                        "name": "amount1",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/simulate.ProvideSimulationRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/simulate/reverse-swap": {
+            "get": {
+                "description": "simulate the offer amount including commission required to receive the ask amount on the latest indexed reserves of the pair",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "simulate"
+                ],
+                "summary": "Simulate a reverse swap",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Offer token address",
+                        "name": "offer",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ask token address",
+                        "name": "ask",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ask amount in the smallest unit",
+                        "name": "amount",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/simulate.SwapSimulationRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/simulate/swap": {
+            "get": {
+                "description": "simulate a swap on the latest indexed reserves of the pair without querying the node",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "simulate"
+                ],
+                "summary": "Simulate a swap",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Offer token address",
+                        "name": "offer",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ask token address",
+                        "name": "ask",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Offer amount in the smallest unit",
+                        "name": "amount",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/simulate.SwapSimulationRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/simulate/withdraw": {
+            "get": {
+                "description": "simulate the assets received by burning the lp tokens on the latest indexed reserves of the pair",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "simulate"
+                ],
+                "summary": "Simulate a liquidity withdrawal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pair address",
+                        "name": "pair",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Lp amount in the smallest unit",
+                        "name": "amount",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/simulate.WithdrawSimulationRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats": {
+            "get": {
+                "description": "get pair stats",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "All pair stats",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.StatsRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/{period}": {
+            "get": {
+                "description": "get a pair stat by period",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a stat",
+                "parameters": [
+                    {
+                        "enum": [
+                            "24h",
+                            "7d",
+                            "1mon"
+                        ],
+                        "type": "string",
+                        "description": "period 24h,7d,1mon",
+                        "name": "period",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.StatRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/stream": {
+            "get": {
+                "description": "stream the parsed txs, the pool reserves and the swap prices as Server-Sent Events, of which the event is the topic and the id is the height.\nThe events from the height are replayed first when fromHeight or the Last-Event-ID header is given, the events of the height may be sent again.\nA subscriber falling behind the live events is disconnected with an error event and may resume from the last height.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "stream"
+                ],
+                "summary": "Stream events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated topics of tx, pool and price, every topic when empty",
+                        "name": "topics",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated pair addresses",
+                        "name": "pairs",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated token addresses, the events of the pairs or the tokens are sent, every event when both are empty",
+                        "name": "tokens",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Height to replay from",
+                        "name": "fromHeight",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/stream.TxRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens": {
+            "get": {
+                "description": "get Tokens",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "All Tokens",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/controller.TokenRes"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens/{address}": {
+            "get": {
+                "description": "get Token by Address",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token Address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.TokenRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens/{address}/supply/circulating": {
+            "get": {
+                "description": "get the circulating supply of a token normalized by its decimals as plain text, balances of the configured holders are excluded",
+                "produces": [
+                    "text/plain"
+                ],
+                "summary": "Circulating supply of a token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token Address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tokens/{address}/supply/total": {
+            "get": {
+                "description": "get the total supply of a token normalized by its decimals as plain text",
+                "produces": [
+                    "text/plain"
+                ],
+                "summary": "Total supply of a token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Token Address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tx/provide": {
+            "get": {
+                "description": "build unsigned provide_liquidity messages of the pair, preceded by increase_allowance messages of cw20 deposits",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tx"
+                ],
+                "summary": "Provide liquidity messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sender address",
+                        "name": "sender",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pair address",
+                        "name": "pair",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deposit amount of the asset0 of the pair in the smallest unit",
+                        "name": "amount0",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deposit amount of the asset1 of the pair in the smallest unit",
+                        "name": "amount1",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Slippage tolerance, 0.005 by default",
+                        "name": "slippage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tx.TxRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tx/swap": {
+            "get": {
+                "description": "build unsigned execute_swap_operations messages of the router for the route, minimum_receive is the simulated return less the slippage",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tx"
+                ],
+                "summary": "Swap messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sender address",
+                        "name": "sender",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated token addresses from the offer token to the ask token",
+                        "name": "route",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Offer amount in the smallest unit",
+                        "name": "amount",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Slippage tolerance, 0.005 by default",
+                        "name": "slippage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tx.SwapTxRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    },
+                    "501": {
+                        "description": "Not Implemented",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/tx/withdraw": {
+            "get": {
+                "description": "build unsigned send messages of the lp token which withdraw the liquidity from the pair",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tx"
+                ],
+                "summary": "Withdraw liquidity messages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Sender address",
+                        "name": "sender",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pair address",
+                        "name": "pair",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Lp amount in the smallest unit",
+                        "name": "amount",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tx.TxRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/udf/config": {
+            "get": {
+                "description": "get the configuration of the TradingView UDF datafeed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "udf"
+                ],
+                "summary": "Datafeed configuration",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/udf.ConfigRes"
+                        }
+                    }
+                }
+            }
+        },
+        "/udf/history": {
+            "get": {
+                "description": "get the OHLCV bars of the ticker built from the swaps, the price is of asset0 in asset1 and the volume is of asset0",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "udf"
+                ],
+                "summary": "Bars",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ticker in the form of asset0_asset1",
+                        "name": "symbol",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "1",
+                            "5",
+                            "15",
+                            "30",
+                            "60",
+                            "240",
+                            "1D",
+                            "1W"
+                        ],
+                        "type": "string",
+                        "description": "Resolution",
+                        "name": "resolution",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "From in unix seconds, inclusive",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "To in unix seconds, exclusive",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of the bars before to, from is ignored when given",
+                        "name": "countback",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/udf.HistoryRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/udf/search": {
+            "get": {
+                "description": "get the symbols of which the pair name contains the query, or the pair or token address is the query",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "udf"
+                ],
+                "summary": "Search symbols",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Query",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Symbol type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Exchange",
+                        "name": "exchange",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 30,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/udf.SearchItemRes"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/udf/symbols": {
+            "get": {
+                "description": "get the symbol information of the ticker in the form of asset0_asset1",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "udf"
+                ],
+                "summary": "Resolve a symbol",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ticker in the form of asset0_asset1",
+                        "name": "symbol",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/udf.SymbolRes"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.BadRequestError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.NotFoundError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/httputil.InternalServerError"
+                        }
+                    }
+                }
+            }
+        },
+        "/udf/time": {
+            "get": {
+                "description": "get the server time in unix seconds",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "udf"
+                ],
+                "summary": "Server time",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Returns the current application version",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "status"
+                ],
+                "summary": "Get application version",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "adapter.DefiLlamaTokensItemRes": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "integer"
+                },
+                "tokens": {
+                    "description": "Tokens are the TVLs in USD by the token address",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "adapter.DefiLlamaTvlItemRes": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "description": "Date is the unix timestamp in seconds",
+                    "type": "integer"
+                },
+                "totalLiquidityUSD": {
+                    "type": "number"
+                }
+            }
+        },
+        "adapter.DefiLlamaTvlRes": {
+            "type": "object",
+            "properties": {
+                "tokensInUsd": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/adapter.DefiLlamaTokensItemRes"
+                    }
+                },
+                "tvl": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/adapter.DefiLlamaTvlItemRes"
+                    }
+                }
+            }
+        },
+        "adapter.DefiLlamaVolumeRes": {
+            "type": "object",
+            "properties": {
+                "totalDataChart": {
+                    "description": "TotalDataChart is the list of [unix timestamp in seconds, volume in USD]",
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "number"
+                        }
+                    }
+                }
+            }
+        },
+        "adapter.GeckoTerminalPointRes": {
+            "type": "object",
+            "properties": {
+                "timestamp": {
+                    "type": "integer"
+                },
+                "value_usd": {
+                    "type": "string"
+                }
+            }
+        },
+        "adapter.GeckoTerminalTokenTvlRes": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "tvl_usd": {
+                    "type": "string"
+                }
+            }
+        },
+        "adapter.GeckoTerminalTvlAttributesRes": {
+            "type": "object",
+            "properties": {
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/adapter.GeckoTerminalPointRes"
+                    }
+                },
+                "timestamp": {
+                    "description": "Timestamp is when the token TVLs are valued",
+                    "type": "integer"
+                },
+                "tokens": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/adapter.GeckoTerminalTokenTvlRes"
+                    }
+                }
+            }
+        },
+        "adapter.GeckoTerminalTvlDataRes": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "$ref": "#/definitions/adapter.GeckoTerminalTvlAttributesRes"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "adapter.GeckoTerminalTvlRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/adapter.GeckoTerminalTvlDataRes"
+                }
+            }
+        },
+        "adapter.GeckoTerminalVolumeAttributesRes": {
+            "type": "object",
+            "properties": {
+                "series": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/adapter.GeckoTerminalPointRes"
+                    }
+                }
+            }
+        },
+        "adapter.GeckoTerminalVolumeDataRes": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "$ref": "#/definitions/adapter.GeckoTerminalVolumeAttributesRes"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "adapter.GeckoTerminalVolumeRes": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/adapter.GeckoTerminalVolumeDataRes"
+                }
+            }
+        },
+        "coingecko.HistoricalTradesRes": {
+            "type": "object",
+            "properties": {
+                "buy": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/coingecko.TradeRes"
+                    }
+                },
+                "sell": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/coingecko.TradeRes"
+                    }
+                }
+            }
+        },
+        "coingecko.OrderbookRes": {
+            "type": "object",
+            "properties": {
+                "asks": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "bids": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "ticker_id": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "coingecko.PairRes": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "type": "string"
+                },
+                "pool_id": {
+                    "type": "string"
+                },
+                "target": {
+                    "type": "string"
+                },
+                "ticker_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "coingecko.TickerDepthRes": {
+            "type": "object",
+            "properties": {
+                "base_amount": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "type": "string"
+                },
+                "target_amount": {
+                    "type": "string"
+                },
+                "usd_amount": {
+                    "type": "string"
+                }
+            }
+        },
+        "coingecko.TickerRes": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "type": "string"
+                },
+                "base_volume": {
+                    "type": "string"
+                },
+                "bid_ask_spread_percentage": {
+                    "description": "BidAskSpreadPercentage is the gap made by the commission between the marginal ask and bid prices",
+                    "type": "string"
+                },
+                "depth": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/coingecko.TickerDepthRes"
+                    }
+                },
+                "last_price": {
+                    "type": "string"
+                },
+                "liquidity_in_usd": {
+                    "type": "string"
+                },
+                "pool_id": {
+                    "type": "string"
+                },
+                "target_currency": {
+                    "type": "string"
+                },
+                "target_volume": {
+                    "type": "string"
+                },
+                "ticker_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "coingecko.TradeRes": {
+            "type": "object",
+            "properties": {
+                "base_volume": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "target_volume": {
+                    "type": "string"
+                },
+                "trade_id": {
+                    "type": "integer"
+                },
+                "trade_timestamp": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "coinmarketcap.AssetRes": {
+            "type": "object",
+            "properties": {
+                "can_deposit": {
+                    "type": "boolean"
+                },
+                "can_withdraw": {
+                    "type": "boolean"
+                },
+                "contractAddress": {
+                    "type": "string"
+                },
+                "decimals": {
+                    "type": "integer"
+                },
+                "maker_fee": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "taker_fee": {
+                    "type": "string"
+                },
+                "unified_cryptoasset_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "coinmarketcap.AssetsRes": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#/definitions/coinmarketcap.AssetRes"
+            }
+        },
+        "coinmarketcap.SummaryRes": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "type": "string"
+                },
+                "base_volume": {
+                    "type": "string"
+                },
+                "highest_bid": {
+                    "type": "string"
+                },
+                "highest_price_24h": {
+                    "type": "string"
+                },
+                "last_price": {
+                    "type": "string"
+                },
+                "lowest_ask": {
+                    "type": "string"
+                },
+                "lowest_price_24h": {
+                    "type": "string"
+                },
+                "price_change_percent_24h": {
+                    "type": "string"
+                },
+                "quote_currency": {
+                    "type": "string"
+                },
+                "quote_volume": {
+                    "type": "string"
+                },
+                "trading_pairs": {
+                    "type": "string"
+                }
+            }
+        },
+        "coinmarketcap.TickerRes": {
+            "type": "object",
+            "properties": {
+                "base_id": {
+                    "type": "string"
+                },
+                "base_name": {
+                    "type": "string"
+                },
+                "base_symbol": {
+                    "type": "string"
+                },
+                "base_volume": {
+                    "type": "string"
+                },
+                "last_price": {
+                    "type": "string"
+                },
+                "quote_id": {
+                    "type": "string"
+                },
+                "quote_name": {
+                    "type": "string"
+                },
+                "quote_symbol": {
+                    "type": "string"
+                },
+                "quote_volume": {
+                    "type": "string"
+                }
+            }
+        },
+        "coinmarketcap.TickersRes": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#/definitions/coinmarketcap.TickerRes"
+            }
+        },
+        "coinmarketcap.TradeRes": {
+            "type": "object",
+            "properties": {
+                "base_volume": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "quote_volume": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "integer"
+                },
+                "trade_id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.HealthDependency": {
+            "type": "object",
+            "properties": {
+                "latencyMs": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.HealthIndexer": {
+            "type": "object",
+            "properties": {
+                "maxStalenessSeconds": {
+                    "type": "integer"
+                },
+                "poolHeight": {
+                    "description": "PoolHeight is the newest height of the latest pools",
+                    "type": "integer"
+                },
+                "poolsBehindSeconds": {
+                    "description": "PoolsBehindSeconds is the age of the oldest pool change synced above the pool height, omitted when the pools are up to date",
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "syncedHeight": {
+                    "description": "SyncedHeight is the height synced by the parser, its age is of since the parser synced it",
+                    "type": "integer"
+                },
+                "syncedHeightAgeSeconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.HealthPrices": {
+            "type": "object",
+            "properties": {
+                "lastFetch": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "dependencies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.HealthDependency"
+                    }
+                },
+                "indexer": {
+                    "$ref": "#/definitions/controller.HealthIndexer"
+                },
+                "prices": {
+                    "$ref": "#/definitions/controller.HealthPrices"
+                },
+                "routes": {
+                    "$ref": "#/definitions/controller.HealthRoutes"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.HealthRoutes": {
+            "type": "object",
+            "properties": {
+                "ageSeconds": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "modifiedAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.IbcOriginRes": {
+            "type": "object",
+            "properties": {
+                "baseDenom": {
+                    "type": "string"
+                },
+                "channel": {
+                    "type": "string"
+                },
+                "originChain": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.PairRes": {
+            "type": "object",
+            "properties": {
+                "asset_decimals": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "asset_infos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dezswap.AssetInfoTokenRes"
+                    }
+                },
+                "contract_addr": {
+                    "type": "string"
+                },
+                "liquidity_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.PairsRes": {
+            "type": "object",
+            "properties": {
+                "pairs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.PairRes"
+                    }
+                }
+            }
+        },
+        "controller.PoolRes": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dezswap.AssetInfoRes"
+                    }
+                },
+                "total_share": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.StatRes": {
+            "type": "object",
+            "properties": {
+                "apr": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.StatValueRes"
+                    }
+                },
+                "fee": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.StatValueRes"
+                    }
+                },
+                "volume": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/controller.StatValueRes"
+                    }
+                }
+            }
+        },
+        "controller.StatValueRes": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "controller.StatsRes": {
+            "type": "object",
+            "properties": {
+                "stats_1mon": {
+                    "$ref": "#/definitions/controller.StatRes"
+                },
+                "stats_24h": {
+                    "$ref": "#/definitions/controller.StatRes"
+                },
+                "stats_7d": {
+                    "$ref": "#/definitions/controller.StatRes"
+                }
+            }
+        },
+        "controller.TokenRes": {
+            "type": "object",
+            "properties": {
+                "chainId": {
+                    "type": "string"
+                },
+                "decimals": {
+                    "type": "integer"
+                },
+                "ibc": {
+                    "$ref": "#/definitions/controller.IbcOriginRes"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "protocol": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "total_supply": {
+                    "type": "string"
+                },
+                "verified": {
+                    "type": "boolean"
                 }
             }
-        }
-    },
-    "definitions": {
-        "coingecko.PairRes": {
+        },
+        "dashboard.ChartItem": {
             "type": "object",
             "properties": {
-                "base": {
+                "t": {
                     "type": "string"
                 },
-                "pool_id": {
+                "v": {
                     "type": "string"
+                }
+            }
+        },
+        "dashboard.PoolDetailRes": {
+            "type": "object",
+            "properties": {
+                "recent": {
+                    "$ref": "#/definitions/dashboard.RecentRes"
                 },
-                "target": {
+                "txs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dashboard.TxRes"
+                    }
+                }
+            }
+        },
+        "dashboard.PoolRes": {
+            "type": "object",
+            "properties": {
+                "address": {
                     "type": "string"
                 },
-                "ticker_id": {
+                "apr": {
+                    "type": "string"
+                },
+                "fee": {
+                    "type": "string"
+                },
+                "tvl": {
+                    "type": "string"
+                },
+                "volume": {
+                    "type": "string"
+                }
+            }
+        },
+        "dashboard.RecentRes": {
+            "type": "object",
+            "properties": {
+                "apr": {
+                    "type": "number"
+                },
+                "aprChangeRate": {
+                    "type": "number"
+                },
+                "fee": {
+                    "type": "string"
+                },
+                "feeChangeRate": {
+                    "type": "number"
+                },
+                "tvl": {
+                    "type": "string"
+                },
+                "tvlChangeRate": {
+                    "type": "number"
+                },
+                "volume": {
+                    "type": "string"
+                },
+                "volumeChangeRate": {
+                    "type": "number"
+                }
+            }
+        },
+        "dashboard.StatisticResItem": {
+            "type": "object",
+            "properties": {
+                "addressCount": {
+                    "type": "integer"
+                },
+                "fee": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "txCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dashboard.TokenRes": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "fdv": {
                     "type": "string"
+                },
+                "fee": {
+                    "type": "string"
+                },
+                "marketCap": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "string"
+                },
+                "priceChange": {
+                    "type": "number"
+                },
+                "tvl": {
+                    "type": "string"
+                },
+                "tvlChange": {
+                    "type": "string"
+                },
+                "volume24h": {
+                    "type": "string"
+                },
+                "volume24hChange": {
+                    "type": "string"
+                },
+                "volume7d": {
+                    "type": "string"
+                },
+                "volume7dChange": {
+                    "type": "string"
+                }
+            }
+        },
+        "dashboard.TxRes": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "action": {
+                    "type": "string"
+                },
+                "actionDisplay": {
+                    "type": "string"
+                },
+                "address": {
+                    "type": "string"
+                },
+                "asset0": {
+                    "type": "string"
+                },
+                "asset0amount": {
+                    "type": "string"
+                },
+                "asset1": {
+                    "type": "string"
+                },
+                "asset1amount": {
+                    "type": "string"
+                },
+                "hash": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "totalValue": {
+                    "type": "string"
+                }
+            }
+        },
+        "depth.DepthLevelRes": {
+            "type": "object",
+            "properties": {
+                "asset0Amount": {
+                    "type": "string"
+                },
+                "asset1Amount": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "description": "Percentage is the price move of asset0 in asset1, negative on the bid side",
+                    "type": "string"
+                },
+                "valueInUsd": {
+                    "description": "ValueInUsd is the value of asset1Amount, the price token is regarded as USD",
+                    "type": "string"
+                }
+            }
+        },
+        "depth.DepthRes": {
+            "type": "object",
+            "properties": {
+                "asset0": {
+                    "type": "string"
+                },
+                "asset1": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "levels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/depth.DepthLevelRes"
+                    }
+                },
+                "pair": {
+                    "type": "string"
+                },
+                "spreadPercentage": {
+                    "type": "string"
+                }
+            }
+        },
+        "dexscreener.AssetItemRes": {
+            "type": "object",
+            "properties": {
+                "circulatingSupply": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "totalSupply": {
+                    "type": "string"
+                }
+            }
+        },
+        "dexscreener.AssetRes": {
+            "type": "object",
+            "properties": {
+                "asset": {
+                    "$ref": "#/definitions/dexscreener.AssetItemRes"
+                }
+            }
+        },
+        "dexscreener.BlockRes": {
+            "type": "object",
+            "properties": {
+                "blockNumber": {
+                    "type": "integer"
+                },
+                "blockTimestamp": {
+                    "type": "integer"
                 }
             }
         },
-        "coingecko.TickerRes": {
+        "dexscreener.EventRes": {
             "type": "object",
             "properties": {
-                "base_currency": {
+                "amount0": {
+                    "description": "join and exit only",
                     "type": "string"
                 },
-                "base_volume": {
+                "amount1": {
                     "type": "string"
                 },
-                "last_price": {
+                "asset0In": {
+                    "description": "swap only",
                     "type": "string"
                 },
-                "liquidity_in_usd": {
+                "asset0Out": {
                     "type": "string"
                 },
-                "pool_id": {
+                "asset1In": {
                     "type": "string"
                 },
-                "target_currency": {
+                "asset1Out": {
                     "type": "string"
                 },
-                "target_volume": {
+                "block": {
+                    "$ref": "#/definitions/dexscreener.BlockRes"
+                },
+                "eventIndex": {
+                    "type": "integer"
+                },
+                "eventType": {
                     "type": "string"
                 },
-                "ticker_id": {
+                "maker": {
+                    "type": "string"
+                },
+                "pairId": {
+                    "type": "string"
+                },
+                "priceNative": {
+                    "type": "string"
+                },
+                "reserves": {
+                    "$ref": "#/definitions/dexscreener.ReservesRes"
+                },
+                "txnId": {
                     "type": "string"
+                },
+                "txnIndex": {
+                    "type": "integer"
                 }
             }
         },
-        "coinmarketcap.TickerRes": {
+        "dexscreener.EventsRes": {
             "type": "object",
             "properties": {
-                "base_id": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dexscreener.EventRes"
+                    }
+                }
+            }
+        },
+        "dexscreener.LatestBlockRes": {
+            "type": "object",
+            "properties": {
+                "block": {
+                    "$ref": "#/definitions/dexscreener.BlockRes"
+                }
+            }
+        },
+        "dexscreener.PairItemRes": {
+            "type": "object",
+            "properties": {
+                "asset0Id": {
                     "type": "string"
                 },
-                "base_name": {
+                "asset1Id": {
                     "type": "string"
                 },
-                "base_symbol": {
-                    "type": "string"
+                "createdAtBlockNumber": {
+                    "type": "integer"
                 },
-                "base_volume": {
-                    "type": "string"
+                "createdAtBlockTimestamp": {
+                    "type": "integer"
                 },
-                "last_price": {
+                "createdAtTxnId": {
                     "type": "string"
                 },
-                "quote_id": {
+                "creator": {
                     "type": "string"
                 },
-                "quote_name": {
+                "dexKey": {
                     "type": "string"
                 },
-                "quote_symbol": {
-                    "type": "string"
+                "feeBps": {
+                    "type": "integer"
                 },
-                "quote_volume": {
+                "id": {
                     "type": "string"
                 }
             }
         },
-        "coinmarketcap.TickersRes": {
+        "dexscreener.PairRes": {
             "type": "object",
-            "additionalProperties": {
-                "$ref": "#/definitions/coinmarketcap.TickerRes"
+            "properties": {
+                "pair": {
+                    "$ref": "#/definitions/dexscreener.PairItemRes"
+                }
             }
         },
-        "controller.HealthDependency": {
+        "dexscreener.ReservesRes": {
             "type": "object",
             "properties": {
-                "name": {
+                "asset0": {
                     "type": "string"
                 },
-                "status": {
+                "asset1": {
                     "type": "string"
                 }
             }
         },
-        "controller.HealthResponse": {
+        "dezswap.AssetInfoRes": {
             "type": "object",
             "properties": {
-                "dependencies": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/controller.HealthDependency"
-                    }
-                },
-                "status": {
+                "amount": {
                     "type": "string"
                 },
-                "timestamp": {
-                    "type": "string"
+                "info": {
+                    "$ref": "#/definitions/dezswap.AssetInfoTokenRes"
                 }
             }
         },
-        "controller.PairRes": {
+        "dezswap.AssetInfoTokenRes": {
             "type": "object",
             "properties": {
-                "asset_decimals": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
-                },
-                "asset_infos": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dezswap.AssetInfoTokenRes"
-                    }
-                },
-                "contract_addr": {
-                    "type": "string"
+                "native_token": {
+                    "$ref": "#/definitions/dezswap.NativeTokenAssetInfoRes"
                 },
-                "liquidity_token": {
+                "token": {
+                    "$ref": "#/definitions/dezswap.TokenAssetInfoRes"
+                }
+            }
+        },
+        "dezswap.NativeTokenAssetInfoRes": {
+            "type": "object",
+            "properties": {
+                "denom": {
                     "type": "string"
                 }
             }
         },
-        "controller.PairsRes": {
+        "dezswap.TokenAssetInfoRes": {
             "type": "object",
             "properties": {
-                "pairs": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/controller.PairRes"
-                    }
+                "contract_addr": {
+                    "type": "string"
                 }
             }
         },
-        "controller.PoolRes": {
+        "gql.ErrorRes": {
             "type": "object",
             "properties": {
-                "address": {
+                "message": {
                     "type": "string"
                 },
-                "assets": {
+                "path": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dezswap.AssetInfoRes"
+                        "type": "string"
                     }
+                }
+            }
+        },
+        "gql.Req": {
+            "type": "object",
+            "properties": {
+                "operationName": {
+                    "type": "string"
                 },
-                "total_share": {
+                "query": {
                     "type": "string"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {}
                 }
             }
         },
-        "controller.StatRes": {
+        "gql.Res": {
             "type": "object",
             "properties": {
-                "apr": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/controller.StatValueRes"
-                    }
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {}
                 },
-                "fee": {
+                "errors": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/controller.StatValueRes"
+                        "$ref": "#/definitions/gql.ErrorRes"
                     }
+                }
+            }
+        },
+        "httputil.BadGatewayError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 502
                 },
-                "volume": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/controller.StatValueRes"
-                    }
+                "message": {
+                    "type": "string",
+                    "example": "bad gateway"
                 }
             }
         },
-        "controller.StatValueRes": {
+        "httputil.BadRequestError": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
+                "code": {
+                    "type": "integer",
+                    "example": 400
                 },
-                "value": {
-                    "type": "string"
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
                 }
             }
         },
-        "controller.StatsRes": {
+        "httputil.HTTPError": {
             "type": "object",
             "properties": {
-                "stats_1mon": {
-                    "$ref": "#/definitions/controller.StatRes"
+                "code": {
+                    "type": "integer",
+                    "example": 400
                 },
-                "stats_24h": {
-                    "$ref": "#/definitions/controller.StatRes"
+                "message": {
+                    "type": "string",
+                    "example": "bad request"
+                }
+            }
+        },
+        "httputil.InternalServerError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer",
+                    "example": 500
                 },
-                "stats_7d": {
-                    "$ref": "#/definitions/controller.StatRes"
+                "message": {
+                    "type": "string",
+                    "example": "internal server error"
                 }
             }
         },
-        "controller.TokenRes": {
+        "httputil.NotFoundError": {
             "type": "object",
             "properties": {
-                "chainId": {
+                "code": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "message": {
+                    "type": "string",
+                    "example": "not found"
+                }
+            }
+        },
+        "notice.noticeItem": {
+            "type": "object",
+            "properties": {
+                "chain": {
                     "type": "string"
                 },
-                "decimals": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "icon": {
+                "id": {
                     "type": "string"
                 },
-                "name": {
+                "timestamp": {
                     "type": "string"
                 },
-                "protocol": {
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "router.QuoteHop": {
+            "type": "object",
+            "properties": {
+                "askAsset": {
                     "type": "string"
                 },
-                "symbol": {
+                "commissionAmount": {
                     "type": "string"
                 },
-                "token": {
+                "height": {
+                    "type": "integer"
+                },
+                "offerAmount": {
                     "type": "string"
                 },
-                "total_supply": {
+                "offerAsset": {
                     "type": "string"
                 },
-                "verified": {
-                    "type": "boolean"
+                "pair": {
+                    "type": "string"
+                },
+                "returnAmount": {
+                    "type": "string"
+                },
+                "spreadAmount": {
+                    "type": "string"
                 }
             }
         },
-        "dashboard.ChartItem": {
+        "router.QuoteRes": {
             "type": "object",
             "properties": {
-                "t": {
+                "feeRate": {
                     "type": "string"
                 },
-                "v": {
+                "hops": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/router.QuoteHop"
+                    }
+                },
+                "offerAmount": {
+                    "type": "string"
+                },
+                "priceImpact": {
+                    "type": "string"
+                },
+                "returnAmount": {
                     "type": "string"
                 }
             }
         },
-        "dashboard.PoolDetailRes": {
+        "router.RouteRes": {
             "type": "object",
             "properties": {
-                "recent": {
-                    "$ref": "#/definitions/dashboard.RecentRes"
+                "from": {
+                    "type": "string"
                 },
-                "txs": {
+                "hopCount": {
+                    "type": "integer"
+                },
+                "quote": {
+                    "$ref": "#/definitions/router.QuoteRes"
+                },
+                "route": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dashboard.TxRes"
+                        "type": "string"
                     }
+                },
+                "to": {
+                    "type": "string"
                 }
             }
         },
-        "dashboard.PoolRes": {
+        "simulate.ProvideSimulationRes": {
             "type": "object",
             "properties": {
-                "address": {
+                "asset0": {
                     "type": "string"
                 },
-                "apr": {
+                "asset0Deposit": {
                     "type": "string"
                 },
-                "fee": {
+                "asset0Refund": {
                     "type": "string"
                 },
-                "tvl": {
+                "asset1": {
                     "type": "string"
                 },
-                "volume": {
+                "asset1Deposit": {
                     "type": "string"
-                }
-            }
-        },
-        "dashboard.RecentRes": {
-            "type": "object",
-            "properties": {
-                "apr": {
-                    "type": "number"
-                },
-                "aprChangeRate": {
-                    "type": "number"
                 },
-                "fee": {
+                "asset1Refund": {
                     "type": "string"
                 },
-                "feeChangeRate": {
-                    "type": "number"
+                "height": {
+                    "type": "integer"
                 },
-                "tvl": {
+                "lp": {
                     "type": "string"
                 },
-                "tvlChangeRate": {
-                    "type": "number"
+                "lpAmount": {
+                    "type": "string"
                 },
-                "volume": {
+                "pair": {
                     "type": "string"
                 },
-                "volumeChangeRate": {
-                    "type": "number"
+                "share": {
+                    "type": "string"
                 }
             }
         },
-        "dashboard.StatisticResItem": {
+        "simulate.SwapSimulationRes": {
             "type": "object",
             "properties": {
-                "addressCount": {
+                "askAsset": {
+                    "type": "string"
+                },
+                "commissionAmount": {
+                    "type": "string"
+                },
+                "height": {
                     "type": "integer"
                 },
-                "fee": {
+                "offerAmount": {
                     "type": "string"
                 },
-                "timestamp": {
+                "offerAsset": {
                     "type": "string"
                 },
-                "txCount": {
-                    "type": "integer"
+                "pair": {
+                    "type": "string"
+                },
+                "returnAmount": {
+                    "type": "string"
+                },
+                "spreadAmount": {
+                    "type": "string"
                 }
             }
         },
-        "dashboard.TokenRes": {
+        "simulate.WithdrawSimulationRes": {
             "type": "object",
             "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "fee": {
+                "asset0": {
                     "type": "string"
                 },
-                "price": {
+                "asset0Amount": {
                     "type": "string"
                 },
-                "priceChange": {
-                    "type": "number"
-                },
-                "tvl": {
+                "asset1": {
                     "type": "string"
                 },
-                "tvlChange": {
+                "asset1Amount": {
                     "type": "string"
                 },
-                "volume24h": {
-                    "type": "string"
+                "height": {
+                    "type": "integer"
                 },
-                "volume24hChange": {
+                "lp": {
                     "type": "string"
                 },
-                "volume7d": {
+                "lpAmount": {
                     "type": "string"
                 },
-                "volume7dChange": {
+                "pair": {
                     "type": "string"
                 }
             }
         },
-        "dashboard.TxRes": {
+        "stream.TxRes": {
             "type": "object",
             "properties": {
-                "account": {
+                "asset0": {
                     "type": "string"
                 },
-                "action": {
+                "asset0Amount": {
                     "type": "string"
                 },
-                "actionDisplay": {
+                "asset1": {
                     "type": "string"
                 },
-                "address": {
+                "asset1Amount": {
                     "type": "string"
                 },
-                "asset0": {
+                "hash": {
                     "type": "string"
                 },
-                "asset0amount": {
-                    "type": "string"
+                "height": {
+                    "type": "integer"
                 },
-                "asset1": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 },
-                "asset1amount": {
+                "pair": {
                     "type": "string"
                 },
-                "hash": {
+                "sender": {
                     "type": "string"
                 },
                 "timestamp": {
-                    "type": "string"
+                    "type": "number"
                 },
-                "totalValue": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "dezswap.AssetInfoRes": {
+        "tx.CoinRes": {
             "type": "object",
             "properties": {
                 "amount": {
                     "type": "string"
                 },
-                "info": {
-                    "$ref": "#/definitions/dezswap.AssetInfoTokenRes"
+                "denom": {
+                    "type": "string"
                 }
             }
         },
-        "dezswap.AssetInfoTokenRes": {
+        "tx.MsgExecuteContractRes": {
             "type": "object",
             "properties": {
-                "native_token": {
-                    "$ref": "#/definitions/dezswap.NativeTokenAssetInfoRes"
+                "contract": {
+                    "type": "string"
                 },
-                "token": {
-                    "$ref": "#/definitions/dezswap.TokenAssetInfoRes"
+                "funds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tx.CoinRes"
+                    }
+                },
+                "msg": {
+                    "type": "object"
+                },
+                "sender": {
+                    "type": "string"
                 }
             }
         },
-        "dezswap.NativeTokenAssetInfoRes": {
+        "tx.MsgRes": {
             "type": "object",
             "properties": {
-                "denom": {
+                "typeUrl": {
                     "type": "string"
+                },
+                "value": {
+                    "$ref": "#/definitions/tx.MsgExecuteContractRes"
                 }
             }
         },
-        "dezswap.TokenAssetInfoRes": {
+        "tx.SwapTxRes": {
             "type": "object",
             "properties": {
-                "contract_addr": {
+                "minimumReceive": {
+                    "type": "string"
+                },
+                "msgs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tx.MsgRes"
+                    }
+                },
+                "priceImpact": {
+                    "type": "string"
+                },
+                "returnAmount": {
                     "type": "string"
                 }
             }
         },
-        "httputil.BadRequestError": {
+        "tx.TxRes": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 400
-                },
-                "message": {
-                    "type": "string",
-                    "example": "bad request"
+                "msgs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/tx.MsgRes"
+                    }
                 }
             }
         },
-        "httputil.InternalServerError": {
+        "udf.ConfigRes": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 500
+                "exchanges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/udf.ExchangeRes"
+                    }
                 },
-                "message": {
-                    "type": "string",
-                    "example": "internal server error"
+                "supported_resolutions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "supports_group_request": {
+                    "type": "boolean"
+                },
+                "supports_marks": {
+                    "type": "boolean"
+                },
+                "supports_search": {
+                    "type": "boolean"
+                },
+                "supports_time": {
+                    "type": "boolean"
+                },
+                "supports_timescale_marks": {
+                    "type": "boolean"
+                },
+                "symbols_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/udf.SymbolTypeRes"
+                    }
                 }
             }
         },
-        "httputil.NotFoundError": {
+        "udf.ExchangeRes": {
             "type": "object",
             "properties": {
-                "code": {
-                    "type": "integer",
-                    "example": 404
+                "desc": {
+                    "type": "string"
                 },
-                "message": {
-                    "type": "string",
-                    "example": "not found"
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "notice.noticeItem": {
+        "udf.HistoryRes": {
             "type": "object",
             "properties": {
-                "chain": {
+                "c": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "h": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "l": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "nextTime": {
+                    "description": "NextTime is the time of the latest bar before the requested range on no_data",
+                    "type": "integer"
+                },
+                "o": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "s": {
                     "type": "string"
                 },
+                "t": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "v": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                }
+            }
+        },
+        "udf.SearchItemRes": {
+            "type": "object",
+            "properties": {
                 "description": {
                     "type": "string"
                 },
-                "id": {
+                "exchange": {
                     "type": "string"
                 },
-                "timestamp": {
+                "full_name": {
                     "type": "string"
                 },
-                "title": {
+                "symbol": {
+                    "type": "string"
+                },
+                "ticker": {
+                    "type": "string"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "router.RouteRes": {
+        "udf.SymbolRes": {
             "type": "object",
             "properties": {
-                "from": {
+                "data_status": {
                     "type": "string"
                 },
-                "hopCount": {
+                "description": {
+                    "type": "string"
+                },
+                "exchange": {
+                    "type": "string"
+                },
+                "has_daily": {
+                    "type": "boolean"
+                },
+                "has_intraday": {
+                    "type": "boolean"
+                },
+                "has_weekly_and_monthly": {
+                    "type": "boolean"
+                },
+                "listed_exchange": {
+                    "type": "string"
+                },
+                "minmov": {
                     "type": "integer"
                 },
-                "route": {
+                "name": {
+                    "type": "string"
+                },
+                "pricescale": {
+                    "type": "integer"
+                },
+                "session": {
+                    "type": "string"
+                },
+                "supported_resolutions": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "to": {
+                "ticker": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "volume_precision": {
+                    "type": "integer"
+                }
+            }
+        },
+        "udf.SymbolTypeRes": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
                     "type": "string"
                 }
             }
@@ -0,0 +1,230 @@
+package grpcserver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dezswap/dezswap-api/api/grpcserver/pb"
+	ds "github.com/dezswap/dezswap-api/api/v1/service/dashboard"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type dashboardServer struct {
+	pb.UnimplementedDashboardServiceServer
+	dashboard ds.Dashboard
+	logger    logging.Logger
+}
+
+func (s *dashboardServer) GetRecent(ctx context.Context, req *pb.GetRecentRequest) (*pb.Recent, error) {
+	var recent ds.Recent
+	var err error
+	if req.Address != "" {
+		recent, err = s.dashboard.RecentOf(ctx, ds.Addr(req.Address))
+	} else {
+		recent, err = s.dashboard.Recent(ctx)
+	}
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	return recentToPb(recent), nil
+}
+
+func (s *dashboardServer) GetStatistic(ctx context.Context, req *pb.GetStatisticRequest) (*pb.GetStatisticResponse, error) {
+	statistic, err := s.dashboard.Statistic(ctx, toAddrs(req.Addresses)...)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	res := &pb.GetStatisticResponse{Items: make([]*pb.StatisticItem, len(statistic))}
+	for i, item := range statistic {
+		res.Items[i] = &pb.StatisticItem{
+			AddressCount: item.AddressCount,
+			TxCount:      item.TxCount,
+			Fee:          item.Fee,
+			Timestamp:    item.Timestamp.Unix(),
+		}
+	}
+	return res, nil
+}
+
+func (s *dashboardServer) GetChart(ctx context.Context, req *pb.GetChartRequest) (*pb.Chart, error) {
+	duration := toDuration(req.Duration)
+	addr := ds.Addr(req.Address)
+
+	chart := &pb.Chart{}
+	var err error
+	switch req.Type {
+	case pb.ChartType_CHART_TYPE_VOLUME:
+		var volumes ds.Volumes
+		if addr != "" {
+			volumes, err = s.dashboard.VolumesOf(ctx, addr, duration)
+		} else {
+			volumes, err = s.dashboard.Volumes(ctx, duration)
+		}
+		for _, v := range volumes {
+			chart.Items = append(chart.Items, &pb.ChartItem{Timestamp: v.Timestamp.Unix(), Value: v.Volume})
+		}
+	case pb.ChartType_CHART_TYPE_TVL:
+		var tvls ds.Tvls
+		if addr != "" {
+			tvls, err = s.dashboard.TvlsOf(ctx, addr, duration)
+		} else {
+			tvls, err = s.dashboard.Tvls(ctx, duration)
+		}
+		for _, v := range tvls {
+			chart.Items = append(chart.Items, &pb.ChartItem{Timestamp: v.Timestamp.Unix(), Value: v.Tvl})
+		}
+	case pb.ChartType_CHART_TYPE_APR:
+		var aprs ds.Aprs
+		if addr != "" {
+			aprs, err = s.dashboard.AprsOf(ctx, addr, duration)
+		} else {
+			aprs, err = s.dashboard.Aprs(ctx, duration)
+		}
+		for _, v := range aprs {
+			chart.Items = append(chart.Items, &pb.ChartItem{Timestamp: v.Timestamp.Unix(), Value: v.Apr})
+		}
+	case pb.ChartType_CHART_TYPE_FEE:
+		var fees ds.Fees
+		if addr != "" {
+			fees, err = s.dashboard.FeesOf(ctx, addr, duration)
+		} else {
+			fees, err = s.dashboard.Fees(ctx, duration)
+		}
+		for _, v := range fees {
+			chart.Items = append(chart.Items, &pb.ChartItem{Timestamp: v.Timestamp.Unix(), Value: v.Fee})
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid chart type")
+	}
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	return chart, nil
+}
+
+func (s *dashboardServer) GetTokenChart(ctx context.Context, req *pb.GetTokenChartRequest) (*pb.Chart, error) {
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "must provide token address")
+	}
+	duration := toDuration(req.Duration)
+	addr := ds.Addr(req.Address)
+
+	var tokenChart ds.TokenChart
+	var err error
+	switch req.Type {
+	case pb.ChartType_CHART_TYPE_VOLUME:
+		tokenChart, err = s.dashboard.TokenVolumes(ctx, addr, duration)
+	case pb.ChartType_CHART_TYPE_TVL:
+		tokenChart, err = s.dashboard.TokenTvls(ctx, addr, duration)
+	case pb.ChartType_CHART_TYPE_PRICE:
+		tokenChart, err = s.dashboard.TokenPrices(ctx, addr, duration)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid chart type")
+	}
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+
+	chart := &pb.Chart{Items: make([]*pb.ChartItem, len(tokenChart))}
+	for i, v := range tokenChart {
+		timestamp, err := strconv.ParseInt(v.Timestamp, 10, 64)
+		if err != nil {
+			logging.WithContext(s.logger, ctx).Warn(err)
+			return nil, errInternal
+		}
+		chart.Items[i] = &pb.ChartItem{Timestamp: timestamp, Value: v.Value}
+	}
+	return chart, nil
+}
+
+func (s *dashboardServer) ListPoolSummaries(ctx context.Context, req *pb.ListPoolSummariesRequest) (*pb.ListPoolSummariesResponse, error) {
+	pools, err := s.dashboard.Pools(ctx, toAddrs(req.Tokens)...)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	res := &pb.ListPoolSummariesResponse{Pools: make([]*pb.PoolSummary, len(pools))}
+	for i, pool := range pools {
+		res.Pools[i] = &pb.PoolSummary{
+			Address: pool.Address,
+			Symbols: pool.Symbols,
+			Tvl:     pool.Tvl,
+			Volume:  pool.Volume,
+			Fee:     pool.Fee,
+			Apr:     pool.Apr,
+		}
+	}
+	return res, nil
+}
+
+func (s *dashboardServer) GetPoolDetail(ctx context.Context, req *pb.GetPoolDetailRequest) (*pb.PoolDetail, error) {
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	detail, err := s.dashboard.PoolDetail(ctx, ds.Addr(req.Address))
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	if !detail.Recent.PoolExists {
+		return nil, status.Error(codes.NotFound, "pool not found")
+	}
+	return &pb.PoolDetail{Recent: recentToPb(detail.Recent), Txs: txsToPb(detail.Txs)}, nil
+}
+
+func (s *dashboardServer) ListTokenSummaries(ctx context.Context, _ *pb.ListTokenSummariesRequest) (*pb.ListTokenSummariesResponse, error) {
+	tokens, err := s.dashboard.Tokens(ctx)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	res := &pb.ListTokenSummariesResponse{Tokens: make([]*pb.TokenSummary, len(tokens))}
+	for i, token := range tokens {
+		res.Tokens[i] = tokenSummaryToPb(token)
+	}
+	return res, nil
+}
+
+func (s *dashboardServer) GetTokenSummary(ctx context.Context, req *pb.GetTokenSummaryRequest) (*pb.TokenSummary, error) {
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	token, err := s.dashboard.Token(ctx, ds.Addr(req.Address))
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	if string(token.Addr) != req.Address {
+		return nil, status.Error(codes.NotFound, "token not found")
+	}
+	return tokenSummaryToPb(token), nil
+}
+
+func (s *dashboardServer) ListTxs(ctx context.Context, req *pb.ListTxsRequest) (*pb.ListTxsResponse, error) {
+	if req.Pool != "" && len(req.Tokens) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid query, must choose one of (pool or token, not both)")
+	}
+	txType := toTxType(req.Type)
+
+	var txs ds.Txs
+	var err error
+	switch {
+	case len(req.Tokens) > 0:
+		txs, err = s.dashboard.TxsOfToken(ctx, txType, toAddrs(req.Tokens)...)
+	case req.Pool != "":
+		txs, err = s.dashboard.Txs(ctx, txType, ds.Addr(req.Pool))
+	default:
+		txs, err = s.dashboard.Txs(ctx, txType)
+	}
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	return &pb.ListTxsResponse{Txs: txsToPb(txs)}, nil
+}
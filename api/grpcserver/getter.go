@@ -0,0 +1,186 @@
+package grpcserver
+
+import (
+	"context"
+	"slices"
+
+	"github.com/dezswap/dezswap-api/api/grpcserver/pb"
+	"github.com/dezswap/dezswap-api/api/v1/service"
+	"github.com/dezswap/dezswap-api/api/v1/service/stream"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type pairServer struct {
+	pb.UnimplementedPairServiceServer
+	pairs  service.Getter[service.Pair]
+	logger logging.Logger
+}
+
+func (s *pairServer) ListPairs(ctx context.Context, _ *pb.ListPairsRequest) (*pb.ListPairsResponse, error) {
+	pairs, err := s.pairs.GetAll(ctx)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	res := &pb.ListPairsResponse{Pairs: make([]*pb.Pair, len(pairs))}
+	for i, pair := range pairs {
+		res.Pairs[i] = pairToPb(pair)
+	}
+	return res, nil
+}
+
+func (s *pairServer) GetPair(ctx context.Context, req *pb.GetPairRequest) (*pb.Pair, error) {
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	pair, err := s.pairs.Get(ctx, req.Address)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	if pair == nil {
+		return nil, status.Error(codes.NotFound, "pair not found")
+	}
+	return pairToPb(*pair), nil
+}
+
+type poolServer struct {
+	pb.UnimplementedPoolServiceServer
+	pools  service.Getter[service.Pool]
+	stream stream.Service
+	logger logging.Logger
+}
+
+func (s *poolServer) ListPools(ctx context.Context, _ *pb.ListPoolsRequest) (*pb.ListPoolsResponse, error) {
+	pools, err := s.pools.GetAll(ctx)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	res := &pb.ListPoolsResponse{Pools: make([]*pb.Pool, len(pools))}
+	for i, pool := range pools {
+		res.Pools[i] = poolToPb(pool)
+	}
+	return res, nil
+}
+
+func (s *poolServer) GetPool(ctx context.Context, req *pb.GetPoolRequest) (*pb.Pool, error) {
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	pool, err := s.pools.Get(ctx, req.Address)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	if pool == nil {
+		return nil, status.Error(codes.NotFound, "pool not found")
+	}
+	return poolToPb(*pool), nil
+}
+
+func (s *poolServer) WatchPools(req *pb.WatchPoolsRequest, watcher grpc.ServerStreamingServer[pb.PoolUpdate]) error {
+	filter := stream.Filter{Topics: []stream.Topic{stream.TopicPool}, Pairs: req.Pairs, Tokens: req.Tokens}
+	err := s.stream.Subscribe(watcher.Context(), filter, req.FromHeight, func(event stream.Event) error {
+		pool := event.Pool
+		return watcher.Send(&pb.PoolUpdate{
+			Pair:         pool.Pair,
+			Asset0:       pool.Asset0,
+			Asset0Amount: pool.Asset0Amount,
+			Asset1:       pool.Asset1,
+			Asset1Amount: pool.Asset1Amount,
+			Height:       pool.Height,
+		})
+	})
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, stream.ErrTooManySubscribers):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, stream.ErrReplayTooFar):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, stream.ErrLagged):
+		return status.Error(codes.Aborted, err.Error())
+	case watcher.Context().Err() != nil:
+		return status.FromContextError(watcher.Context().Err()).Err()
+	}
+	logging.WithContext(s.logger, watcher.Context()).Warn(err)
+	return errInternal
+}
+
+type tokenServer struct {
+	pb.UnimplementedTokenServiceServer
+	tokens service.Getter[service.Token]
+	logger logging.Logger
+}
+
+func (s *tokenServer) ListTokens(ctx context.Context, _ *pb.ListTokensRequest) (*pb.ListTokensResponse, error) {
+	tokens, err := s.tokens.GetAll(ctx)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	res := &pb.ListTokensResponse{Tokens: make([]*pb.Token, len(tokens))}
+	for i, token := range tokens {
+		res.Tokens[i] = tokenToPb(token)
+	}
+	return res, nil
+}
+
+func (s *tokenServer) GetToken(ctx context.Context, req *pb.GetTokenRequest) (*pb.Token, error) {
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+	token, err := s.tokens.Get(ctx, req.Address)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	if token == nil {
+		return nil, status.Error(codes.NotFound, "token not found")
+	}
+	return tokenToPb(*token), nil
+}
+
+// statPeriods are the periods of the stats by service.PeriodTypeIdx
+var statPeriods = [service.CountOfPeriodType]string{"24h", "7d", "1mon"}
+
+type statServer struct {
+	pb.UnimplementedStatServiceServer
+	stats  service.Getter[service.PairStats]
+	logger logging.Logger
+}
+
+func (s *statServer) ListStats(ctx context.Context, _ *pb.ListStatsRequest) (*pb.ListStatsResponse, error) {
+	stats, err := s.stats.GetAll(ctx)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	res := &pb.ListStatsResponse{Stats: make([]*pb.Stat, 0, len(stats))}
+	for i, stat := range stats {
+		if i < len(statPeriods) {
+			res.Stats = append(res.Stats, statToPb(statPeriods[i], stat))
+		}
+	}
+	return res, nil
+}
+
+func (s *statServer) GetStat(ctx context.Context, req *pb.GetStatRequest) (*pb.Stat, error) {
+	if !slices.Contains(statPeriods[:], req.Period) {
+		return nil, status.Error(codes.InvalidArgument, "invalid period")
+	}
+	stat, err := s.stats.Get(ctx, req.Period)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	if stat == nil {
+		return nil, status.Error(codes.NotFound, "stat not found")
+	}
+	return statToPb(req.Period, *stat), nil
+}
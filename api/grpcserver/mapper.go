@@ -0,0 +1,139 @@
+package grpcserver
+
+import (
+	"github.com/dezswap/dezswap-api/api/grpcserver/pb"
+	"github.com/dezswap/dezswap-api/api/v1/service"
+	ds "github.com/dezswap/dezswap-api/api/v1/service/dashboard"
+)
+
+func tokenToPb(token service.Token) *pb.Token {
+	return &pb.Token{
+		Address:  token.Address,
+		ChainId:  token.ChainId,
+		Protocol: token.Protocol,
+		Symbol:   token.Symbol,
+		Name:     token.Name,
+		Decimals: uint32(token.Decimals),
+		Icon:     token.Icon,
+		Verified: token.Verified,
+	}
+}
+
+func pairToPb(pair service.Pair) *pb.Pair {
+	return &pb.Pair{
+		Address: pair.Address,
+		ChainId: pair.ChainId,
+		Asset0:  tokenToPb(pair.Asset0),
+		Asset1:  tokenToPb(pair.Asset1),
+		Lp:      tokenToPb(pair.Lp),
+	}
+}
+
+func poolToPb(pool service.Pool) *pb.Pool {
+	return &pb.Pool{
+		Address:      pool.Address,
+		Height:       pool.Height,
+		Asset0:       pool.Asset0,
+		Asset0Amount: pool.Asset0Amount,
+		Asset1:       pool.Asset1,
+		Asset1Amount: pool.Asset1Amount,
+		Lp:           pool.Lp,
+		LpAmount:     pool.LpAmount,
+	}
+}
+
+func statToPb(period string, stats service.PairStats) *pb.Stat {
+	stat := &pb.Stat{Period: period, Stats: make([]*pb.PairStat, len(stats))}
+	for i, s := range stats {
+		stat.Stats[i] = &pb.PairStat{
+			Address:    s.Address,
+			Volume:     s.VolumeInPrice,
+			Commission: s.CommissionInPrice,
+			Apr:        s.AprInPrice,
+		}
+	}
+	return stat
+}
+
+func recentToPb(recent ds.Recent) *pb.Recent {
+	return &pb.Recent{
+		Volume:           recent.Volume,
+		VolumeChangeRate: recent.VolumeChangeRate,
+		Fee:              recent.Fee,
+		FeeChangeRate:    recent.FeeChangeRate,
+		Tvl:              recent.Tvl,
+		TvlChangeRate:    recent.TvlChangeRate,
+		Apr:              recent.Apr,
+		AprChangeRate:    recent.AprChangeRate,
+	}
+}
+
+func tokenSummaryToPb(token ds.Token) *pb.TokenSummary {
+	return &pb.TokenSummary{
+		Address:            string(token.Addr),
+		Price:              token.Price,
+		PriceChange:        token.PriceChange,
+		MarketCap:          token.MarketCap,
+		Fdv:                token.Fdv,
+		Volume:             token.Volume,
+		VolumeChange:       token.VolumeChange,
+		WeeklyVolume:       token.Volume7d,
+		WeeklyVolumeChange: token.Volume7dChange,
+		Tvl:                token.Tvl,
+		TvlChange:          token.TvlChange,
+		Commission:         token.Commission,
+	}
+}
+
+func txsToPb(txs ds.Txs) []*pb.Tx {
+	res := make([]*pb.Tx, len(txs))
+	for i, tx := range txs {
+		res[i] = &pb.Tx{
+			Action:       tx.Action,
+			Hash:         tx.Hash,
+			Sender:       tx.Sender,
+			Address:      tx.Address,
+			Asset0:       tx.Asset0,
+			Asset0Symbol: tx.Asset0Symbol,
+			Asset0Amount: tx.Asset0Amount,
+			Asset1:       tx.Asset1,
+			Asset1Symbol: tx.Asset1Symbol,
+			Asset1Amount: tx.Asset1Amount,
+			TotalValue:   tx.TotalValue,
+			Timestamp:    tx.Timestamp.Unix(),
+		}
+	}
+	return res
+}
+
+func toAddrs(addrs []string) []ds.Addr {
+	res := make([]ds.Addr, len(addrs))
+	for i, addr := range addrs {
+		res[i] = ds.Addr(addr)
+	}
+	return res
+}
+
+func toDuration(duration pb.Duration) ds.Duration {
+	switch duration {
+	case pb.Duration_DURATION_MONTH:
+		return ds.Month
+	case pb.Duration_DURATION_QUARTER:
+		return ds.Quarter
+	case pb.Duration_DURATION_YEAR:
+		return ds.Year
+	}
+	return ds.All
+}
+
+func toTxType(txType pb.TxType) ds.TxType {
+	switch txType {
+	case pb.TxType_TX_TYPE_SWAP:
+		return ds.TX_TYPE_SWAP
+	case pb.TxType_TX_TYPE_PROVIDE:
+		return ds.TX_TYPE_PROVIDE
+	case pb.TxType_TX_TYPE_WITHDRAW:
+		return ds.TX_TYPE_WITHDRAW
+	}
+	return ds.TX_TYPE_ALL
+}
@@ -0,0 +1,3110 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: dezswap/v1/dezswap.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Duration int32
+
+const (
+	Duration_DURATION_ALL     Duration = 0
+	Duration_DURATION_MONTH   Duration = 1
+	Duration_DURATION_QUARTER Duration = 2
+	Duration_DURATION_YEAR    Duration = 3
+)
+
+// Enum value maps for Duration.
+var (
+	Duration_name = map[int32]string{
+		0: "DURATION_ALL",
+		1: "DURATION_MONTH",
+		2: "DURATION_QUARTER",
+		3: "DURATION_YEAR",
+	}
+	Duration_value = map[string]int32{
+		"DURATION_ALL":     0,
+		"DURATION_MONTH":   1,
+		"DURATION_QUARTER": 2,
+		"DURATION_YEAR":    3,
+	}
+)
+
+func (x Duration) Enum() *Duration {
+	p := new(Duration)
+	*p = x
+	return p
+}
+
+func (x Duration) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Duration) Descriptor() protoreflect.EnumDescriptor {
+	return file_dezswap_v1_dezswap_proto_enumTypes[0].Descriptor()
+}
+
+func (Duration) Type() protoreflect.EnumType {
+	return &file_dezswap_v1_dezswap_proto_enumTypes[0]
+}
+
+func (x Duration) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Duration.Descriptor instead.
+func (Duration) EnumDescriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{0}
+}
+
+type ChartType int32
+
+const (
+	ChartType_CHART_TYPE_UNSPECIFIED ChartType = 0
+	ChartType_CHART_TYPE_VOLUME      ChartType = 1
+	ChartType_CHART_TYPE_TVL         ChartType = 2
+	ChartType_CHART_TYPE_APR         ChartType = 3
+	ChartType_CHART_TYPE_FEE         ChartType = 4
+	ChartType_CHART_TYPE_PRICE       ChartType = 5
+)
+
+// Enum value maps for ChartType.
+var (
+	ChartType_name = map[int32]string{
+		0: "CHART_TYPE_UNSPECIFIED",
+		1: "CHART_TYPE_VOLUME",
+		2: "CHART_TYPE_TVL",
+		3: "CHART_TYPE_APR",
+		4: "CHART_TYPE_FEE",
+		5: "CHART_TYPE_PRICE",
+	}
+	ChartType_value = map[string]int32{
+		"CHART_TYPE_UNSPECIFIED": 0,
+		"CHART_TYPE_VOLUME":      1,
+		"CHART_TYPE_TVL":         2,
+		"CHART_TYPE_APR":         3,
+		"CHART_TYPE_FEE":         4,
+		"CHART_TYPE_PRICE":       5,
+	}
+)
+
+func (x ChartType) Enum() *ChartType {
+	p := new(ChartType)
+	*p = x
+	return p
+}
+
+func (x ChartType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChartType) Descriptor() protoreflect.EnumDescriptor {
+	return file_dezswap_v1_dezswap_proto_enumTypes[1].Descriptor()
+}
+
+func (ChartType) Type() protoreflect.EnumType {
+	return &file_dezswap_v1_dezswap_proto_enumTypes[1]
+}
+
+func (x ChartType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChartType.Descriptor instead.
+func (ChartType) EnumDescriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{1}
+}
+
+type TxType int32
+
+const (
+	TxType_TX_TYPE_ALL      TxType = 0
+	TxType_TX_TYPE_SWAP     TxType = 1
+	TxType_TX_TYPE_PROVIDE  TxType = 2
+	TxType_TX_TYPE_WITHDRAW TxType = 3
+)
+
+// Enum value maps for TxType.
+var (
+	TxType_name = map[int32]string{
+		0: "TX_TYPE_ALL",
+		1: "TX_TYPE_SWAP",
+		2: "TX_TYPE_PROVIDE",
+		3: "TX_TYPE_WITHDRAW",
+	}
+	TxType_value = map[string]int32{
+		"TX_TYPE_ALL":      0,
+		"TX_TYPE_SWAP":     1,
+		"TX_TYPE_PROVIDE":  2,
+		"TX_TYPE_WITHDRAW": 3,
+	}
+)
+
+func (x TxType) Enum() *TxType {
+	p := new(TxType)
+	*p = x
+	return p
+}
+
+func (x TxType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TxType) Descriptor() protoreflect.EnumDescriptor {
+	return file_dezswap_v1_dezswap_proto_enumTypes[2].Descriptor()
+}
+
+func (TxType) Type() protoreflect.EnumType {
+	return &file_dezswap_v1_dezswap_proto_enumTypes[2]
+}
+
+func (x TxType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TxType.Descriptor instead.
+func (TxType) EnumDescriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{2}
+}
+
+type Token struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ChainId       string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Symbol        string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Decimals      uint32                 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Icon          string                 `protobuf:"bytes,7,opt,name=icon,proto3" json:"icon,omitempty"`
+	Verified      bool                   `protobuf:"varint,8,opt,name=verified,proto3" json:"verified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Token) Reset() {
+	*x = Token{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Token) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Token) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *Token) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Token) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Token) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Token) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Token) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Token) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+type Pair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ChainId       string                 `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Asset0        *Token                 `protobuf:"bytes,3,opt,name=asset0,proto3" json:"asset0,omitempty"`
+	Asset1        *Token                 `protobuf:"bytes,4,opt,name=asset1,proto3" json:"asset1,omitempty"`
+	Lp            *Token                 `protobuf:"bytes,5,opt,name=lp,proto3" json:"lp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pair) Reset() {
+	*x = Pair{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pair) ProtoMessage() {}
+
+func (x *Pair) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pair.ProtoReflect.Descriptor instead.
+func (*Pair) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Pair) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pair) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *Pair) GetAsset0() *Token {
+	if x != nil {
+		return x.Asset0
+	}
+	return nil
+}
+
+func (x *Pair) GetAsset1() *Token {
+	if x != nil {
+		return x.Asset1
+	}
+	return nil
+}
+
+func (x *Pair) GetLp() *Token {
+	if x != nil {
+		return x.Lp
+	}
+	return nil
+}
+
+type ListPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPairsRequest) Reset() {
+	*x = ListPairsRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPairsRequest) ProtoMessage() {}
+
+func (x *ListPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPairsRequest.ProtoReflect.Descriptor instead.
+func (*ListPairsRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{2}
+}
+
+type ListPairsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pairs         []*Pair                `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPairsResponse) Reset() {
+	*x = ListPairsResponse{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPairsResponse) ProtoMessage() {}
+
+func (x *ListPairsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPairsResponse.ProtoReflect.Descriptor instead.
+func (*ListPairsResponse) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPairsResponse) GetPairs() []*Pair {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+type GetPairRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPairRequest) Reset() {
+	*x = GetPairRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPairRequest) ProtoMessage() {}
+
+func (x *GetPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPairRequest.ProtoReflect.Descriptor instead.
+func (*GetPairRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPairRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type Pool struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height        uint64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Asset0        string                 `protobuf:"bytes,3,opt,name=asset0,proto3" json:"asset0,omitempty"`
+	Asset0Amount  string                 `protobuf:"bytes,4,opt,name=asset0_amount,json=asset0Amount,proto3" json:"asset0_amount,omitempty"`
+	Asset1        string                 `protobuf:"bytes,5,opt,name=asset1,proto3" json:"asset1,omitempty"`
+	Asset1Amount  string                 `protobuf:"bytes,6,opt,name=asset1_amount,json=asset1Amount,proto3" json:"asset1_amount,omitempty"`
+	Lp            string                 `protobuf:"bytes,7,opt,name=lp,proto3" json:"lp,omitempty"`
+	LpAmount      string                 `protobuf:"bytes,8,opt,name=lp_amount,json=lpAmount,proto3" json:"lp_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pool) Reset() {
+	*x = Pool{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pool) ProtoMessage() {}
+
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Pool) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Pool) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Pool) GetAsset0() string {
+	if x != nil {
+		return x.Asset0
+	}
+	return ""
+}
+
+func (x *Pool) GetAsset0Amount() string {
+	if x != nil {
+		return x.Asset0Amount
+	}
+	return ""
+}
+
+func (x *Pool) GetAsset1() string {
+	if x != nil {
+		return x.Asset1
+	}
+	return ""
+}
+
+func (x *Pool) GetAsset1Amount() string {
+	if x != nil {
+		return x.Asset1Amount
+	}
+	return ""
+}
+
+func (x *Pool) GetLp() string {
+	if x != nil {
+		return x.Lp
+	}
+	return ""
+}
+
+func (x *Pool) GetLpAmount() string {
+	if x != nil {
+		return x.LpAmount
+	}
+	return ""
+}
+
+type ListPoolsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoolsRequest) Reset() {
+	*x = ListPoolsRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolsRequest) ProtoMessage() {}
+
+func (x *ListPoolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolsRequest.ProtoReflect.Descriptor instead.
+func (*ListPoolsRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{6}
+}
+
+type ListPoolsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pools         []*Pool                `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoolsResponse) Reset() {
+	*x = ListPoolsResponse{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolsResponse) ProtoMessage() {}
+
+func (x *ListPoolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolsResponse.ProtoReflect.Descriptor instead.
+func (*ListPoolsResponse) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPoolsResponse) GetPools() []*Pool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type GetPoolRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPoolRequest) Reset() {
+	*x = GetPoolRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolRequest) ProtoMessage() {}
+
+func (x *GetPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolRequest.ProtoReflect.Descriptor instead.
+func (*GetPoolRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPoolRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type WatchPoolsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pairs and tokens filter the pools of the pairs or of the tokens, every pool when both are empty
+	Pairs         []string `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	Tokens        []string `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	FromHeight    uint64   `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPoolsRequest) Reset() {
+	*x = WatchPoolsRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPoolsRequest) ProtoMessage() {}
+
+func (x *WatchPoolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPoolsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPoolsRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchPoolsRequest) GetPairs() []string {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *WatchPoolsRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *WatchPoolsRequest) GetFromHeight() uint64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+type PoolUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pair          string                 `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset0        string                 `protobuf:"bytes,2,opt,name=asset0,proto3" json:"asset0,omitempty"`
+	Asset0Amount  string                 `protobuf:"bytes,3,opt,name=asset0_amount,json=asset0Amount,proto3" json:"asset0_amount,omitempty"`
+	Asset1        string                 `protobuf:"bytes,4,opt,name=asset1,proto3" json:"asset1,omitempty"`
+	Asset1Amount  string                 `protobuf:"bytes,5,opt,name=asset1_amount,json=asset1Amount,proto3" json:"asset1_amount,omitempty"`
+	Height        uint64                 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoolUpdate) Reset() {
+	*x = PoolUpdate{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolUpdate) ProtoMessage() {}
+
+func (x *PoolUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolUpdate.ProtoReflect.Descriptor instead.
+func (*PoolUpdate) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PoolUpdate) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *PoolUpdate) GetAsset0() string {
+	if x != nil {
+		return x.Asset0
+	}
+	return ""
+}
+
+func (x *PoolUpdate) GetAsset0Amount() string {
+	if x != nil {
+		return x.Asset0Amount
+	}
+	return ""
+}
+
+func (x *PoolUpdate) GetAsset1() string {
+	if x != nil {
+		return x.Asset1
+	}
+	return ""
+}
+
+func (x *PoolUpdate) GetAsset1Amount() string {
+	if x != nil {
+		return x.Asset1Amount
+	}
+	return ""
+}
+
+func (x *PoolUpdate) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ListTokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTokensRequest) Reset() {
+	*x = ListTokensRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokensRequest) ProtoMessage() {}
+
+func (x *ListTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListTokensRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{11}
+}
+
+type ListTokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*Token               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTokensResponse) Reset() {
+	*x = ListTokensResponse{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokensResponse) ProtoMessage() {}
+
+func (x *ListTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListTokensResponse) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListTokensResponse) GetTokens() []*Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type GetTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenRequest) Reset() {
+	*x = GetTokenRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenRequest) ProtoMessage() {}
+
+func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTokenRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type PairStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Volume        string                 `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Commission    string                 `protobuf:"bytes,3,opt,name=commission,proto3" json:"commission,omitempty"`
+	Apr           string                 `protobuf:"bytes,4,opt,name=apr,proto3" json:"apr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PairStat) Reset() {
+	*x = PairStat{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PairStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairStat) ProtoMessage() {}
+
+func (x *PairStat) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairStat.ProtoReflect.Descriptor instead.
+func (*PairStat) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PairStat) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PairStat) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *PairStat) GetCommission() string {
+	if x != nil {
+		return x.Commission
+	}
+	return ""
+}
+
+func (x *PairStat) GetApr() string {
+	if x != nil {
+		return x.Apr
+	}
+	return ""
+}
+
+type Stat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// period is one of 24h, 7d and 1mon
+	Period        string      `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Stats         []*PairStat `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stat) Reset() {
+	*x = Stat{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stat) ProtoMessage() {}
+
+func (x *Stat) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stat.ProtoReflect.Descriptor instead.
+func (*Stat) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Stat) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Stat) GetStats() []*PairStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ListStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatsRequest) Reset() {
+	*x = ListStatsRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatsRequest) ProtoMessage() {}
+
+func (x *ListStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListStatsRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{16}
+}
+
+type ListStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*Stat                `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStatsResponse) Reset() {
+	*x = ListStatsResponse{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatsResponse) ProtoMessage() {}
+
+func (x *ListStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListStatsResponse) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListStatsResponse) GetStats() []*Stat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetStatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatRequest) Reset() {
+	*x = GetStatRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatRequest) ProtoMessage() {}
+
+func (x *GetStatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatRequest.ProtoReflect.Descriptor instead.
+func (*GetStatRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetStatRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type ListRoutesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// hop_count is the maximum number of the hops, every route when zero
+	HopCount      int32  `protobuf:"varint,3,opt,name=hop_count,json=hopCount,proto3" json:"hop_count,omitempty"`
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	AskAmount     string `protobuf:"bytes,5,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoutesRequest) Reset() {
+	*x = ListRoutesRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoutesRequest) ProtoMessage() {}
+
+func (x *ListRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoutesRequest.ProtoReflect.Descriptor instead.
+func (*ListRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListRoutesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListRoutesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListRoutesRequest) GetHopCount() int32 {
+	if x != nil {
+		return x.HopCount
+	}
+	return 0
+}
+
+func (x *ListRoutesRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ListRoutesRequest) GetAskAmount() string {
+	if x != nil {
+		return x.AskAmount
+	}
+	return ""
+}
+
+type Route struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	From     string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To       string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	HopCount int32                  `protobuf:"varint,3,opt,name=hop_count,json=hopCount,proto3" json:"hop_count,omitempty"`
+	Route    []string               `protobuf:"bytes,4,rep,name=route,proto3" json:"route,omitempty"`
+	// the quote of the route, empty when not quoted
+	OfferAmount   string `protobuf:"bytes,5,opt,name=offer_amount,json=offerAmount,proto3" json:"offer_amount,omitempty"`
+	ReturnAmount  string `protobuf:"bytes,6,opt,name=return_amount,json=returnAmount,proto3" json:"return_amount,omitempty"`
+	PriceImpact   string `protobuf:"bytes,7,opt,name=price_impact,json=priceImpact,proto3" json:"price_impact,omitempty"`
+	FeeRate       string `protobuf:"bytes,8,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Route) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Route) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Route) GetHopCount() int32 {
+	if x != nil {
+		return x.HopCount
+	}
+	return 0
+}
+
+func (x *Route) GetRoute() []string {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *Route) GetOfferAmount() string {
+	if x != nil {
+		return x.OfferAmount
+	}
+	return ""
+}
+
+func (x *Route) GetReturnAmount() string {
+	if x != nil {
+		return x.ReturnAmount
+	}
+	return ""
+}
+
+func (x *Route) GetPriceImpact() string {
+	if x != nil {
+		return x.PriceImpact
+	}
+	return ""
+}
+
+func (x *Route) GetFeeRate() string {
+	if x != nil {
+		return x.FeeRate
+	}
+	return ""
+}
+
+type ListRoutesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Routes        []*Route               `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoutesResponse) Reset() {
+	*x = ListRoutesResponse{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoutesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoutesResponse) ProtoMessage() {}
+
+func (x *ListRoutesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoutesResponse.ProtoReflect.Descriptor instead.
+func (*ListRoutesResponse) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListRoutesResponse) GetRoutes() []*Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type GetRecentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// address is of a pool, the recent of every pool when empty
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecentRequest) Reset() {
+	*x = GetRecentRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentRequest) ProtoMessage() {}
+
+func (x *GetRecentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRecentRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type Recent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Volume           string                 `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	VolumeChangeRate float32                `protobuf:"fixed32,2,opt,name=volume_change_rate,json=volumeChangeRate,proto3" json:"volume_change_rate,omitempty"`
+	Fee              string                 `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeChangeRate    float32                `protobuf:"fixed32,4,opt,name=fee_change_rate,json=feeChangeRate,proto3" json:"fee_change_rate,omitempty"`
+	Tvl              string                 `protobuf:"bytes,5,opt,name=tvl,proto3" json:"tvl,omitempty"`
+	TvlChangeRate    float32                `protobuf:"fixed32,6,opt,name=tvl_change_rate,json=tvlChangeRate,proto3" json:"tvl_change_rate,omitempty"`
+	Apr              float32                `protobuf:"fixed32,7,opt,name=apr,proto3" json:"apr,omitempty"`
+	AprChangeRate    float32                `protobuf:"fixed32,8,opt,name=apr_change_rate,json=aprChangeRate,proto3" json:"apr_change_rate,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Recent) Reset() {
+	*x = Recent{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recent) ProtoMessage() {}
+
+func (x *Recent) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recent.ProtoReflect.Descriptor instead.
+func (*Recent) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Recent) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *Recent) GetVolumeChangeRate() float32 {
+	if x != nil {
+		return x.VolumeChangeRate
+	}
+	return 0
+}
+
+func (x *Recent) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *Recent) GetFeeChangeRate() float32 {
+	if x != nil {
+		return x.FeeChangeRate
+	}
+	return 0
+}
+
+func (x *Recent) GetTvl() string {
+	if x != nil {
+		return x.Tvl
+	}
+	return ""
+}
+
+func (x *Recent) GetTvlChangeRate() float32 {
+	if x != nil {
+		return x.TvlChangeRate
+	}
+	return 0
+}
+
+func (x *Recent) GetApr() float32 {
+	if x != nil {
+		return x.Apr
+	}
+	return 0
+}
+
+func (x *Recent) GetAprChangeRate() float32 {
+	if x != nil {
+		return x.AprChangeRate
+	}
+	return 0
+}
+
+type GetStatisticRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatisticRequest) Reset() {
+	*x = GetStatisticRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatisticRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatisticRequest) ProtoMessage() {}
+
+func (x *GetStatisticRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatisticRequest.ProtoReflect.Descriptor instead.
+func (*GetStatisticRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetStatisticRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type StatisticItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AddressCount  uint64                 `protobuf:"varint,1,opt,name=address_count,json=addressCount,proto3" json:"address_count,omitempty"`
+	TxCount       uint64                 `protobuf:"varint,2,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	Fee           string                 `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatisticItem) Reset() {
+	*x = StatisticItem{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatisticItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatisticItem) ProtoMessage() {}
+
+func (x *StatisticItem) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatisticItem.ProtoReflect.Descriptor instead.
+func (*StatisticItem) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StatisticItem) GetAddressCount() uint64 {
+	if x != nil {
+		return x.AddressCount
+	}
+	return 0
+}
+
+func (x *StatisticItem) GetTxCount() uint64 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+func (x *StatisticItem) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *StatisticItem) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetStatisticResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StatisticItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatisticResponse) Reset() {
+	*x = GetStatisticResponse{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatisticResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatisticResponse) ProtoMessage() {}
+
+func (x *GetStatisticResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatisticResponse.ProtoReflect.Descriptor instead.
+func (*GetStatisticResponse) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetStatisticResponse) GetItems() []*StatisticItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetChartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// address is of a pool, the chart of every pool when empty
+	Address       string    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Type          ChartType `protobuf:"varint,2,opt,name=type,proto3,enum=dezswap.v1.ChartType" json:"type,omitempty"`
+	Duration      Duration  `protobuf:"varint,3,opt,name=duration,proto3,enum=dezswap.v1.Duration" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChartRequest) Reset() {
+	*x = GetChartRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChartRequest) ProtoMessage() {}
+
+func (x *GetChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChartRequest.ProtoReflect.Descriptor instead.
+func (*GetChartRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetChartRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetChartRequest) GetType() ChartType {
+	if x != nil {
+		return x.Type
+	}
+	return ChartType_CHART_TYPE_UNSPECIFIED
+}
+
+func (x *GetChartRequest) GetDuration() Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return Duration_DURATION_ALL
+}
+
+type GetTokenChartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Type          ChartType              `protobuf:"varint,2,opt,name=type,proto3,enum=dezswap.v1.ChartType" json:"type,omitempty"`
+	Duration      Duration               `protobuf:"varint,3,opt,name=duration,proto3,enum=dezswap.v1.Duration" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenChartRequest) Reset() {
+	*x = GetTokenChartRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenChartRequest) ProtoMessage() {}
+
+func (x *GetTokenChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenChartRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenChartRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTokenChartRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetTokenChartRequest) GetType() ChartType {
+	if x != nil {
+		return x.Type
+	}
+	return ChartType_CHART_TYPE_UNSPECIFIED
+}
+
+func (x *GetTokenChartRequest) GetDuration() Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return Duration_DURATION_ALL
+}
+
+type ChartItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// timestamp is in unix seconds
+	Timestamp     int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChartItem) Reset() {
+	*x = ChartItem{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartItem) ProtoMessage() {}
+
+func (x *ChartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartItem.ProtoReflect.Descriptor instead.
+func (*ChartItem) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChartItem) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ChartItem) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Chart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ChartItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chart) Reset() {
+	*x = Chart{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chart) ProtoMessage() {}
+
+func (x *Chart) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chart.ProtoReflect.Descriptor instead.
+func (*Chart) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Chart) GetItems() []*ChartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PoolSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Symbols       string                 `protobuf:"bytes,2,opt,name=symbols,proto3" json:"symbols,omitempty"`
+	Tvl           string                 `protobuf:"bytes,3,opt,name=tvl,proto3" json:"tvl,omitempty"`
+	Volume        string                 `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	Fee           string                 `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Apr           string                 `protobuf:"bytes,6,opt,name=apr,proto3" json:"apr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoolSummary) Reset() {
+	*x = PoolSummary{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolSummary) ProtoMessage() {}
+
+func (x *PoolSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolSummary.ProtoReflect.Descriptor instead.
+func (*PoolSummary) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PoolSummary) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PoolSummary) GetSymbols() string {
+	if x != nil {
+		return x.Symbols
+	}
+	return ""
+}
+
+func (x *PoolSummary) GetTvl() string {
+	if x != nil {
+		return x.Tvl
+	}
+	return ""
+}
+
+func (x *PoolSummary) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *PoolSummary) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *PoolSummary) GetApr() string {
+	if x != nil {
+		return x.Apr
+	}
+	return ""
+}
+
+type ListPoolSummariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []string               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoolSummariesRequest) Reset() {
+	*x = ListPoolSummariesRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoolSummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolSummariesRequest) ProtoMessage() {}
+
+func (x *ListPoolSummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolSummariesRequest.ProtoReflect.Descriptor instead.
+func (*ListPoolSummariesRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPoolSummariesRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type ListPoolSummariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pools         []*PoolSummary         `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoolSummariesResponse) Reset() {
+	*x = ListPoolSummariesResponse{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoolSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolSummariesResponse) ProtoMessage() {}
+
+func (x *ListPoolSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolSummariesResponse.ProtoReflect.Descriptor instead.
+func (*ListPoolSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPoolSummariesResponse) GetPools() []*PoolSummary {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+type GetPoolDetailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPoolDetailRequest) Reset() {
+	*x = GetPoolDetailRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPoolDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPoolDetailRequest) ProtoMessage() {}
+
+func (x *GetPoolDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPoolDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetPoolDetailRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPoolDetailRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type PoolDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recent        *Recent                `protobuf:"bytes,1,opt,name=recent,proto3" json:"recent,omitempty"`
+	Txs           []*Tx                  `protobuf:"bytes,2,rep,name=txs,proto3" json:"txs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoolDetail) Reset() {
+	*x = PoolDetail{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoolDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolDetail) ProtoMessage() {}
+
+func (x *PoolDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolDetail.ProtoReflect.Descriptor instead.
+func (*PoolDetail) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PoolDetail) GetRecent() *Recent {
+	if x != nil {
+		return x.Recent
+	}
+	return nil
+}
+
+func (x *PoolDetail) GetTxs() []*Tx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+type TokenSummary struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Address            string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Price              string                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	PriceChange        float32                `protobuf:"fixed32,3,opt,name=price_change,json=priceChange,proto3" json:"price_change,omitempty"`
+	MarketCap          string                 `protobuf:"bytes,4,opt,name=market_cap,json=marketCap,proto3" json:"market_cap,omitempty"`
+	Fdv                string                 `protobuf:"bytes,5,opt,name=fdv,proto3" json:"fdv,omitempty"`
+	Volume             string                 `protobuf:"bytes,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	VolumeChange       string                 `protobuf:"bytes,7,opt,name=volume_change,json=volumeChange,proto3" json:"volume_change,omitempty"`
+	WeeklyVolume       string                 `protobuf:"bytes,8,opt,name=weekly_volume,json=weeklyVolume,proto3" json:"weekly_volume,omitempty"`
+	WeeklyVolumeChange string                 `protobuf:"bytes,9,opt,name=weekly_volume_change,json=weeklyVolumeChange,proto3" json:"weekly_volume_change,omitempty"`
+	Tvl                string                 `protobuf:"bytes,10,opt,name=tvl,proto3" json:"tvl,omitempty"`
+	TvlChange          string                 `protobuf:"bytes,11,opt,name=tvl_change,json=tvlChange,proto3" json:"tvl_change,omitempty"`
+	Commission         string                 `protobuf:"bytes,12,opt,name=commission,proto3" json:"commission,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TokenSummary) Reset() {
+	*x = TokenSummary{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenSummary) ProtoMessage() {}
+
+func (x *TokenSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenSummary.ProtoReflect.Descriptor instead.
+func (*TokenSummary) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TokenSummary) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TokenSummary) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *TokenSummary) GetPriceChange() float32 {
+	if x != nil {
+		return x.PriceChange
+	}
+	return 0
+}
+
+func (x *TokenSummary) GetMarketCap() string {
+	if x != nil {
+		return x.MarketCap
+	}
+	return ""
+}
+
+func (x *TokenSummary) GetFdv() string {
+	if x != nil {
+		return x.Fdv
+	}
+	return ""
+}
+
+func (x *TokenSummary) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *TokenSummary) GetVolumeChange() string {
+	if x != nil {
+		return x.VolumeChange
+	}
+	return ""
+}
+
+func (x *TokenSummary) GetWeeklyVolume() string {
+	if x != nil {
+		return x.WeeklyVolume
+	}
+	return ""
+}
+
+func (x *TokenSummary) GetWeeklyVolumeChange() string {
+	if x != nil {
+		return x.WeeklyVolumeChange
+	}
+	return ""
+}
+
+func (x *TokenSummary) GetTvl() string {
+	if x != nil {
+		return x.Tvl
+	}
+	return ""
+}
+
+func (x *TokenSummary) GetTvlChange() string {
+	if x != nil {
+		return x.TvlChange
+	}
+	return ""
+}
+
+func (x *TokenSummary) GetCommission() string {
+	if x != nil {
+		return x.Commission
+	}
+	return ""
+}
+
+type ListTokenSummariesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTokenSummariesRequest) Reset() {
+	*x = ListTokenSummariesRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokenSummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokenSummariesRequest) ProtoMessage() {}
+
+func (x *ListTokenSummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokenSummariesRequest.ProtoReflect.Descriptor instead.
+func (*ListTokenSummariesRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{37}
+}
+
+type ListTokenSummariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*TokenSummary        `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTokenSummariesResponse) Reset() {
+	*x = ListTokenSummariesResponse{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTokenSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTokenSummariesResponse) ProtoMessage() {}
+
+func (x *ListTokenSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTokenSummariesResponse.ProtoReflect.Descriptor instead.
+func (*ListTokenSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListTokenSummariesResponse) GetTokens() []*TokenSummary {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type GetTokenSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenSummaryRequest) Reset() {
+	*x = GetTokenSummaryRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenSummaryRequest) ProtoMessage() {}
+
+func (x *GetTokenSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetTokenSummaryRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ListTxsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  TxType                 `protobuf:"varint,1,opt,name=type,proto3,enum=dezswap.v1.TxType" json:"type,omitempty"`
+	// pool and tokens are exclusive, the txs of every pool when both are empty
+	Pool          string   `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	Tokens        []string `protobuf:"bytes,3,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTxsRequest) Reset() {
+	*x = ListTxsRequest{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTxsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTxsRequest) ProtoMessage() {}
+
+func (x *ListTxsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTxsRequest.ProtoReflect.Descriptor instead.
+func (*ListTxsRequest) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListTxsRequest) GetType() TxType {
+	if x != nil {
+		return x.Type
+	}
+	return TxType_TX_TYPE_ALL
+}
+
+func (x *ListTxsRequest) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *ListTxsRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type Tx struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// action is one of swap, provide and withdraw
+	Action        string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Hash          string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Sender        string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Address       string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Asset0        string `protobuf:"bytes,5,opt,name=asset0,proto3" json:"asset0,omitempty"`
+	Asset0Symbol  string `protobuf:"bytes,6,opt,name=asset0_symbol,json=asset0Symbol,proto3" json:"asset0_symbol,omitempty"`
+	Asset0Amount  string `protobuf:"bytes,7,opt,name=asset0_amount,json=asset0Amount,proto3" json:"asset0_amount,omitempty"`
+	Asset1        string `protobuf:"bytes,8,opt,name=asset1,proto3" json:"asset1,omitempty"`
+	Asset1Symbol  string `protobuf:"bytes,9,opt,name=asset1_symbol,json=asset1Symbol,proto3" json:"asset1_symbol,omitempty"`
+	Asset1Amount  string `protobuf:"bytes,10,opt,name=asset1_amount,json=asset1Amount,proto3" json:"asset1_amount,omitempty"`
+	TotalValue    string `protobuf:"bytes,11,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	Timestamp     int64  `protobuf:"varint,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tx) Reset() {
+	*x = Tx{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tx) ProtoMessage() {}
+
+func (x *Tx) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tx.ProtoReflect.Descriptor instead.
+func (*Tx) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Tx) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Tx) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Tx) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *Tx) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Tx) GetAsset0() string {
+	if x != nil {
+		return x.Asset0
+	}
+	return ""
+}
+
+func (x *Tx) GetAsset0Symbol() string {
+	if x != nil {
+		return x.Asset0Symbol
+	}
+	return ""
+}
+
+func (x *Tx) GetAsset0Amount() string {
+	if x != nil {
+		return x.Asset0Amount
+	}
+	return ""
+}
+
+func (x *Tx) GetAsset1() string {
+	if x != nil {
+		return x.Asset1
+	}
+	return ""
+}
+
+func (x *Tx) GetAsset1Symbol() string {
+	if x != nil {
+		return x.Asset1Symbol
+	}
+	return ""
+}
+
+func (x *Tx) GetAsset1Amount() string {
+	if x != nil {
+		return x.Asset1Amount
+	}
+	return ""
+}
+
+func (x *Tx) GetTotalValue() string {
+	if x != nil {
+		return x.TotalValue
+	}
+	return ""
+}
+
+func (x *Tx) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ListTxsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Txs           []*Tx                  `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTxsResponse) Reset() {
+	*x = ListTxsResponse{}
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTxsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTxsResponse) ProtoMessage() {}
+
+func (x *ListTxsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dezswap_v1_dezswap_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTxsResponse.ProtoReflect.Descriptor instead.
+func (*ListTxsResponse) Descriptor() ([]byte, []int) {
+	return file_dezswap_v1_dezswap_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListTxsResponse) GetTxs() []*Tx {
+	if x != nil {
+		return x.Txs
+	}
+	return nil
+}
+
+var File_dezswap_v1_dezswap_proto protoreflect.FileDescriptor
+
+const file_dezswap_v1_dezswap_proto_rawDesc = "" +
+	"\n" +
+	"\x18dezswap/v1/dezswap.proto\x12\n" +
+	"dezswap.v1\"\xd0\x01\n" +
+	"\x05Token\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12\x1a\n" +
+	"\bprotocol\x18\x03 \x01(\tR\bprotocol\x12\x16\n" +
+	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x1a\n" +
+	"\bdecimals\x18\x06 \x01(\rR\bdecimals\x12\x12\n" +
+	"\x04icon\x18\a \x01(\tR\x04icon\x12\x1a\n" +
+	"\bverified\x18\b \x01(\bR\bverified\"\xb4\x01\n" +
+	"\x04Pair\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x19\n" +
+	"\bchain_id\x18\x02 \x01(\tR\achainId\x12)\n" +
+	"\x06asset0\x18\x03 \x01(\v2\x11.dezswap.v1.TokenR\x06asset0\x12)\n" +
+	"\x06asset1\x18\x04 \x01(\v2\x11.dezswap.v1.TokenR\x06asset1\x12!\n" +
+	"\x02lp\x18\x05 \x01(\v2\x11.dezswap.v1.TokenR\x02lp\"\x12\n" +
+	"\x10ListPairsRequest\";\n" +
+	"\x11ListPairsResponse\x12&\n" +
+	"\x05pairs\x18\x01 \x03(\v2\x10.dezswap.v1.PairR\x05pairs\"*\n" +
+	"\x0eGetPairRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\xdf\x01\n" +
+	"\x04Pool\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x04R\x06height\x12\x16\n" +
+	"\x06asset0\x18\x03 \x01(\tR\x06asset0\x12#\n" +
+	"\rasset0_amount\x18\x04 \x01(\tR\fasset0Amount\x12\x16\n" +
+	"\x06asset1\x18\x05 \x01(\tR\x06asset1\x12#\n" +
+	"\rasset1_amount\x18\x06 \x01(\tR\fasset1Amount\x12\x0e\n" +
+	"\x02lp\x18\a \x01(\tR\x02lp\x12\x1b\n" +
+	"\tlp_amount\x18\b \x01(\tR\blpAmount\"\x12\n" +
+	"\x10ListPoolsRequest\";\n" +
+	"\x11ListPoolsResponse\x12&\n" +
+	"\x05pools\x18\x01 \x03(\v2\x10.dezswap.v1.PoolR\x05pools\"*\n" +
+	"\x0eGetPoolRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"b\n" +
+	"\x11WatchPoolsRequest\x12\x14\n" +
+	"\x05pairs\x18\x01 \x03(\tR\x05pairs\x12\x16\n" +
+	"\x06tokens\x18\x02 \x03(\tR\x06tokens\x12\x1f\n" +
+	"\vfrom_height\x18\x03 \x01(\x04R\n" +
+	"fromHeight\"\xb2\x01\n" +
+	"\n" +
+	"PoolUpdate\x12\x12\n" +
+	"\x04pair\x18\x01 \x01(\tR\x04pair\x12\x16\n" +
+	"\x06asset0\x18\x02 \x01(\tR\x06asset0\x12#\n" +
+	"\rasset0_amount\x18\x03 \x01(\tR\fasset0Amount\x12\x16\n" +
+	"\x06asset1\x18\x04 \x01(\tR\x06asset1\x12#\n" +
+	"\rasset1_amount\x18\x05 \x01(\tR\fasset1Amount\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x04R\x06height\"\x13\n" +
+	"\x11ListTokensRequest\"?\n" +
+	"\x12ListTokensResponse\x12)\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x11.dezswap.v1.TokenR\x06tokens\"+\n" +
+	"\x0fGetTokenRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"n\n" +
+	"\bPairStat\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06volume\x18\x02 \x01(\tR\x06volume\x12\x1e\n" +
+	"\n" +
+	"commission\x18\x03 \x01(\tR\n" +
+	"commission\x12\x10\n" +
+	"\x03apr\x18\x04 \x01(\tR\x03apr\"J\n" +
+	"\x04Stat\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12*\n" +
+	"\x05stats\x18\x02 \x03(\v2\x14.dezswap.v1.PairStatR\x05stats\"\x12\n" +
+	"\x10ListStatsRequest\";\n" +
+	"\x11ListStatsResponse\x12&\n" +
+	"\x05stats\x18\x01 \x03(\v2\x10.dezswap.v1.StatR\x05stats\"(\n" +
+	"\x0eGetStatRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\"\x8b\x01\n" +
+	"\x11ListRoutesRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1b\n" +
+	"\thop_count\x18\x03 \x01(\x05R\bhopCount\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1d\n" +
+	"\n" +
+	"ask_amount\x18\x05 \x01(\tR\taskAmount\"\xe4\x01\n" +
+	"\x05Route\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1b\n" +
+	"\thop_count\x18\x03 \x01(\x05R\bhopCount\x12\x14\n" +
+	"\x05route\x18\x04 \x03(\tR\x05route\x12!\n" +
+	"\foffer_amount\x18\x05 \x01(\tR\vofferAmount\x12#\n" +
+	"\rreturn_amount\x18\x06 \x01(\tR\freturnAmount\x12!\n" +
+	"\fprice_impact\x18\a \x01(\tR\vpriceImpact\x12\x19\n" +
+	"\bfee_rate\x18\b \x01(\tR\afeeRate\"?\n" +
+	"\x12ListRoutesResponse\x12)\n" +
+	"\x06routes\x18\x01 \x03(\v2\x11.dezswap.v1.RouteR\x06routes\",\n" +
+	"\x10GetRecentRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"\xfc\x01\n" +
+	"\x06Recent\x12\x16\n" +
+	"\x06volume\x18\x01 \x01(\tR\x06volume\x12,\n" +
+	"\x12volume_change_rate\x18\x02 \x01(\x02R\x10volumeChangeRate\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\tR\x03fee\x12&\n" +
+	"\x0ffee_change_rate\x18\x04 \x01(\x02R\rfeeChangeRate\x12\x10\n" +
+	"\x03tvl\x18\x05 \x01(\tR\x03tvl\x12&\n" +
+	"\x0ftvl_change_rate\x18\x06 \x01(\x02R\rtvlChangeRate\x12\x10\n" +
+	"\x03apr\x18\a \x01(\x02R\x03apr\x12&\n" +
+	"\x0fapr_change_rate\x18\b \x01(\x02R\raprChangeRate\"3\n" +
+	"\x13GetStatisticRequest\x12\x1c\n" +
+	"\taddresses\x18\x01 \x03(\tR\taddresses\"\x7f\n" +
+	"\rStatisticItem\x12#\n" +
+	"\raddress_count\x18\x01 \x01(\x04R\faddressCount\x12\x19\n" +
+	"\btx_count\x18\x02 \x01(\x04R\atxCount\x12\x10\n" +
+	"\x03fee\x18\x03 \x01(\tR\x03fee\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\x03R\ttimestamp\"G\n" +
+	"\x14GetStatisticResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.dezswap.v1.StatisticItemR\x05items\"\x88\x01\n" +
+	"\x0fGetChartRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12)\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.dezswap.v1.ChartTypeR\x04type\x120\n" +
+	"\bduration\x18\x03 \x01(\x0e2\x14.dezswap.v1.DurationR\bduration\"\x8d\x01\n" +
+	"\x14GetTokenChartRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12)\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.dezswap.v1.ChartTypeR\x04type\x120\n" +
+	"\bduration\x18\x03 \x01(\x0e2\x14.dezswap.v1.DurationR\bduration\"?\n" +
+	"\tChartItem\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"4\n" +
+	"\x05Chart\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.dezswap.v1.ChartItemR\x05items\"\x8f\x01\n" +
+	"\vPoolSummary\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x18\n" +
+	"\asymbols\x18\x02 \x01(\tR\asymbols\x12\x10\n" +
+	"\x03tvl\x18\x03 \x01(\tR\x03tvl\x12\x16\n" +
+	"\x06volume\x18\x04 \x01(\tR\x06volume\x12\x10\n" +
+	"\x03fee\x18\x05 \x01(\tR\x03fee\x12\x10\n" +
+	"\x03apr\x18\x06 \x01(\tR\x03apr\"2\n" +
+	"\x18ListPoolSummariesRequest\x12\x16\n" +
+	"\x06tokens\x18\x01 \x03(\tR\x06tokens\"J\n" +
+	"\x19ListPoolSummariesResponse\x12-\n" +
+	"\x05pools\x18\x01 \x03(\v2\x17.dezswap.v1.PoolSummaryR\x05pools\"0\n" +
+	"\x14GetPoolDetailRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"Z\n" +
+	"\n" +
+	"PoolDetail\x12*\n" +
+	"\x06recent\x18\x01 \x01(\v2\x12.dezswap.v1.RecentR\x06recent\x12 \n" +
+	"\x03txs\x18\x02 \x03(\v2\x0e.dezswap.v1.TxR\x03txs\"\xf7\x02\n" +
+	"\fTokenSummary\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\tR\x05price\x12!\n" +
+	"\fprice_change\x18\x03 \x01(\x02R\vpriceChange\x12\x1d\n" +
+	"\n" +
+	"market_cap\x18\x04 \x01(\tR\tmarketCap\x12\x10\n" +
+	"\x03fdv\x18\x05 \x01(\tR\x03fdv\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\tR\x06volume\x12#\n" +
+	"\rvolume_change\x18\a \x01(\tR\fvolumeChange\x12#\n" +
+	"\rweekly_volume\x18\b \x01(\tR\fweeklyVolume\x120\n" +
+	"\x14weekly_volume_change\x18\t \x01(\tR\x12weeklyVolumeChange\x12\x10\n" +
+	"\x03tvl\x18\n" +
+	" \x01(\tR\x03tvl\x12\x1d\n" +
+	"\n" +
+	"tvl_change\x18\v \x01(\tR\ttvlChange\x12\x1e\n" +
+	"\n" +
+	"commission\x18\f \x01(\tR\n" +
+	"commission\"\x1b\n" +
+	"\x19ListTokenSummariesRequest\"N\n" +
+	"\x1aListTokenSummariesResponse\x120\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x18.dezswap.v1.TokenSummaryR\x06tokens\"2\n" +
+	"\x16GetTokenSummaryRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"d\n" +
+	"\x0eListTxsRequest\x12&\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x12.dezswap.v1.TxTypeR\x04type\x12\x12\n" +
+	"\x04pool\x18\x02 \x01(\tR\x04pool\x12\x16\n" +
+	"\x06tokens\x18\x03 \x03(\tR\x06tokens\"\xe5\x02\n" +
+	"\x02Tx\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x16\n" +
+	"\x06sender\x18\x03 \x01(\tR\x06sender\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06asset0\x18\x05 \x01(\tR\x06asset0\x12#\n" +
+	"\rasset0_symbol\x18\x06 \x01(\tR\fasset0Symbol\x12#\n" +
+	"\rasset0_amount\x18\a \x01(\tR\fasset0Amount\x12\x16\n" +
+	"\x06asset1\x18\b \x01(\tR\x06asset1\x12#\n" +
+	"\rasset1_symbol\x18\t \x01(\tR\fasset1Symbol\x12#\n" +
+	"\rasset1_amount\x18\n" +
+	" \x01(\tR\fasset1Amount\x12\x1f\n" +
+	"\vtotal_value\x18\v \x01(\tR\n" +
+	"totalValue\x12\x1c\n" +
+	"\ttimestamp\x18\f \x01(\x03R\ttimestamp\"3\n" +
+	"\x0fListTxsResponse\x12 \n" +
+	"\x03txs\x18\x01 \x03(\v2\x0e.dezswap.v1.TxR\x03txs*Y\n" +
+	"\bDuration\x12\x10\n" +
+	"\fDURATION_ALL\x10\x00\x12\x12\n" +
+	"\x0eDURATION_MONTH\x10\x01\x12\x14\n" +
+	"\x10DURATION_QUARTER\x10\x02\x12\x11\n" +
+	"\rDURATION_YEAR\x10\x03*\x90\x01\n" +
+	"\tChartType\x12\x1a\n" +
+	"\x16CHART_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11CHART_TYPE_VOLUME\x10\x01\x12\x12\n" +
+	"\x0eCHART_TYPE_TVL\x10\x02\x12\x12\n" +
+	"\x0eCHART_TYPE_APR\x10\x03\x12\x12\n" +
+	"\x0eCHART_TYPE_FEE\x10\x04\x12\x14\n" +
+	"\x10CHART_TYPE_PRICE\x10\x05*V\n" +
+	"\x06TxType\x12\x0f\n" +
+	"\vTX_TYPE_ALL\x10\x00\x12\x10\n" +
+	"\fTX_TYPE_SWAP\x10\x01\x12\x13\n" +
+	"\x0fTX_TYPE_PROVIDE\x10\x02\x12\x14\n" +
+	"\x10TX_TYPE_WITHDRAW\x10\x032\x90\x01\n" +
+	"\vPairService\x12H\n" +
+	"\tListPairs\x12\x1c.dezswap.v1.ListPairsRequest\x1a\x1d.dezswap.v1.ListPairsResponse\x127\n" +
+	"\aGetPair\x12\x1a.dezswap.v1.GetPairRequest\x1a\x10.dezswap.v1.Pair2\xd7\x01\n" +
+	"\vPoolService\x12H\n" +
+	"\tListPools\x12\x1c.dezswap.v1.ListPoolsRequest\x1a\x1d.dezswap.v1.ListPoolsResponse\x127\n" +
+	"\aGetPool\x12\x1a.dezswap.v1.GetPoolRequest\x1a\x10.dezswap.v1.Pool\x12E\n" +
+	"\n" +
+	"WatchPools\x12\x1d.dezswap.v1.WatchPoolsRequest\x1a\x16.dezswap.v1.PoolUpdate0\x012\x97\x01\n" +
+	"\fTokenService\x12K\n" +
+	"\n" +
+	"ListTokens\x12\x1d.dezswap.v1.ListTokensRequest\x1a\x1e.dezswap.v1.ListTokensResponse\x12:\n" +
+	"\bGetToken\x12\x1b.dezswap.v1.GetTokenRequest\x1a\x11.dezswap.v1.Token2\x90\x01\n" +
+	"\vStatService\x12H\n" +
+	"\tListStats\x12\x1c.dezswap.v1.ListStatsRequest\x1a\x1d.dezswap.v1.ListStatsResponse\x127\n" +
+	"\aGetStat\x12\x1a.dezswap.v1.GetStatRequest\x1a\x10.dezswap.v1.Stat2[\n" +
+	"\fRouteService\x12K\n" +
+	"\n" +
+	"ListRoutes\x12\x1d.dezswap.v1.ListRoutesRequest\x1a\x1e.dezswap.v1.ListRoutesResponse2\xcd\x05\n" +
+	"\x10DashboardService\x12=\n" +
+	"\tGetRecent\x12\x1c.dezswap.v1.GetRecentRequest\x1a\x12.dezswap.v1.Recent\x12Q\n" +
+	"\fGetStatistic\x12\x1f.dezswap.v1.GetStatisticRequest\x1a .dezswap.v1.GetStatisticResponse\x12:\n" +
+	"\bGetChart\x12\x1b.dezswap.v1.GetChartRequest\x1a\x11.dezswap.v1.Chart\x12D\n" +
+	"\rGetTokenChart\x12 .dezswap.v1.GetTokenChartRequest\x1a\x11.dezswap.v1.Chart\x12`\n" +
+	"\x11ListPoolSummaries\x12$.dezswap.v1.ListPoolSummariesRequest\x1a%.dezswap.v1.ListPoolSummariesResponse\x12I\n" +
+	"\rGetPoolDetail\x12 .dezswap.v1.GetPoolDetailRequest\x1a\x16.dezswap.v1.PoolDetail\x12c\n" +
+	"\x12ListTokenSummaries\x12%.dezswap.v1.ListTokenSummariesRequest\x1a&.dezswap.v1.ListTokenSummariesResponse\x12O\n" +
+	"\x0fGetTokenSummary\x12\".dezswap.v1.GetTokenSummaryRequest\x1a\x18.dezswap.v1.TokenSummary\x12B\n" +
+	"\aListTxs\x12\x1a.dezswap.v1.ListTxsRequest\x1a\x1b.dezswap.v1.ListTxsResponseB2Z0github.com/dezswap/dezswap-api/api/grpcserver/pbb\x06proto3"
+
+var (
+	file_dezswap_v1_dezswap_proto_rawDescOnce sync.Once
+	file_dezswap_v1_dezswap_proto_rawDescData []byte
+)
+
+func file_dezswap_v1_dezswap_proto_rawDescGZIP() []byte {
+	file_dezswap_v1_dezswap_proto_rawDescOnce.Do(func() {
+		file_dezswap_v1_dezswap_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_dezswap_v1_dezswap_proto_rawDesc), len(file_dezswap_v1_dezswap_proto_rawDesc)))
+	})
+	return file_dezswap_v1_dezswap_proto_rawDescData
+}
+
+var file_dezswap_v1_dezswap_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_dezswap_v1_dezswap_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
+var file_dezswap_v1_dezswap_proto_goTypes = []any{
+	(Duration)(0),                      // 0: dezswap.v1.Duration
+	(ChartType)(0),                     // 1: dezswap.v1.ChartType
+	(TxType)(0),                        // 2: dezswap.v1.TxType
+	(*Token)(nil),                      // 3: dezswap.v1.Token
+	(*Pair)(nil),                       // 4: dezswap.v1.Pair
+	(*ListPairsRequest)(nil),           // 5: dezswap.v1.ListPairsRequest
+	(*ListPairsResponse)(nil),          // 6: dezswap.v1.ListPairsResponse
+	(*GetPairRequest)(nil),             // 7: dezswap.v1.GetPairRequest
+	(*Pool)(nil),                       // 8: dezswap.v1.Pool
+	(*ListPoolsRequest)(nil),           // 9: dezswap.v1.ListPoolsRequest
+	(*ListPoolsResponse)(nil),          // 10: dezswap.v1.ListPoolsResponse
+	(*GetPoolRequest)(nil),             // 11: dezswap.v1.GetPoolRequest
+	(*WatchPoolsRequest)(nil),          // 12: dezswap.v1.WatchPoolsRequest
+	(*PoolUpdate)(nil),                 // 13: dezswap.v1.PoolUpdate
+	(*ListTokensRequest)(nil),          // 14: dezswap.v1.ListTokensRequest
+	(*ListTokensResponse)(nil),         // 15: dezswap.v1.ListTokensResponse
+	(*GetTokenRequest)(nil),            // 16: dezswap.v1.GetTokenRequest
+	(*PairStat)(nil),                   // 17: dezswap.v1.PairStat
+	(*Stat)(nil),                       // 18: dezswap.v1.Stat
+	(*ListStatsRequest)(nil),           // 19: dezswap.v1.ListStatsRequest
+	(*ListStatsResponse)(nil),          // 20: dezswap.v1.ListStatsResponse
+	(*GetStatRequest)(nil),             // 21: dezswap.v1.GetStatRequest
+	(*ListRoutesRequest)(nil),          // 22: dezswap.v1.ListRoutesRequest
+	(*Route)(nil),                      // 23: dezswap.v1.Route
+	(*ListRoutesResponse)(nil),         // 24: dezswap.v1.ListRoutesResponse
+	(*GetRecentRequest)(nil),           // 25: dezswap.v1.GetRecentRequest
+	(*Recent)(nil),                     // 26: dezswap.v1.Recent
+	(*GetStatisticRequest)(nil),        // 27: dezswap.v1.GetStatisticRequest
+	(*StatisticItem)(nil),              // 28: dezswap.v1.StatisticItem
+	(*GetStatisticResponse)(nil),       // 29: dezswap.v1.GetStatisticResponse
+	(*GetChartRequest)(nil),            // 30: dezswap.v1.GetChartRequest
+	(*GetTokenChartRequest)(nil),       // 31: dezswap.v1.GetTokenChartRequest
+	(*ChartItem)(nil),                  // 32: dezswap.v1.ChartItem
+	(*Chart)(nil),                      // 33: dezswap.v1.Chart
+	(*PoolSummary)(nil),                // 34: dezswap.v1.PoolSummary
+	(*ListPoolSummariesRequest)(nil),   // 35: dezswap.v1.ListPoolSummariesRequest
+	(*ListPoolSummariesResponse)(nil),  // 36: dezswap.v1.ListPoolSummariesResponse
+	(*GetPoolDetailRequest)(nil),       // 37: dezswap.v1.GetPoolDetailRequest
+	(*PoolDetail)(nil),                 // 38: dezswap.v1.PoolDetail
+	(*TokenSummary)(nil),               // 39: dezswap.v1.TokenSummary
+	(*ListTokenSummariesRequest)(nil),  // 40: dezswap.v1.ListTokenSummariesRequest
+	(*ListTokenSummariesResponse)(nil), // 41: dezswap.v1.ListTokenSummariesResponse
+	(*GetTokenSummaryRequest)(nil),     // 42: dezswap.v1.GetTokenSummaryRequest
+	(*ListTxsRequest)(nil),             // 43: dezswap.v1.ListTxsRequest
+	(*Tx)(nil),                         // 44: dezswap.v1.Tx
+	(*ListTxsResponse)(nil),            // 45: dezswap.v1.ListTxsResponse
+}
+var file_dezswap_v1_dezswap_proto_depIdxs = []int32{
+	3,  // 0: dezswap.v1.Pair.asset0:type_name -> dezswap.v1.Token
+	3,  // 1: dezswap.v1.Pair.asset1:type_name -> dezswap.v1.Token
+	3,  // 2: dezswap.v1.Pair.lp:type_name -> dezswap.v1.Token
+	4,  // 3: dezswap.v1.ListPairsResponse.pairs:type_name -> dezswap.v1.Pair
+	8,  // 4: dezswap.v1.ListPoolsResponse.pools:type_name -> dezswap.v1.Pool
+	3,  // 5: dezswap.v1.ListTokensResponse.tokens:type_name -> dezswap.v1.Token
+	17, // 6: dezswap.v1.Stat.stats:type_name -> dezswap.v1.PairStat
+	18, // 7: dezswap.v1.ListStatsResponse.stats:type_name -> dezswap.v1.Stat
+	23, // 8: dezswap.v1.ListRoutesResponse.routes:type_name -> dezswap.v1.Route
+	28, // 9: dezswap.v1.GetStatisticResponse.items:type_name -> dezswap.v1.StatisticItem
+	1,  // 10: dezswap.v1.GetChartRequest.type:type_name -> dezswap.v1.ChartType
+	0,  // 11: dezswap.v1.GetChartRequest.duration:type_name -> dezswap.v1.Duration
+	1,  // 12: dezswap.v1.GetTokenChartRequest.type:type_name -> dezswap.v1.ChartType
+	0,  // 13: dezswap.v1.GetTokenChartRequest.duration:type_name -> dezswap.v1.Duration
+	32, // 14: dezswap.v1.Chart.items:type_name -> dezswap.v1.ChartItem
+	34, // 15: dezswap.v1.ListPoolSummariesResponse.pools:type_name -> dezswap.v1.PoolSummary
+	26, // 16: dezswap.v1.PoolDetail.recent:type_name -> dezswap.v1.Recent
+	44, // 17: dezswap.v1.PoolDetail.txs:type_name -> dezswap.v1.Tx
+	39, // 18: dezswap.v1.ListTokenSummariesResponse.tokens:type_name -> dezswap.v1.TokenSummary
+	2,  // 19: dezswap.v1.ListTxsRequest.type:type_name -> dezswap.v1.TxType
+	44, // 20: dezswap.v1.ListTxsResponse.txs:type_name -> dezswap.v1.Tx
+	5,  // 21: dezswap.v1.PairService.ListPairs:input_type -> dezswap.v1.ListPairsRequest
+	7,  // 22: dezswap.v1.PairService.GetPair:input_type -> dezswap.v1.GetPairRequest
+	9,  // 23: dezswap.v1.PoolService.ListPools:input_type -> dezswap.v1.ListPoolsRequest
+	11, // 24: dezswap.v1.PoolService.GetPool:input_type -> dezswap.v1.GetPoolRequest
+	12, // 25: dezswap.v1.PoolService.WatchPools:input_type -> dezswap.v1.WatchPoolsRequest
+	14, // 26: dezswap.v1.TokenService.ListTokens:input_type -> dezswap.v1.ListTokensRequest
+	16, // 27: dezswap.v1.TokenService.GetToken:input_type -> dezswap.v1.GetTokenRequest
+	19, // 28: dezswap.v1.StatService.ListStats:input_type -> dezswap.v1.ListStatsRequest
+	21, // 29: dezswap.v1.StatService.GetStat:input_type -> dezswap.v1.GetStatRequest
+	22, // 30: dezswap.v1.RouteService.ListRoutes:input_type -> dezswap.v1.ListRoutesRequest
+	25, // 31: dezswap.v1.DashboardService.GetRecent:input_type -> dezswap.v1.GetRecentRequest
+	27, // 32: dezswap.v1.DashboardService.GetStatistic:input_type -> dezswap.v1.GetStatisticRequest
+	30, // 33: dezswap.v1.DashboardService.GetChart:input_type -> dezswap.v1.GetChartRequest
+	31, // 34: dezswap.v1.DashboardService.GetTokenChart:input_type -> dezswap.v1.GetTokenChartRequest
+	35, // 35: dezswap.v1.DashboardService.ListPoolSummaries:input_type -> dezswap.v1.ListPoolSummariesRequest
+	37, // 36: dezswap.v1.DashboardService.GetPoolDetail:input_type -> dezswap.v1.GetPoolDetailRequest
+	40, // 37: dezswap.v1.DashboardService.ListTokenSummaries:input_type -> dezswap.v1.ListTokenSummariesRequest
+	42, // 38: dezswap.v1.DashboardService.GetTokenSummary:input_type -> dezswap.v1.GetTokenSummaryRequest
+	43, // 39: dezswap.v1.DashboardService.ListTxs:input_type -> dezswap.v1.ListTxsRequest
+	6,  // 40: dezswap.v1.PairService.ListPairs:output_type -> dezswap.v1.ListPairsResponse
+	4,  // 41: dezswap.v1.PairService.GetPair:output_type -> dezswap.v1.Pair
+	10, // 42: dezswap.v1.PoolService.ListPools:output_type -> dezswap.v1.ListPoolsResponse
+	8,  // 43: dezswap.v1.PoolService.GetPool:output_type -> dezswap.v1.Pool
+	13, // 44: dezswap.v1.PoolService.WatchPools:output_type -> dezswap.v1.PoolUpdate
+	15, // 45: dezswap.v1.TokenService.ListTokens:output_type -> dezswap.v1.ListTokensResponse
+	3,  // 46: dezswap.v1.TokenService.GetToken:output_type -> dezswap.v1.Token
+	20, // 47: dezswap.v1.StatService.ListStats:output_type -> dezswap.v1.ListStatsResponse
+	18, // 48: dezswap.v1.StatService.GetStat:output_type -> dezswap.v1.Stat
+	24, // 49: dezswap.v1.RouteService.ListRoutes:output_type -> dezswap.v1.ListRoutesResponse
+	26, // 50: dezswap.v1.DashboardService.GetRecent:output_type -> dezswap.v1.Recent
+	29, // 51: dezswap.v1.DashboardService.GetStatistic:output_type -> dezswap.v1.GetStatisticResponse
+	33, // 52: dezswap.v1.DashboardService.GetChart:output_type -> dezswap.v1.Chart
+	33, // 53: dezswap.v1.DashboardService.GetTokenChart:output_type -> dezswap.v1.Chart
+	36, // 54: dezswap.v1.DashboardService.ListPoolSummaries:output_type -> dezswap.v1.ListPoolSummariesResponse
+	38, // 55: dezswap.v1.DashboardService.GetPoolDetail:output_type -> dezswap.v1.PoolDetail
+	41, // 56: dezswap.v1.DashboardService.ListTokenSummaries:output_type -> dezswap.v1.ListTokenSummariesResponse
+	39, // 57: dezswap.v1.DashboardService.GetTokenSummary:output_type -> dezswap.v1.TokenSummary
+	45, // 58: dezswap.v1.DashboardService.ListTxs:output_type -> dezswap.v1.ListTxsResponse
+	40, // [40:59] is the sub-list for method output_type
+	21, // [21:40] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_dezswap_v1_dezswap_proto_init() }
+func file_dezswap_v1_dezswap_proto_init() {
+	if File_dezswap_v1_dezswap_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dezswap_v1_dezswap_proto_rawDesc), len(file_dezswap_v1_dezswap_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   43,
+			NumExtensions: 0,
+			NumServices:   6,
+		},
+		GoTypes:           file_dezswap_v1_dezswap_proto_goTypes,
+		DependencyIndexes: file_dezswap_v1_dezswap_proto_depIdxs,
+		EnumInfos:         file_dezswap_v1_dezswap_proto_enumTypes,
+		MessageInfos:      file_dezswap_v1_dezswap_proto_msgTypes,
+	}.Build()
+	File_dezswap_v1_dezswap_proto = out.File
+	file_dezswap_v1_dezswap_proto_goTypes = nil
+	file_dezswap_v1_dezswap_proto_depIdxs = nil
+}
@@ -0,0 +1,112 @@
+package grpcserver
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/grpcserver/pb"
+	rs "github.com/dezswap/dezswap-api/api/v1/service/router"
+	ss "github.com/dezswap/dezswap-api/api/v1/service/simulate"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type routeServer struct {
+	pb.UnimplementedRouteServiceServer
+	router rs.Router
+	quoter rs.Quoter
+	logger logging.Logger
+}
+
+func (s *routeServer) ListRoutes(ctx context.Context, req *pb.ListRoutesRequest) (*pb.ListRoutesResponse, error) {
+	from, to, hopCount := req.From, req.To, int(req.HopCount)
+	if from == "" && to == "" {
+		return nil, status.Error(codes.InvalidArgument, "required from or to")
+	}
+
+	if req.Amount != "" || req.AskAmount != "" {
+		return s.quotes(ctx, req)
+	}
+
+	// full path
+	if from != "" && to != "" {
+		routes, err := s.router.Routes(from, to, hopCount)
+		if err != nil {
+			logging.WithContext(s.logger, ctx).Warn(err)
+			return nil, errInternal
+		}
+		return routesToPb(routes, from, false), nil
+	}
+
+	addr, reverse := from, false
+	if addr == "" {
+		addr, reverse = to, true
+	}
+	routes, err := s.router.RoutesOfToken(addr, hopCount, reverse)
+	if err != nil {
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+	return routesToPb(routes, addr, reverse), nil
+}
+
+func (s *routeServer) quotes(ctx context.Context, req *pb.ListRoutesRequest) (*pb.ListRoutesResponse, error) {
+	if req.From == "" || req.To == "" {
+		return nil, status.Error(codes.InvalidArgument, "required from and to with amount")
+	}
+	if req.Amount != "" && req.AskAmount != "" {
+		return nil, status.Error(codes.InvalidArgument, "amount and ask amount are exclusive")
+	}
+
+	var quotes []rs.Quote
+	var err error
+	if req.Amount != "" {
+		amount, ok := math.NewIntFromString(req.Amount)
+		if !ok || !amount.IsPositive() {
+			return nil, status.Error(codes.InvalidArgument, "invalid amount")
+		}
+		quotes, err = s.quoter.Quotes(ctx, req.From, req.To, int(req.HopCount), amount)
+	} else {
+		askAmount, ok := math.NewIntFromString(req.AskAmount)
+		if !ok || !askAmount.IsPositive() {
+			return nil, status.Error(codes.InvalidArgument, "invalid ask amount")
+		}
+		quotes, err = s.quoter.ReverseQuotes(ctx, req.From, req.To, int(req.HopCount), askAmount)
+	}
+	if err != nil {
+		if errors.Is(err, ss.ErrInsufficientReserves) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		logging.WithContext(s.logger, ctx).Warn(err)
+		return nil, errInternal
+	}
+
+	res := &pb.ListRoutesResponse{Routes: make([]*pb.Route, len(quotes))}
+	for i, q := range quotes {
+		res.Routes[i] = &pb.Route{
+			From:         req.From,
+			To:           req.To,
+			HopCount:     int32(q.HopCount),
+			Route:        q.Route,
+			OfferAmount:  q.OfferAmount.String(),
+			ReturnAmount: q.ReturnAmount.String(),
+			PriceImpact:  q.PriceImpact.String(),
+			FeeRate:      q.FeeRate.String(),
+		}
+	}
+	return res, nil
+}
+
+func routesToPb(routes []rs.Route, addr string, reverse bool) *pb.ListRoutesResponse {
+	res := &pb.ListRoutesResponse{Routes: make([]*pb.Route, len(routes))}
+	for i, r := range routes {
+		route := &pb.Route{From: addr, To: r.To, HopCount: int32(r.HopCount), Route: r.Route}
+		if reverse {
+			route.From, route.To = r.To, addr
+		}
+		res.Routes[i] = route
+	}
+	return res
+}
@@ -0,0 +1,29 @@
+// Package grpcserver serves the v1 API over gRPC for the backend consumers, next to the REST endpoints.
+package grpcserver
+
+//go:generate sh -c "cd proto && buf generate"
+
+import (
+	"github.com/dezswap/dezswap-api/api/grpcserver/pb"
+	v1 "github.com/dezswap/dezswap-api/api/v1"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+var errInternal = status.Error(codes.Internal, "internal server error")
+
+// New registers the services of the v1 API and the server reflection on a gRPC server of the options
+func New(s v1.Services, logger logging.Logger, opts ...grpc.ServerOption) *grpc.Server {
+	server := grpc.NewServer(opts...)
+	pb.RegisterPairServiceServer(server, &pairServer{pairs: s.Pairs, logger: logger})
+	pb.RegisterPoolServiceServer(server, &poolServer{pools: s.Pools, stream: s.Stream, logger: logger})
+	pb.RegisterTokenServiceServer(server, &tokenServer{tokens: s.Tokens, logger: logger})
+	pb.RegisterStatServiceServer(server, &statServer{stats: s.Stats, logger: logger})
+	pb.RegisterRouteServiceServer(server, &routeServer{router: s.Router, quoter: s.Quoter, logger: logger})
+	pb.RegisterDashboardServiceServer(server, &dashboardServer{dashboard: s.Dashboard, logger: logger})
+	reflection.Register(server)
+	return server
+}
@@ -0,0 +1,146 @@
+package grpcserver
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/dezswap/dezswap-api/api/grpcserver/pb"
+	v1 "github.com/dezswap/dezswap-api/api/v1"
+	"github.com/dezswap/dezswap-api/api/v1/service"
+	"github.com/dezswap/dezswap-api/api/v1/service/stream"
+	"github.com/dezswap/dezswap-api/configs"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type getterMock[T any] struct {
+	mock.Mock
+}
+
+func (m *getterMock[T]) Get(_ context.Context, key string) (*T, error) {
+	args := m.Called(key)
+	item, _ := args.Get(0).(*T)
+	return item, args.Error(1)
+}
+
+func (m *getterMock[T]) GetAll(_ context.Context) ([]T, error) {
+	args := m.Called()
+	items, _ := args.Get(0).([]T)
+	return items, args.Error(1)
+}
+
+// streamFake sends the events and then returns err
+type streamFake struct {
+	filter     stream.Filter
+	fromHeight uint64
+	events     []stream.Event
+	err        error
+}
+
+func (s *streamFake) Subscribe(_ context.Context, filter stream.Filter, fromHeight uint64, send func(stream.Event) error) error {
+	s.filter, s.fromHeight = filter, fromHeight
+	for _, event := range s.events {
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+	return s.err
+}
+
+func newTestClient(t *testing.T, services v1.Services) *grpc.ClientConn {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	server := New(services, logging.New("test", configs.LogConfig{}))
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestPairService(t *testing.T) {
+	pairs := &getterMock[service.Pair]{}
+	pair := service.Pair{Address: "pair", ChainId: "dimension_37-1"}
+	pair.Asset0.Address = "axpla"
+	pair.Asset1.Address = "xpla1token"
+	pairs.On("GetAll").Return([]service.Pair{pair}, nil).Once()
+	pairs.On("Get", "pair").Return(&pair, nil).Once()
+	pairs.On("Get", "unknown").Return(nil, nil).Once()
+	client := pb.NewPairServiceClient(newTestClient(t, v1.Services{Pairs: pairs}))
+
+	res, err := client.ListPairs(context.Background(), &pb.ListPairsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Pairs, 1)
+	assert.Equal(t, "pair", res.Pairs[0].Address)
+	assert.Equal(t, "xpla1token", res.Pairs[0].Asset1.Address)
+
+	got, err := client.GetPair(context.Background(), &pb.GetPairRequest{Address: "pair"})
+	require.NoError(t, err)
+	assert.Equal(t, "axpla", got.Asset0.Address)
+
+	_, err = client.GetPair(context.Background(), &pb.GetPairRequest{Address: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.GetPair(context.Background(), &pb.GetPairRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	pairs.AssertExpectations(t)
+}
+
+func TestPoolService_WatchPools(t *testing.T) {
+	s := &streamFake{
+		events: []stream.Event{
+			{Topic: stream.TopicPool, Height: 10, Pool: &stream.Pool{Pair: "pair", Asset0: "axpla", Asset0Amount: "100", Asset1: "xpla1token", Asset1Amount: "200", Height: 10}},
+			{Topic: stream.TopicPool, Height: 11, Pool: &stream.Pool{Pair: "pair", Asset0: "axpla", Asset0Amount: "110", Asset1: "xpla1token", Asset1Amount: "190", Height: 11}},
+		},
+		err: stream.ErrLagged,
+	}
+	client := pb.NewPoolServiceClient(newTestClient(t, v1.Services{Stream: s}))
+
+	watcher, err := client.WatchPools(context.Background(), &pb.WatchPoolsRequest{Pairs: []string{"pair"}, FromHeight: 10})
+	require.NoError(t, err)
+	heights := []uint64{}
+	for {
+		update, err := watcher.Recv()
+		if err != nil {
+			assert.NotEqual(t, io.EOF, err)
+			assert.Equal(t, codes.Aborted, status.Code(err))
+			break
+		}
+		heights = append(heights, update.Height)
+	}
+	assert.Equal(t, []uint64{10, 11}, heights)
+	assert.Equal(t, stream.Filter{Topics: []stream.Topic{stream.TopicPool}, Pairs: []string{"pair"}}, s.filter)
+	assert.Equal(t, uint64(10), s.fromHeight)
+}
+
+func TestReflection(t *testing.T) {
+	client := rpb.NewServerReflectionClient(newTestClient(t, v1.Services{}))
+	info, err := client.ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, info.Send(&rpb.ServerReflectionRequest{MessageRequest: &rpb.ServerReflectionRequest_ListServices{}}))
+	res, err := info.Recv()
+	require.NoError(t, err)
+
+	names := []string{}
+	for _, s := range res.GetListServicesResponse().Service {
+		names = append(names, s.Name)
+	}
+	assert.Subset(t, names, []string{
+		"dezswap.v1.PairService", "dezswap.v1.PoolService", "dezswap.v1.TokenService",
+		"dezswap.v1.StatService", "dezswap.v1.RouteService", "dezswap.v1.DashboardService",
+	})
+}
@@ -15,9 +15,15 @@ import (
 	"time"
 
 	"github.com/dezswap/dezswap-api/api/docs"
+	"github.com/dezswap/dezswap-api/api/metrics"
+	"github.com/dezswap/dezswap-api/api/ratelimit"
+	"github.com/dezswap/dezswap-api/api/tracing"
 	"github.com/dezswap/dezswap-api/configs"
 	"github.com/gin-gonic/gin"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -168,7 +174,17 @@ func (s *Server) newMCPServer() *mcp.Server {
 			Description: spec.Description,
 			InputSchema: inputSchema(spec),
 		}, func(ctx context.Context, req *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-			return s.handleTool(ctx, spec, req)
+			ctx, span := tracing.Tracer().Start(ctx, "mcp.tool "+spec.Name)
+			defer span.End()
+
+			start := time.Now()
+			result, err := s.handleTool(ctx, spec, req)
+			failed := err != nil || result.IsError
+			metrics.ObserveMCPToolCall(spec.Name, failed, time.Since(start))
+			if failed {
+				span.SetStatus(codes.Error, "tool error")
+			}
+			return result, err
 		})
 	}
 	addResources(server)
@@ -242,13 +258,26 @@ func (s *Server) dispatch(ctx context.Context, spec toolSpec, args map[string]an
 		}
 	}
 	target := "/" + strings.TrimPrefix(path, "/")
+	urlPath := target
 	if queryString := query.Encode(); queryString != "" {
 		target += "?" + queryString
 	}
 
-	req := httptest.NewRequestWithContext(ctx, spec.Method, target, nil)
+	// the request of the engine is a child of the loopback span by its context
+	ctx, span := tracing.Tracer().Start(ctx, "mcp.loopback "+spec.Method+" "+spec.Path,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPRequestMethodKey.String(spec.Method), semconv.URLPath(urlPath)),
+	)
+	defer span.End()
+
+	// the tool call was limited as the request of the MCP endpoint, its loopback is not limited again
+	req := httptest.NewRequestWithContext(ratelimit.WithLoopback(ctx), spec.Method, target, nil)
 	rec := httptest.NewRecorder()
 	s.engine.ServeHTTP(rec, req)
+	span.SetAttributes(semconv.HTTPResponseStatusCode(rec.Code))
+	if rec.Code >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(rec.Code))
+	}
 
 	reader := io.LimitReader(rec.Result().Body, s.responseMaxBytes+1)
 	body, err := io.ReadAll(reader)
@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// pkgPath is of this package, its frames are not the callers of the statements
+	pkgPath      = "github.com/dezswap/dezswap-api/api/metrics"
+	gormStartKey = "metrics:start"
+	// unknownMethod labels the statements of no caller outside gorm
+	unknownMethod = "unknown"
+)
+
+// closureSuffix is of the closures in the methods, their statements are of the methods
+var closureSuffix = regexp.MustCompile(`(\.func\d+)+(\.\d+)*$`)
+
+type gormPlugin struct{}
+
+// NewGormPlugin times the statements of the DB by the method running them, as "service.(*tokenService).GetAll"
+func NewGormPlugin() gorm.Plugin {
+	return gormPlugin{}
+}
+
+// Name implements gorm.Plugin
+func (gormPlugin) Name() string {
+	return "metrics"
+}
+
+// Initialize implements gorm.Plugin
+func (gormPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("*").Register("metrics:before_create", before),
+		cb.Create().After("*").Register("metrics:after_create", after),
+		cb.Query().Before("*").Register("metrics:before_query", before),
+		cb.Query().After("*").Register("metrics:after_query", after),
+		cb.Update().Before("*").Register("metrics:before_update", before),
+		cb.Update().After("*").Register("metrics:after_update", after),
+		cb.Delete().Before("*").Register("metrics:before_delete", before),
+		cb.Delete().After("*").Register("metrics:after_delete", after),
+		cb.Row().Before("*").Register("metrics:before_row", before),
+		cb.Row().After("*").Register("metrics:after_row", after),
+		cb.Raw().Before("*").Register("metrics:before_raw", before),
+		cb.Raw().After("*").Register("metrics:after_raw", after),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func before(db *gorm.DB) {
+	db.InstanceSet(gormStartKey, time.Now())
+}
+
+func after(db *gorm.DB) {
+	v, ok := db.InstanceGet(gormStartKey)
+	if !ok {
+		return
+	}
+	start, ok := v.(time.Time)
+	if !ok {
+		return
+	}
+	queryDuration.WithLabelValues(caller()).Observe(time.Since(start).Seconds())
+}
+
+// caller is the first function of the stack outside gorm and this package, without its import path
+func caller() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "gorm.io/") && !strings.HasPrefix(frame.Function, pkgPath+".") {
+			return methodName(frame.Function)
+		}
+		if !more {
+			return unknownMethod
+		}
+	}
+}
+
+func methodName(function string) string {
+	if function == "" {
+		return unknownMethod
+	}
+	if i := strings.LastIndex(function, "/"); i >= 0 {
+		function = function[i+1:]
+	}
+	return closureSuffix.ReplaceAllString(function, "")
+}
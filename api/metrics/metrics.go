@@ -0,0 +1,137 @@
+// Package metrics keeps the Prometheus metrics of the API in a registry of its own, served by Handler.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "dezswap_api"
+
+// unmatchedRoute labels the requests of no route, their paths would be a label without bound
+const unmatchedRoute = "unmatched"
+
+// results of the CoinGecko fetches
+const (
+	FetchSuccess     = "success"
+	FetchError       = "error"
+	FetchStatusError = "status_error"
+	FetchDecodeError = "decode_error"
+)
+
+var Registry = prometheus.NewRegistry()
+
+var (
+	requests = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by route template and status code.",
+	}, []string{"method", "route", "status"})
+	requestDuration = promauto.With(Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latencies by route template and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+	responseCache = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "response_cache_requests_total",
+		Help:      "Response cache lookups by route template and result, hit or miss.",
+	}, []string{"route", "result"})
+	queryDuration = promauto.With(Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "DB statement durations by the method running them.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+	coinGeckoFetches = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "coingecko_fetches_total",
+		Help:      "CoinGecko price fetches by result.",
+	}, []string{"result"})
+	coinGeckoFetchDuration = promauto.With(Registry).NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "coingecko_fetch_duration_seconds",
+		Help:      "CoinGecko price fetch latencies.",
+		Buckets:   prometheus.DefBuckets,
+	})
+	mcpToolCalls = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "mcp_tool_calls_total",
+		Help:      "MCP tool calls by tool and result, success or error.",
+	}, []string{"tool", "result"})
+	mcpToolCallDuration = promauto.With(Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "mcp_tool_call_duration_seconds",
+		Help:      "MCP tool call latencies by tool.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"tool"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler serves the metrics of the Registry
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// Middleware counts the requests and their latencies by the route template, the paths of the routes are not labels
+func Middleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+
+		labels := prometheus.Labels{
+			"method": ctx.Request.Method,
+			"route":  route(ctx),
+			"status": strconv.Itoa(ctx.Writer.Status()),
+		}
+		requests.With(labels).Inc()
+		requestDuration.With(labels).Observe(time.Since(start).Seconds())
+	}
+}
+
+// CacheHit counts a response served from the response cache
+func CacheHit(ctx *gin.Context) {
+	responseCache.WithLabelValues(route(ctx), "hit").Inc()
+}
+
+// CacheMiss counts a response not found in the response cache
+func CacheMiss(ctx *gin.Context) {
+	responseCache.WithLabelValues(route(ctx), "miss").Inc()
+}
+
+// ObserveCoinGeckoFetch counts a CoinGecko price fetch of the result, one of the Fetch results
+func ObserveCoinGeckoFetch(result string, d time.Duration) {
+	coinGeckoFetches.WithLabelValues(result).Inc()
+	coinGeckoFetchDuration.Observe(d.Seconds())
+}
+
+// ObserveMCPToolCall counts an MCP tool call, failed when its result is a tool error
+func ObserveMCPToolCall(tool string, failed bool, d time.Duration) {
+	result := "success"
+	if failed {
+		result = "error"
+	}
+	mcpToolCalls.WithLabelValues(tool, result).Inc()
+	mcpToolCallDuration.WithLabelValues(tool).Observe(d.Seconds())
+}
+
+func route(ctx *gin.Context) string {
+	if path := ctx.FullPath(); path != "" {
+		return path
+	}
+	return unmatchedRoute
+}
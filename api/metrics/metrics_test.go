@@ -0,0 +1,129 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/dezswap/dezswap-api/api/metrics"
+	"github.com/gin-gonic/gin"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// sample returns the count of the counter or the histogram of the name and the labels, zero when there is none
+func sample(t *testing.T, name string, labels map[string]string) uint64 {
+	t.Helper()
+	families, err := metrics.Registry.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			if !hasLabels(m, labels) {
+				continue
+			}
+			if h := m.GetHistogram(); h != nil {
+				return h.GetSampleCount()
+			}
+			return uint64(m.GetCounter().GetValue())
+		}
+	}
+	return 0
+}
+
+func hasLabels(m *dto.Metric, labels map[string]string) bool {
+	matched := 0
+	for _, pair := range m.GetLabel() {
+		if v, ok := labels[pair.GetName()]; ok && v == pair.GetValue() {
+			matched++
+		}
+	}
+	return matched == len(labels)
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(metrics.Middleware())
+	engine.GET("/v1/pairs/:address", func(ctx *gin.Context) {
+		metrics.CacheMiss(ctx)
+		ctx.Status(http.StatusOK)
+	})
+	engine.GET("/metrics", gin.WrapH(metrics.Handler()))
+
+	for _, path := range []string{"/v1/pairs/a", "/v1/pairs/b", "/unknown"} {
+		engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	// the paths of a route are one route template
+	assert.EqualValues(t, 2, sample(t, "dezswap_api_http_requests_total", map[string]string{"method": "GET", "route": "/v1/pairs/:address", "status": "200"}))
+	assert.EqualValues(t, 2, sample(t, "dezswap_api_http_request_duration_seconds", map[string]string{"route": "/v1/pairs/:address"}))
+	assert.EqualValues(t, 1, sample(t, "dezswap_api_http_requests_total", map[string]string{"route": "unmatched", "status": "404"}))
+	assert.EqualValues(t, 2, sample(t, "dezswap_api_response_cache_requests_total", map[string]string{"route": "/v1/pairs/:address", "result": "miss"}))
+
+	rec := httptest.NewRecorder()
+	engine.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.Contains(rec.Body.String(), `dezswap_api_http_requests_total{method="GET",route="/v1/pairs/:address",status="200"} 2`))
+	assert.True(t, strings.Contains(rec.Body.String(), "go_goroutines"))
+}
+
+func TestObserve(t *testing.T) {
+	metrics.ObserveCoinGeckoFetch(metrics.FetchStatusError, time.Second)
+	metrics.ObserveMCPToolCall("get_pool", false, time.Millisecond)
+	metrics.ObserveMCPToolCall("get_pool", true, time.Millisecond)
+
+	assert.EqualValues(t, 1, sample(t, "dezswap_api_coingecko_fetches_total", map[string]string{"result": metrics.FetchStatusError}))
+	assert.EqualValues(t, 1, sample(t, "dezswap_api_mcp_tool_calls_total", map[string]string{"tool": "get_pool", "result": "success"}))
+	assert.EqualValues(t, 1, sample(t, "dezswap_api_mcp_tool_calls_total", map[string]string{"tool": "get_pool", "result": "error"}))
+	assert.EqualValues(t, 2, sample(t, "dezswap_api_mcp_tool_call_duration_seconds", map[string]string{"tool": "get_pool"}))
+}
+
+type tokenRepo struct {
+	db *gorm.DB
+}
+
+func (r *tokenRepo) Addresses() ([]string, error) {
+	var addresses []string
+	err := r.db.Raw("select address from tokens").Scan(&addresses).Error
+	return addresses, err
+}
+
+func (r *tokenRepo) Count() (int64, error) {
+	var count int64
+	err := func() error {
+		return r.db.Table("tokens").Count(&count).Error
+	}()
+	return count, err
+}
+
+func TestGormPlugin(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(metrics.NewGormPlugin()))
+
+	mock.ExpectQuery("select address from tokens").WillReturnRows(sqlmock.NewRows([]string{"address"}).AddRow("xpla1"))
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "tokens"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	repo := &tokenRepo{db: db}
+	_, err = repo.Addresses()
+	require.NoError(t, err)
+	_, err = repo.Count()
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	assert.EqualValues(t, 1, sample(t, "dezswap_api_db_query_duration_seconds", map[string]string{"method": "metrics_test.(*tokenRepo).Addresses"}))
+	// the statements of the closures are of their methods
+	assert.EqualValues(t, 1, sample(t, "dezswap_api_db_query_duration_seconds", map[string]string{"method": "metrics_test.(*tokenRepo).Count"}))
+}
@@ -0,0 +1,103 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+
+	"github.com/dezswap/dezswap-api/configs"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// grpcLimits limits the calls of the gRPC server as Middleware limits the requests, the buckets are shared with the HTTP requests
+type grpcLimits struct {
+	c      configs.RateLimitConfig
+	keys   *keyStore
+	l      *limiter
+	logger logging.Logger
+}
+
+// ServerOptions limits the gRPC calls with a key of the x-api-key metadata by the key and the others by the peer IP, a stream is limited once as it opens.
+// The peer is the last proxy when the server is behind one, the forwarded IPs are not trusted.
+func ServerOptions(c configs.RateLimitConfig, repo KeyRepo, store cache.Cache, logger logging.Logger) ([]grpc.ServerOption, error) {
+	buckets, ok := store.(cache.TokenBucket)
+	if !ok {
+		return nil, errors.Errorf("ratelimit.ServerOptions: the cache %T has no token buckets", store)
+	}
+	g := &grpcLimits{c: c, keys: newKeyStore(c.Keys, repo, store), l: newLimiter(buckets), logger: logger}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := g.allow(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := g.allow(ss.Context()); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	}, nil
+}
+
+// allow takes a call as Middleware takes a request, it returns the status of a call which is not allowed
+func (g *grpcLimits) allow(ctx context.Context) error {
+	ipId, ipTier := "ip:"+peerIp(ctx), g.c.Ip
+	id, tier := ipId, ipTier
+	if values := metadata.ValueFromIncomingContext(ctx, KeyHeader); len(values) > 0 && values[0] != "" {
+		key, ok, err := g.keys.cached(values[0])
+		if err != nil {
+			g.logger.Warn(err)
+			return nil
+		}
+		if !ok {
+			// an unknown key costs the IP its call before the DB
+			if err := g.take(ipId, ipTier); err != nil {
+				return err
+			}
+			if key, err = g.keys.lookup(values[0]); err != nil {
+				g.logger.Warn(err)
+				return nil
+			}
+		}
+		if key == nil {
+			return status.Error(codes.Unauthenticated, errInvalidKey.Error())
+		}
+		id, tier = "key:"+key.Id, g.c.Key
+		tier.Override(key.RateLimitTier)
+	} else if g.c.RequireKey {
+		return status.Error(codes.Unauthenticated, errKeyRequired.Error())
+	}
+	return g.take(id, tier)
+}
+
+func (g *grpcLimits) take(id string, tier configs.RateLimitTier) error {
+	res, err := g.l.allow(id, tier)
+	if err != nil {
+		g.logger.Warn(err)
+		return nil
+	}
+	if !res.Allowed {
+		return status.Error(codes.ResourceExhausted, errRateLimited.Error())
+	}
+	return nil
+}
+
+func peerIp(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/dezswap/dezswap-api/configs"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/pkg/errors"
+)
+
+// keyCacheTTL bounds how long a revoked key of the DB keeps working
+const keyCacheTTL = time.Minute
+
+// Key is an API key with its own limits, the zero limits follow the key tier
+type Key struct {
+	// Id names the buckets of the key, it is not the key itself
+	Id   string
+	Name string
+	configs.RateLimitTier
+}
+
+type KeyRepo interface {
+	// Key returns the enabled key of the hash, nil when there is none
+	Key(hash string) (*Key, error)
+}
+
+// Hash is of the keys stored, the keys are not stored as they are
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+type keyEntry struct {
+	Found bool
+	Key   Key
+}
+
+// keyStore looks up the keys of the config first and then the keys of the DB through the cache
+type keyStore struct {
+	keys  map[string]Key
+	repo  KeyRepo
+	cache cache.Cache
+}
+
+func newKeyStore(keys []configs.ApiKeyConfig, repo KeyRepo, cache cache.Cache) *keyStore {
+	s := &keyStore{keys: make(map[string]Key, len(keys)), repo: repo, cache: cache}
+	for _, k := range keys {
+		hash := Hash(k.Key)
+		s.keys[hash] = Key{Id: hash[:16], Name: k.Name, RateLimitTier: k.RateLimitTier}
+	}
+	return s
+}
+
+// cached resolves the key by the config and the cache without the DB, ok is false when the DB is yet to be looked up
+func (s *keyStore) cached(key string) (k *Key, ok bool, err error) {
+	hash := Hash(key)
+	if k, ok := s.keys[hash]; ok {
+		return &k, true, nil
+	}
+	if s.repo == nil {
+		return nil, true, nil
+	}
+
+	entry := keyEntry{}
+	if err := s.cache.Get(keyCacheKey(hash), &entry); err == nil {
+		if !entry.Found {
+			return nil, true, nil
+		}
+		return &entry.Key, true, nil
+	} else if !errors.Is(err, cache.ErrCacheMiss) {
+		return nil, false, errors.Wrap(err, "keyStore.cached")
+	}
+	return nil, false, nil
+}
+
+// lookup resolves the key by the DB and caches it
+func (s *keyStore) lookup(key string) (*Key, error) {
+	hash := Hash(key)
+	k, err := s.repo.Key(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "keyStore.lookup")
+	}
+	entry := keyEntry{Found: k != nil}
+	if k != nil {
+		entry.Key = *k
+	}
+	if err := s.cache.Set(keyCacheKey(hash), entry, keyCacheTTL); err != nil {
+		return nil, errors.Wrap(err, "keyStore.lookup")
+	}
+	return k, nil
+}
+
+func keyCacheKey(hash string) string {
+	return "ratelimit:key:" + hash
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/dezswap/dezswap-api/configs"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/pkg/errors"
+)
+
+// Result is of a request taken from the buckets
+type Result struct {
+	Allowed bool
+	// Limit is the burst of the bucket, zero when unlimited
+	Limit     int
+	Remaining int
+	// Reset is until the bucket is full again
+	Reset time.Duration
+	// RetryAfter is until a request is allowed again when it is not allowed
+	RetryAfter time.Duration
+	// QuotaRemaining is of the UTC day, -1 without a quota
+	QuotaRemaining int
+}
+
+// limiter takes the requests from the token buckets of the cache, the cache takes them atomically
+type limiter struct {
+	buckets cache.TokenBucket
+	now     func() time.Time
+}
+
+func newLimiter(buckets cache.TokenBucket) *limiter {
+	return &limiter{buckets: buckets, now: time.Now}
+}
+
+// allow takes a request of the id from the bucket and the daily quota of the tier, a tier without a rate or a burst has no bucket
+func (l *limiter) allow(id string, tier configs.RateLimitTier) (Result, error) {
+	now := l.now()
+	nextDay := now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+	take := cache.TokenTake{
+		BucketKey: "ratelimit:bucket:" + id,
+		Rate:      tier.Rate,
+		Burst:     tier.Burst,
+		QuotaKey:  "ratelimit:quota:" + id + ":" + now.UTC().Format("20060102"),
+		Quota:     tier.DailyQuota,
+		QuotaTTL:  nextDay.Sub(now),
+	}
+	taken, err := l.buckets.TakeToken(take, now)
+	if err != nil {
+		return Result{}, errors.Wrap(err, "limiter.allow")
+	}
+
+	res := Result{Allowed: taken.Allowed, QuotaRemaining: -1}
+	quotaExceeded := tier.DailyQuota > 0 && !taken.Allowed && taken.Used >= tier.DailyQuota
+	if tier.Rate > 0 && tier.Burst > 0 {
+		res.Limit = tier.Burst
+		res.Remaining = int(taken.Tokens)
+		res.Reset = seconds((float64(tier.Burst) - taken.Tokens) / tier.Rate)
+		if !res.Allowed && !quotaExceeded {
+			res.RetryAfter = seconds((1 - taken.Tokens) / tier.Rate)
+		}
+	}
+	if tier.DailyQuota > 0 {
+		res.QuotaRemaining = max(tier.DailyQuota-taken.Used, 0)
+		if quotaExceeded {
+			res.RetryAfter = nextDay.Sub(now)
+		}
+	}
+	return res, nil
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
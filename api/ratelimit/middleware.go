@@ -0,0 +1,131 @@
+// Package ratelimit limits the requests of the API by the API keys and by the IPs with the token buckets in the cache.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dezswap/dezswap-api/configs"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// KeyHeader carries the API key of a request
+const KeyHeader = "X-API-Key"
+
+// Headers are the rate limit headers of the responses
+var Headers = []string{"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "X-RateLimit-Quota-Remaining", "Retry-After"}
+
+var (
+	errKeyRequired = errors.New("api key required")
+	errInvalidKey  = errors.New("invalid api key")
+	errRateLimited = errors.New("rate limit exceeded")
+)
+
+type loopbackKey struct{}
+
+// WithLoopback marks the context of a request the API makes to itself, it was limited when it came in
+func WithLoopback(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loopbackKey{}, true)
+}
+
+func isLoopback(ctx context.Context) bool {
+	loopback, _ := ctx.Value(loopbackKey{}).(bool)
+	return loopback
+}
+
+// Middleware limits the requests with a key by the key and the others by the IP, the requests of the MCP endpoint by the MCP tier apart from the others.
+// The requests with a key unknown to the config and the cache are limited by the IP before the DB looks it up, the requests of the API to itself are not limited.
+// The requests pass when the cache or the DB fails, the limits are not worth an outage.
+func Middleware(c configs.RateLimitConfig, mcpPath string, repo KeyRepo, store cache.Cache, logger logging.Logger) (gin.HandlerFunc, error) {
+	buckets, ok := store.(cache.TokenBucket)
+	if !ok {
+		return nil, errors.Errorf("ratelimit.Middleware: the cache %T has no token buckets", store)
+	}
+	keys := newKeyStore(c.Keys, repo, store)
+	l := newLimiter(buckets)
+
+	return func(ctx *gin.Context) {
+		if isLoopback(ctx.Request.Context()) {
+			ctx.Next()
+			return
+		}
+
+		ipId, ipTier := "ip:"+ctx.ClientIP(), c.Ip
+		if mcpPath != "" && ctx.Request.URL.Path == mcpPath {
+			ipId, ipTier = "mcp:"+ipId, c.MCP
+		}
+		id, tier := ipId, ipTier
+		if value := ctx.GetHeader(KeyHeader); value != "" {
+			key, ok, err := keys.cached(value)
+			if err != nil {
+				logger.Warn(err)
+				ctx.Next()
+				return
+			}
+			if !ok {
+				// an unknown key costs the IP its request before the DB
+				if !limit(ctx, l, ipId, ipTier, logger) {
+					return
+				}
+				if key, err = keys.lookup(value); err != nil {
+					logger.Warn(err)
+					ctx.Next()
+					return
+				}
+			}
+			if key == nil {
+				httputil.NewError(ctx, http.StatusUnauthorized, errInvalidKey)
+				ctx.Abort()
+				return
+			}
+			id, tier = "key:"+key.Id, c.Key
+			tier.Override(key.RateLimitTier)
+			if mcpPath != "" && ctx.Request.URL.Path == mcpPath {
+				id, tier = "mcp:"+id, c.MCP
+			}
+		} else if c.RequireKey {
+			httputil.NewError(ctx, http.StatusUnauthorized, errKeyRequired)
+			ctx.Abort()
+			return
+		}
+
+		if limit(ctx, l, id, tier, logger) {
+			ctx.Next()
+		}
+	}, nil
+}
+
+// limit takes a request of the id and sets the headers, it aborts and returns false when the request is not allowed
+func limit(ctx *gin.Context, l *limiter, id string, tier configs.RateLimitTier, logger logging.Logger) bool {
+	res, err := l.allow(id, tier)
+	if err != nil {
+		logger.Warn(err)
+		return true
+	}
+	if res.Limit > 0 {
+		ctx.Header("X-RateLimit-Limit", strconv.Itoa(res.Limit))
+		ctx.Header("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		ctx.Header("X-RateLimit-Reset", ceilSeconds(res.Reset))
+	}
+	if res.QuotaRemaining >= 0 {
+		ctx.Header("X-RateLimit-Quota-Remaining", strconv.Itoa(res.QuotaRemaining))
+	}
+	if !res.Allowed {
+		ctx.Header("Retry-After", ceilSeconds(res.RetryAfter))
+		httputil.NewError(ctx, http.StatusTooManyRequests, errRateLimited)
+		ctx.Abort()
+		return false
+	}
+	return true
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,248 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dezswap/dezswap-api/configs"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/dezswap/dezswap-api/pkg/cache/memory"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type keyRepoMock struct {
+	mock.Mock
+}
+
+func (m *keyRepoMock) Key(hash string) (*Key, error) {
+	args := m.Called(hash)
+	key, _ := args.Get(0).(*Key)
+	return key, args.Error(1)
+}
+
+func newTestCache(t *testing.T) cache.Cache {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	return memory.NewMemoryCache(ctx, cache.NewByteCodec())
+}
+
+func newTestMiddleware(t *testing.T, c configs.RateLimitConfig, repo KeyRepo) gin.HandlerFunc {
+	m, err := Middleware(c, "/mcp", repo, newTestCache(t), logging.New("test", configs.LogConfig{}))
+	require.NoError(t, err)
+	return m
+}
+
+func TestLimiter(t *testing.T) {
+	l := newLimiter(newTestCache(t).(cache.TokenBucket))
+	now := time.Date(2026, 10, 19, 23, 59, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+	tier := configs.RateLimitTier{Rate: 1, Burst: 2}
+
+	res, err := l.allow("ip:1.2.3.4", tier)
+	require.NoError(t, err)
+	assert.Equal(t, Result{Allowed: true, Limit: 2, Remaining: 1, Reset: time.Second, QuotaRemaining: -1}, res)
+	res, err = l.allow("ip:1.2.3.4", tier)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+
+	res, err = l.allow("ip:1.2.3.4", tier)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Second, res.RetryAfter)
+	// the buckets are of each id
+	res, err = l.allow("ip:5.6.7.8", tier)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	now = now.Add(1500 * time.Millisecond)
+	res, err = l.allow("ip:1.2.3.4", tier)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+}
+
+func TestLimiter_DailyQuota(t *testing.T) {
+	l := newLimiter(newTestCache(t).(cache.TokenBucket))
+	now := time.Date(2026, 10, 19, 23, 59, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+	tier := configs.RateLimitTier{Rate: 100, Burst: 100, DailyQuota: 2}
+
+	for _, remaining := range []int{1, 0} {
+		res, err := l.allow("key:a", tier)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, remaining, res.QuotaRemaining)
+	}
+	res, err := l.allow("key:a", tier)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, time.Minute, res.RetryAfter)
+	// the refused requests are not taken from the bucket
+	assert.Equal(t, 98, res.Remaining)
+
+	now = now.Add(time.Minute)
+	res, err = l.allow("key:a", tier)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	assert.Equal(t, 1, res.QuotaRemaining)
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	repo := &keyRepoMock{}
+	repo.On("Key", Hash("db-key")).Return(&Key{Id: "db", RateLimitTier: configs.RateLimitTier{Burst: 3}}, nil).Once()
+	repo.On("Key", Hash("unknown")).Return(nil, nil).Once()
+
+	c := configs.RateLimitConfig{
+		Enabled: true,
+		Ip:      configs.RateLimitTier{Rate: 0.001, Burst: 1},
+		Key:     configs.RateLimitTier{Rate: 0.001, Burst: 2},
+		MCP:     configs.RateLimitTier{Rate: 0.001, Burst: 1},
+		Keys:    []configs.ApiKeyConfig{{Name: "bot", Key: "config-key"}},
+	}
+	engine := gin.New()
+	engine.Use(newTestMiddleware(t, c, repo))
+	engine.GET("/v1/pairs", func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+	engine.POST("/mcp", func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+
+	remoteAddr := "1.2.3.4:1234"
+	serve := func(method, path, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr
+		if key != "" {
+			req.Header.Set(KeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(http.MethodGet, "/v1/pairs", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "1", rec.Header().Get("X-RateLimit-Limit"))
+	assert.Equal(t, "0", rec.Header().Get("X-RateLimit-Remaining"))
+	rec = serve(http.MethodGet, "/v1/pairs", "")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("Retry-After"))
+
+	// the MCP tier is apart from the IP tier
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/mcp", "").Code)
+	assert.Equal(t, http.StatusTooManyRequests, serve(http.MethodPost, "/mcp", "").Code)
+
+	// the keys have the buckets of their own
+	for _, code := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		assert.Equal(t, code, serve(http.MethodGet, "/v1/pairs", "config-key").Code)
+	}
+	// the first request of a key of the DB costs the IP as well, until the key is cached
+	remoteAddr = "9.9.9.9:1234"
+	for _, code := range []int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		rec = serve(http.MethodGet, "/v1/pairs", "db-key")
+		assert.Equal(t, code, rec.Code)
+	}
+	assert.Equal(t, "3", rec.Header().Get("X-RateLimit-Limit"))
+
+	// the unknown keys cost the IP before the DB, the IP is out of its tokens
+	assert.Equal(t, http.StatusTooManyRequests, serve(http.MethodGet, "/v1/pairs", "unknown").Code)
+	remoteAddr = "5.6.7.8:1234"
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/v1/pairs", "unknown").Code)
+	// the keys of the DB are looked up through the cache, the cached ones cost nothing of the IP
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/v1/pairs", "unknown").Code)
+	// a limited IP never reaches the DB with the unknown keys
+	assert.Equal(t, http.StatusTooManyRequests, serve(http.MethodGet, "/v1/pairs", "another").Code)
+	repo.AssertExpectations(t)
+
+	// the requests of the API to itself are limited once, when they came in
+	req := httptest.NewRequestWithContext(WithLoopback(context.Background()), http.MethodGet, "/v1/pairs", nil)
+	req.RemoteAddr = remoteAddr
+	rec = httptest.NewRecorder()
+	engine.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("X-RateLimit-Limit"))
+
+	c.RequireKey = true
+	engine = gin.New()
+	engine.Use(newTestMiddleware(t, c, nil))
+	engine.GET("/v1/pairs", func(ctx *gin.Context) { ctx.Status(http.StatusOK) })
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodGet, "/v1/pairs", "").Code)
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v1/pairs", "config-key").Code)
+}
+
+type plainCache struct {
+	cache.Cache
+}
+
+func TestMiddleware_NeedsTokenBuckets(t *testing.T) {
+	_, err := Middleware(configs.RateLimitConfig{Enabled: true}, "", nil, plainCache{newTestCache(t)}, logging.New("test", configs.LogConfig{}))
+	assert.Error(t, err)
+}
+
+func TestServerOptions(t *testing.T) {
+	repo := &keyRepoMock{}
+	repo.On("Key", Hash("unknown")).Return(nil, nil).Once()
+	c := configs.RateLimitConfig{
+		Enabled: true,
+		Ip:      configs.RateLimitTier{Rate: 0.001, Burst: 2},
+		Key:     configs.RateLimitTier{Rate: 0.001, Burst: 1},
+		Keys:    []configs.ApiKeyConfig{{Name: "bot", Key: "config-key"}},
+	}
+	newClient := func(t *testing.T, c configs.RateLimitConfig, repo KeyRepo) healthpb.HealthClient {
+		opts, err := ServerOptions(c, repo, newTestCache(t), logging.New("test", configs.LogConfig{}))
+		require.NoError(t, err)
+		lis := bufconn.Listen(1 << 20)
+		server := grpc.NewServer(opts...)
+		healthpb.RegisterHealthServer(server, health.NewServer())
+		go func() { _ = server.Serve(lis) }()
+		t.Cleanup(server.Stop)
+		conn, err := grpc.NewClient("passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return healthpb.NewHealthClient(conn)
+	}
+	check := func(client healthpb.HealthClient, key string) codes.Code {
+		ctx := context.Background()
+		if key != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, KeyHeader, key)
+		}
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		return status.Code(err)
+	}
+
+	client := newClient(t, c, repo)
+	// the keys have the buckets of their own
+	assert.Equal(t, codes.OK, check(client, "config-key"))
+	assert.Equal(t, codes.ResourceExhausted, check(client, "config-key"))
+	// the unknown keys cost the peer before the DB
+	assert.Equal(t, codes.Unauthenticated, check(client, "unknown"))
+	assert.Equal(t, codes.OK, check(client, ""))
+	assert.Equal(t, codes.ResourceExhausted, check(client, ""))
+	// a stream is limited as it opens
+	watch, err := client.Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = watch.Recv()
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	repo.AssertExpectations(t)
+
+	c.RequireKey = true
+	client = newClient(t, c, nil)
+	assert.Equal(t, codes.Unauthenticated, check(client, ""))
+	assert.Equal(t, codes.OK, check(client, "config-key"))
+}
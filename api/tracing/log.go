@@ -0,0 +1,33 @@
+package tracing
+
+import (
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type logHook struct{}
+
+// NewLogHook adds the ids of the trace and the span of the entry context, as of logger.WithContext, to the log entries
+func NewLogHook() logrus.Hook {
+	return logHook{}
+}
+
+// Levels implements logrus.Hook
+func (logHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire implements logrus.Hook
+func (logHook) Fire(e *logrus.Entry) error {
+	if !enabled.Load() {
+		return nil
+	}
+	if e.Context == nil {
+		return nil
+	}
+	if sc := trace.SpanContextFromContext(e.Context); sc.IsValid() {
+		e.Data["trace_id"] = sc.TraceID().String()
+		e.Data["span_id"] = sc.SpanID().String()
+	}
+	return nil
+}
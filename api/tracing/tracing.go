@@ -0,0 +1,75 @@
+// Package tracing traces the requests of the API with OpenTelemetry and exports the spans over OTLP.
+//
+// The handlers pass the context of the request down to the services, the spans of the services,
+// of the DB statements and of the upstream requests are children of the span of the context.
+package tracing
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/dezswap/dezswap-api/configs"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/dezswap/dezswap-api"
+
+var enabled atomic.Bool
+
+// Setup exports the spans to the collector of the config and starts tracing, the returned function flushes the spans left
+func Setup(c configs.TracingConfig, name, version string) (func(context.Context) error, error) {
+	ctx := context.Background()
+	opts := []otlptracegrpc.Option{}
+	if c.Endpoint != "" {
+		opts = append(opts, otlptracegrpc.WithEndpoint(c.Endpoint))
+	}
+	if c.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "tracing.Setup")
+	}
+	attrs := []attribute.KeyValue{semconv.ServiceVersion(version)}
+	// OTEL_SERVICE_NAME names the service without a name
+	if name != "" {
+		attrs = append(attrs, semconv.ServiceName(name))
+	}
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(attrs...),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "tracing.Setup")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	enabled.Store(true)
+	return provider.Shutdown, nil
+}
+
+// Tracer is of the global tracer provider, a no-op one until Setup
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start starts the span of a method under the span of ctx, named as the errors of the method are wrapped as "dashboard.Tokens".
+// The span is a no-op one until Setup.
+func Start(ctx context.Context, name string) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name)
+}
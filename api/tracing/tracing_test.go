@@ -0,0 +1,147 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupTest(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	enabled.Store(true)
+	t.Cleanup(func() {
+		enabled.Store(false)
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return recorder
+}
+
+func spanOf(t *testing.T, recorder *tracetest.SpanRecorder, name string) sdktrace.ReadOnlySpan {
+	t.Helper()
+	for _, span := range recorder.Ended() {
+		if span.Name() == name {
+			return span
+		}
+	}
+	require.Failf(t, "span not found", "no span %s", name)
+	return nil
+}
+
+func TestStart_Disabled(t *testing.T) {
+	ctx, span := Start(context.Background(), "service.Get")
+	defer span.End()
+	// the span is a no-op one until Setup
+	assert.False(t, span.SpanContext().IsValid())
+	assert.False(t, span.IsRecording())
+	assert.Equal(t, span, trace.SpanFromContext(ctx))
+}
+
+func TestMiddleware(t *testing.T) {
+	recorder := setupTest(t)
+	gin.SetMode(gin.TestMode)
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(NewGormPlugin()))
+	mock.ExpectQuery("select address from tokens where chain_id = ").WillReturnRows(sqlmock.NewRows([]string{"address"}).AddRow("xpla1"))
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the trace is not propagated to the upstreams
+		assert.Empty(t, r.Header.Get("traceparent"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer upstream.Close()
+	client := &http.Client{Transport: NewTransport(http.DefaultTransport)}
+
+	var logs bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&logs)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	logger.AddHook(NewLogHook())
+
+	engine := gin.New()
+	engine.Use(Middleware())
+	engine.GET("/v1/tokens/:address", func(ctx *gin.Context) {
+		func(ctx context.Context) {
+			ctx, span := Start(ctx, "tokenService.Get")
+			defer span.End()
+			var addresses []string
+			require.NoError(t, db.WithContext(ctx).Raw("select address from tokens where chain_id = ?", "dimension_37-1").Scan(&addresses).Error)
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, upstream.URL+"/api/v3/coins", nil)
+			require.NoError(t, err)
+			res, err := client.Do(req)
+			require.NoError(t, err)
+			res.Body.Close()
+		}(ctx.Request.Context())
+		logger.WithContext(ctx.Request.Context()).Info("served")
+		ctx.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/tokens/xpla1", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	engine.ServeHTTP(httptest.NewRecorder(), req)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	server := spanOf(t, recorder, "GET /v1/tokens/:address")
+	method := spanOf(t, recorder, "tokenService.Get")
+	// Scan of a raw statement runs the row callbacks
+	statement := spanOf(t, recorder, "gorm.row")
+	upstreamSpan := spanOf(t, recorder, "HTTP GET")
+
+	// the server span continues the trace of the caller
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", server.SpanContext().TraceID().String())
+	assert.Equal(t, server.SpanContext().SpanID(), method.Parent().SpanID())
+	assert.Equal(t, method.SpanContext().SpanID(), statement.Parent().SpanID())
+	assert.Equal(t, method.SpanContext().SpanID(), upstreamSpan.Parent().SpanID())
+	assert.Contains(t, logs.String(), `"trace_id":"4bf92f3577b34da6a3ce929d0e0e4736"`)
+	assert.Contains(t, logs.String(), `"span_id":"`+server.SpanContext().SpanID().String()+`"`)
+}
+
+func TestGormPlugin_Statement(t *testing.T) {
+	recorder := setupTest(t)
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(NewGormPlugin()))
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "tokens"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	var count int64
+	require.NoError(t, db.Table("tokens").Where("chain_id = ?", "dimension_37-1").Count(&count).Error)
+
+	span := spanOf(t, recorder, "gorm.query")
+	attrs := map[string]string{}
+	for _, kv := range span.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.Emit()
+	}
+	assert.Equal(t, `SELECT count(*) FROM "tokens" WHERE chain_id = $1`, attrs["db.query.text"])
+	assert.Equal(t, "postgresql", attrs["db.system.name"])
+	// a statement out of the requests starts a trace of its own
+	assert.False(t, span.Parent().IsValid())
+}
@@ -0,0 +1,170 @@
+package adapter
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/dezswap/dezswap-api/api/v1/service/adapter"
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const protocolId = "dezswap"
+
+type adapterController struct {
+	adapter.Service
+	*mapper
+	logger logging.Logger
+}
+
+type mapper struct{}
+
+func InitAdapterController(s adapter.Service, route *gin.RouterGroup, logger logging.Logger) *adapterController {
+	c := adapterController{s, &mapper{}, logger}
+	c.register(route)
+	return &c
+}
+
+func (c *adapterController) register(route *gin.RouterGroup) {
+	route.GET("/defillama/tvl", c.DefiLlamaTvl)
+	route.GET("/defillama/volume", c.DefiLlamaVolume)
+	route.GET("/geckoterminal/tvl", c.GeckoTerminalTvl)
+	route.GET("/geckoterminal/volume", c.GeckoTerminalVolume)
+}
+
+// DefiLlamaTvl godoc
+//
+//	@Summary		DefiLlama TVL
+//	@Description	get the daily TVL series and the current TVL by the token in USD in the format of DefiLlama
+//	@Tags			adapters
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	DefiLlamaTvlRes
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/adapters/defillama/tvl [get]
+func (c *adapterController) DefiLlamaTvl(ctx *gin.Context) {
+	tvls, err := c.Service.Tvls(ctx.Request.Context())
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.defiLlamaTvlToRes(*tvls))
+}
+
+// DefiLlamaVolume godoc
+//
+//	@Summary		DefiLlama volume
+//	@Description	get the daily volume series in USD in the format of DefiLlama
+//	@Tags			adapters
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	DefiLlamaVolumeRes
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/adapters/defillama/volume [get]
+func (c *adapterController) DefiLlamaVolume(ctx *gin.Context) {
+	volumes, err := c.Service.Volumes(ctx.Request.Context())
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.defiLlamaVolumeToRes(volumes))
+}
+
+// GeckoTerminalTvl godoc
+//
+//	@Summary		GeckoTerminal TVL
+//	@Description	get the daily TVL series and the current TVL by the token in USD in the format of GeckoTerminal
+//	@Tags			adapters
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	GeckoTerminalTvlRes
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/adapters/geckoterminal/tvl [get]
+func (c *adapterController) GeckoTerminalTvl(ctx *gin.Context) {
+	tvls, err := c.Service.Tvls(ctx.Request.Context())
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.geckoTerminalTvlToRes(*tvls))
+}
+
+// GeckoTerminalVolume godoc
+//
+//	@Summary		GeckoTerminal volume
+//	@Description	get the daily volume series in USD in the format of GeckoTerminal
+//	@Tags			adapters
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	GeckoTerminalVolumeRes
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/adapters/geckoterminal/volume [get]
+func (c *adapterController) GeckoTerminalVolume(ctx *gin.Context) {
+	volumes, err := c.Service.Volumes(ctx.Request.Context())
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.geckoTerminalVolumeToRes(volumes))
+}
+
+func (m *mapper) defiLlamaTvlToRes(tvls adapter.Tvls) DefiLlamaTvlRes {
+	res := DefiLlamaTvlRes{
+		Tvl:         make([]DefiLlamaTvlItemRes, len(tvls.Series)),
+		TokensInUsd: []DefiLlamaTokensItemRes{{Date: tvls.Timestamp.Unix(), Tokens: make(map[string]float64, len(tvls.Tokens))}},
+	}
+	for i, p := range tvls.Series {
+		res.Tvl[i] = DefiLlamaTvlItemRes{Date: p.Timestamp.Unix(), TotalLiquidityUSD: p.ValueInUsd}
+	}
+	for _, token := range tvls.Tokens {
+		res.TokensInUsd[0].Tokens[token.Token] = token.TvlInUsd
+	}
+	return res
+}
+
+func (m *mapper) defiLlamaVolumeToRes(volumes []adapter.Point) DefiLlamaVolumeRes {
+	res := DefiLlamaVolumeRes{TotalDataChart: make([][2]float64, len(volumes))}
+	for i, p := range volumes {
+		res.TotalDataChart[i] = [2]float64{float64(p.Timestamp.Unix()), p.ValueInUsd}
+	}
+	return res
+}
+
+func (m *mapper) geckoTerminalTvlToRes(tvls adapter.Tvls) GeckoTerminalTvlRes {
+	attributes := GeckoTerminalTvlAttributesRes{
+		Series:    m.geckoTerminalPointsToRes(tvls.Series),
+		Tokens:    make([]GeckoTerminalTokenTvlRes, len(tvls.Tokens)),
+		Timestamp: tvls.Timestamp.Unix(),
+	}
+	for i, token := range tvls.Tokens {
+		attributes.Tokens[i] = GeckoTerminalTokenTvlRes{Address: token.Token, TvlUsd: formatUsd(token.TvlInUsd)}
+	}
+	return GeckoTerminalTvlRes{Data: GeckoTerminalTvlDataRes{Id: protocolId, Type: "tvl", Attributes: attributes}}
+}
+
+func (m *mapper) geckoTerminalVolumeToRes(volumes []adapter.Point) GeckoTerminalVolumeRes {
+	attributes := GeckoTerminalVolumeAttributesRes{Series: m.geckoTerminalPointsToRes(volumes)}
+	return GeckoTerminalVolumeRes{Data: GeckoTerminalVolumeDataRes{Id: protocolId, Type: "volume", Attributes: attributes}}
+}
+
+func (m *mapper) geckoTerminalPointsToRes(points []adapter.Point) []GeckoTerminalPointRes {
+	res := make([]GeckoTerminalPointRes, len(points))
+	for i, p := range points {
+		res[i] = GeckoTerminalPointRes{Timestamp: p.Timestamp.Unix(), ValueUsd: formatUsd(p.ValueInUsd)}
+	}
+	return res
+}
+
+func formatUsd(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
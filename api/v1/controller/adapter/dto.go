@@ -0,0 +1,68 @@
+package adapter
+
+// DefiLlamaTvlRes follows the historical TVL of a protocol of DefiLlama
+type DefiLlamaTvlRes struct {
+	Tvl         []DefiLlamaTvlItemRes    `json:"tvl"`
+	TokensInUsd []DefiLlamaTokensItemRes `json:"tokensInUsd"`
+}
+
+type DefiLlamaTvlItemRes struct {
+	// Date is the unix timestamp in seconds
+	Date              int64   `json:"date"`
+	TotalLiquidityUSD float64 `json:"totalLiquidityUSD"`
+}
+
+type DefiLlamaTokensItemRes struct {
+	Date int64 `json:"date"`
+	// Tokens are the TVLs in USD by the token address
+	Tokens map[string]float64 `json:"tokens"`
+}
+
+// DefiLlamaVolumeRes follows the volume summary of a protocol of DefiLlama
+type DefiLlamaVolumeRes struct {
+	// TotalDataChart is the list of [unix timestamp in seconds, volume in USD]
+	TotalDataChart [][2]float64 `json:"totalDataChart"`
+}
+
+// GeckoTerminalTvlRes is a JSON:API document as GeckoTerminal serves
+type GeckoTerminalTvlRes struct {
+	Data GeckoTerminalTvlDataRes `json:"data"`
+}
+
+type GeckoTerminalTvlDataRes struct {
+	Id         string                        `json:"id"`
+	Type       string                        `json:"type"`
+	Attributes GeckoTerminalTvlAttributesRes `json:"attributes"`
+}
+
+// GeckoTerminalVolumeRes is a JSON:API document as GeckoTerminal serves
+type GeckoTerminalVolumeRes struct {
+	Data GeckoTerminalVolumeDataRes `json:"data"`
+}
+
+type GeckoTerminalVolumeDataRes struct {
+	Id         string                           `json:"id"`
+	Type       string                           `json:"type"`
+	Attributes GeckoTerminalVolumeAttributesRes `json:"attributes"`
+}
+
+type GeckoTerminalTvlAttributesRes struct {
+	Series []GeckoTerminalPointRes    `json:"series"`
+	Tokens []GeckoTerminalTokenTvlRes `json:"tokens"`
+	// Timestamp is when the token TVLs are valued
+	Timestamp int64 `json:"timestamp"`
+}
+
+type GeckoTerminalVolumeAttributesRes struct {
+	Series []GeckoTerminalPointRes `json:"series"`
+}
+
+type GeckoTerminalPointRes struct {
+	Timestamp int64  `json:"timestamp"`
+	ValueUsd  string `json:"value_usd"`
+}
+
+type GeckoTerminalTokenTvlRes struct {
+	Address string `json:"address"`
+	TvlUsd  string `json:"tvl_usd"`
+}
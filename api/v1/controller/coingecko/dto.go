@@ -20,4 +20,38 @@ type TickerRes struct {
 	TargetVolume   string `json:"target_volume"`
 	PoolId         string `json:"pool_id"`
 	LiquidityInUsd string `json:"liquidity_in_usd"`
+	// BidAskSpreadPercentage is the gap made by the commission between the marginal ask and bid prices
+	BidAskSpreadPercentage string           `json:"bid_ask_spread_percentage,omitempty"`
+	Depth                  []TickerDepthRes `json:"depth,omitempty"`
+}
+
+// TickerDepthRes is the amounts moving the price by the percentage, negative on the bid side
+type TickerDepthRes struct {
+	Percentage   string `json:"percentage"`
+	BaseAmount   string `json:"base_amount"`
+	TargetAmount string `json:"target_amount"`
+	UsdAmount    string `json:"usd_amount"`
+}
+
+// HistoricalTradesRes has the trades of the requested type only
+type HistoricalTradesRes struct {
+	Buy  []TradeRes `json:"buy,omitempty"`
+	Sell []TradeRes `json:"sell,omitempty"`
+}
+
+type TradeRes struct {
+	TradeId        uint64 `json:"trade_id"`
+	Price          string `json:"price"`
+	BaseVolume     string `json:"base_volume"`
+	TargetVolume   string `json:"target_volume"`
+	TradeTimestamp int64  `json:"trade_timestamp"`
+	Type           string `json:"type"`
+}
+
+// OrderbookRes has the orders in the form of [price, base amount]
+type OrderbookRes struct {
+	TickerId  string      `json:"ticker_id"`
+	Timestamp string      `json:"timestamp"`
+	Bids      [][2]string `json:"bids"`
+	Asks      [][2]string `json:"asks"`
 }
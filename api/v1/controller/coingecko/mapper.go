@@ -9,6 +9,10 @@ type pairMapper struct{}
 
 type tickerMapper struct{}
 
+type tradeMapper struct{}
+
+type orderbookMapper struct{}
+
 func (m *pairMapper) pairToRes(pair coingeckoService.Pair) PairRes {
 	return PairRes{
 		TickerId: pair.TickerId,
@@ -55,9 +59,42 @@ func (m *tickerMapper) tickerToRes(ticker coingeckoService.Ticker) (TickerRes, e
 		PoolId:         ticker.PoolId,
 		LiquidityInUsd: strconv.FormatFloat(baseLiquidityInPrice*2, 'f', -1, 64),
 	}
+
+	if ticker.BidAskSpreadPercentage != "" {
+		spread, err := strconv.ParseFloat(ticker.BidAskSpreadPercentage, 64)
+		if err != nil {
+			return TickerRes{}, err
+		}
+		res.BidAskSpreadPercentage = strconv.FormatFloat(spread, 'f', -1, 64)
+	}
+	for _, level := range ticker.Depth {
+		depth, err := m.depthLevelToRes(level)
+		if err != nil {
+			return TickerRes{}, err
+		}
+		res.Depth = append(res.Depth, depth)
+	}
 	return res, nil
 }
 
+func (m *tickerMapper) depthLevelToRes(level coingeckoService.DepthLevel) (TickerDepthRes, error) {
+	values := []string{level.Percentage, level.BaseAmount, level.TargetAmount, level.UsdAmount}
+	for i, v := range values {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return TickerDepthRes{}, err
+		}
+		values[i] = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	return TickerDepthRes{
+		Percentage:   values[0],
+		BaseAmount:   values[1],
+		TargetAmount: values[2],
+		UsdAmount:    values[3],
+	}, nil
+}
+
 func (m *tickerMapper) tickersToRes(tickers []coingeckoService.Ticker) (TickersRes, error) {
 	var err error
 	res := make([]TickerRes, len(tickers))
@@ -69,3 +106,66 @@ func (m *tickerMapper) tickersToRes(tickers []coingeckoService.Ticker) (TickersR
 	}
 	return res, nil
 }
+
+func (m *tradeMapper) tradesToRes(trades []coingeckoService.Trade) (HistoricalTradesRes, error) {
+	res := HistoricalTradesRes{}
+	for _, trade := range trades {
+		values := []string{trade.Price, trade.BaseVolume, trade.TargetVolume}
+		for i, v := range values {
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return HistoricalTradesRes{}, err
+			}
+			values[i] = strconv.FormatFloat(f, 'f', -1, 64)
+		}
+
+		tradeRes := TradeRes{
+			TradeId:        trade.TradeId,
+			Price:          values[0],
+			BaseVolume:     values[1],
+			TargetVolume:   values[2],
+			TradeTimestamp: trade.TradeTimestamp,
+			Type:           string(trade.Type),
+		}
+		if trade.Type == coingeckoService.TradeTypeBuy {
+			res.Buy = append(res.Buy, tradeRes)
+		} else {
+			res.Sell = append(res.Sell, tradeRes)
+		}
+	}
+	return res, nil
+}
+
+func (m *orderbookMapper) orderbookToRes(orderbook coingeckoService.Orderbook) (OrderbookRes, error) {
+	bids, err := m.ordersToRes(orderbook.Bids)
+	if err != nil {
+		return OrderbookRes{}, err
+	}
+	asks, err := m.ordersToRes(orderbook.Asks)
+	if err != nil {
+		return OrderbookRes{}, err
+	}
+
+	return OrderbookRes{
+		TickerId:  orderbook.TickerId,
+		Timestamp: strconv.FormatInt(orderbook.Timestamp, 10),
+		Bids:      bids,
+		Asks:      asks,
+	}, nil
+}
+
+func (m *orderbookMapper) ordersToRes(orders []coingeckoService.Order) ([][2]string, error) {
+	res := make([][2]string, len(orders))
+	for i, order := range orders {
+		price, err := strconv.ParseFloat(order.Price, 64)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := strconv.ParseFloat(order.Amount, 64)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = [2]string{strconv.FormatFloat(price, 'f', -1, 64), strconv.FormatFloat(amount, 'f', -1, 64)}
+	}
+	return res, nil
+}
@@ -0,0 +1,76 @@
+package coingecko
+
+import (
+	coingeckoService "github.com/dezswap/dezswap-api/api/v1/service/coingecko"
+	"net/http"
+	"strconv"
+
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+type orderbookController struct {
+	coingeckoService.OrderbookService
+	logger logging.Logger
+	orderbookMapper
+}
+
+func InitOrderbookController(s coingeckoService.OrderbookService, route *gin.RouterGroup, logger logging.Logger) *orderbookController {
+	c := orderbookController{s, logger, orderbookMapper{}}
+	c.register(route)
+	return &c
+}
+
+func (c *orderbookController) register(route *gin.RouterGroup) {
+	route.GET("/orderbook", c.Orderbook)
+}
+
+// Coingecko godoc
+//
+//	@Summary		Orderbook
+//	@Description	get the orderbook synthesized from the constant product curve of the pool at the configured levels
+//	@Tags			coingecko
+//	@Accept			json
+//	@Produce		json
+//	@Param			ticker_id	query		string	true	"Ticker ID"
+//	@Param			depth		query		int		false	"Number of orders on both sides, half of it rounded up on each side, 0 returns every level"
+//	@Success		200			{object}	OrderbookRes
+//	@Failure		400			{object}	httputil.BadRequestError
+//	@Failure		404			{object}	httputil.NotFoundError
+//	@Failure		500			{object}	httputil.InternalServerError
+//	@Router			/coingecko/orderbook [get]
+func (c *orderbookController) Orderbook(ctx *gin.Context) {
+	depth := 0
+	if v := ctx.Query("depth"); v != "" {
+		var err error
+		if depth, err = strconv.Atoi(v); err != nil || depth < 0 {
+			httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid depth"))
+			return
+		}
+	}
+
+	orderbook, err := c.OrderbookService.Orderbook(ctx.Request.Context(), ctx.Query("ticker_id"), depth)
+	if err != nil {
+		switch {
+		case errors.Is(err, coingeckoService.ErrInvalidTickerId):
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, coingeckoService.ErrTickerNotFound):
+			httputil.NewError(ctx, http.StatusNotFound, err)
+		default:
+			logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		}
+		return
+	}
+
+	res, err := c.orderbookToRes(*orderbook)
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -41,15 +41,15 @@ func (c *tickerController) register(route *gin.RouterGroup) {
 //	@Failure		500	{object}	httputil.InternalServerError
 //	@Router			/coingecko/tickers [get]
 func (c *tickerController) Tickers(ctx *gin.Context) {
-	tickers, err := c.GetAll()
+	tickers, err := c.GetAll(ctx.Request.Context())
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
 	res, err := c.tickersToRes(tickers)
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -76,9 +76,9 @@ func (c *tickerController) Ticker(ctx *gin.Context) {
 		return
 	}
 
-	ticker, err := c.Get(id)
+	ticker, err := c.Get(ctx.Request.Context(), id)
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -90,7 +90,7 @@ func (c *tickerController) Ticker(ctx *gin.Context) {
 
 	res, err := c.tickerToRes(*ticker)
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
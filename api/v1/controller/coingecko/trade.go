@@ -0,0 +1,84 @@
+package coingecko
+
+import (
+	coingeckoService "github.com/dezswap/dezswap-api/api/v1/service/coingecko"
+	"net/http"
+	"strconv"
+
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+type tradeController struct {
+	coingeckoService.TradeService
+	logger logging.Logger
+	tradeMapper
+}
+
+func InitTradeController(s coingeckoService.TradeService, route *gin.RouterGroup, logger logging.Logger) *tradeController {
+	c := tradeController{s, logger, tradeMapper{}}
+	c.register(route)
+	return &c
+}
+
+func (c *tradeController) register(route *gin.RouterGroup) {
+	route.GET("/historical_trades", c.HistoricalTrades)
+}
+
+// Coingecko godoc
+//
+//	@Summary		Historical trades
+//	@Description	get the latest swaps of the ticker, the limit is capped at 1000 and 0 returns up to the cap
+//	@Tags			coingecko
+//	@Accept			json
+//	@Produce		json
+//	@Param			ticker_id	query		string	true	"Ticker ID"
+//	@Param			type		query		string	false	"Trade type"	Enums(buy, sell)
+//	@Param			limit		query		int		false	"Number of trades"
+//	@Param			start_time	query		int		false	"Start time in milliseconds"
+//	@Param			end_time	query		int		false	"End time in milliseconds"
+//	@Success		200			{object}	HistoricalTradesRes
+//	@Failure		400			{object}	httputil.BadRequestError
+//	@Failure		500			{object}	httputil.InternalServerError
+//	@Router			/coingecko/historical_trades [get]
+func (c *tradeController) HistoricalTrades(ctx *gin.Context) {
+	tradeType := coingeckoService.TradeType(ctx.Query("type"))
+	if tradeType != "" && tradeType != coingeckoService.TradeTypeBuy && tradeType != coingeckoService.TradeTypeSell {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid type"))
+		return
+	}
+
+	params := map[string]int64{"limit": 0, "start_time": 0, "end_time": 0}
+	for key := range params {
+		if v := ctx.Query(key); v != "" {
+			value, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || value < 0 {
+				httputil.NewError(ctx, http.StatusBadRequest, errors.Errorf("invalid %s", key))
+				return
+			}
+			params[key] = value
+		}
+	}
+
+	trades, err := c.TradeService.HistoricalTrades(ctx.Request.Context(), ctx.Query("ticker_id"), tradeType, int(params["limit"]), params["start_time"], params["end_time"])
+	if err != nil {
+		if errors.Is(err, coingeckoService.ErrInvalidTickerId) {
+			httputil.NewError(ctx, http.StatusBadRequest, err)
+			return
+		}
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	res, err := c.tradesToRes(trades)
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -13,3 +13,45 @@ type TickerRes struct {
 	BaseVolume  string `json:"base_volume"`
 	QuoteVolume string `json:"quote_volume"`
 }
+
+type SummariesRes []SummaryRes
+
+type SummaryRes struct {
+	TradingPairs          string `json:"trading_pairs"`
+	BaseCurrency          string `json:"base_currency"`
+	QuoteCurrency         string `json:"quote_currency"`
+	LastPrice             string `json:"last_price"`
+	LowestAsk             string `json:"lowest_ask,omitempty"`
+	HighestBid            string `json:"highest_bid,omitempty"`
+	BaseVolume            string `json:"base_volume"`
+	QuoteVolume           string `json:"quote_volume"`
+	PriceChangePercent24h string `json:"price_change_percent_24h,omitempty"`
+	HighestPrice24h       string `json:"highest_price_24h,omitempty"`
+	LowestPrice24h        string `json:"lowest_price_24h,omitempty"`
+}
+
+// AssetsRes is keyed by the token address
+type AssetsRes map[string]AssetRes
+
+type AssetRes struct {
+	Name                 string `json:"name"`
+	Symbol               string `json:"symbol"`
+	UnifiedCryptoassetId string `json:"unified_cryptoasset_id,omitempty"`
+	ContractAddress      string `json:"contractAddress"`
+	Decimals             int    `json:"decimals"`
+	CanWithdraw          bool   `json:"can_withdraw"`
+	CanDeposit           bool   `json:"can_deposit"`
+	MakerFee             string `json:"maker_fee"`
+	TakerFee             string `json:"taker_fee"`
+}
+
+type TradesRes []TradeRes
+
+type TradeRes struct {
+	TradeId     uint64 `json:"trade_id"`
+	Price       string `json:"price"`
+	BaseVolume  string `json:"base_volume"`
+	QuoteVolume string `json:"quote_volume"`
+	Timestamp   int64  `json:"timestamp"`
+	Type        string `json:"type"`
+}
@@ -1,11 +1,17 @@
 package coinmarketcap
 
 import (
+	"strconv"
+
+	coinGeckoService "github.com/dezswap/dezswap-api/api/v1/service/coingecko"
 	coinMarketCapService "github.com/dezswap/dezswap-api/api/v1/service/coinmarketcap"
+	"github.com/dezswap/dezswap-api/pkg/dezswap"
 )
 
 type tickerMapper struct{}
 
+type summaryMapper struct{}
+
 func (m *tickerMapper) tickerToRes(ticker coinMarketCapService.Ticker) TickerRes {
 	return TickerRes{
 		BaseId:      ticker.BaseAddress,
@@ -27,3 +33,57 @@ func (m *tickerMapper) tickersToRes(tickers []coinMarketCapService.Ticker) Ticke
 
 	return res
 }
+
+func (m *summaryMapper) summariesToRes(summaries []coinMarketCapService.Summary) SummariesRes {
+	res := make(SummariesRes, len(summaries))
+	for i, s := range summaries {
+		res[i] = SummaryRes{
+			TradingPairs:          s.BaseAddress + "_" + s.QuoteAddress,
+			BaseCurrency:          s.BaseAddress,
+			QuoteCurrency:         s.QuoteAddress,
+			LastPrice:             s.LastPrice,
+			LowestAsk:             s.LowestAsk,
+			HighestBid:            s.HighestBid,
+			BaseVolume:            s.BaseVolume,
+			QuoteVolume:           s.QuoteVolume,
+			PriceChangePercent24h: s.PriceChangePercent24h,
+			HighestPrice24h:       s.HighestPrice24h,
+			LowestPrice24h:        s.LowestPrice24h,
+		}
+	}
+	return res
+}
+
+// assetsToRes maps the assets which are deposited and withdrawn freely, the fee is the commission of the swap
+func (m *summaryMapper) assetsToRes(assets []coinMarketCapService.Asset) AssetsRes {
+	fee := strconv.FormatFloat(dezswap.SWAP_FEE, 'f', -1, 64)
+	res := make(AssetsRes, len(assets))
+	for _, a := range assets {
+		res[a.Address] = AssetRes{
+			Name:            a.Name,
+			Symbol:          a.Symbol,
+			ContractAddress: a.Address,
+			Decimals:        a.Decimals,
+			CanWithdraw:     true,
+			CanDeposit:      true,
+			MakerFee:        fee,
+			TakerFee:        fee,
+		}
+	}
+	return res
+}
+
+func (m *summaryMapper) tradesToRes(trades []coinGeckoService.Trade) TradesRes {
+	res := make(TradesRes, len(trades))
+	for i, t := range trades {
+		res[i] = TradeRes{
+			TradeId:     t.TradeId,
+			Price:       t.Price,
+			BaseVolume:  t.BaseVolume,
+			QuoteVolume: t.TargetVolume,
+			Timestamp:   t.TradeTimestamp,
+			Type:        string(t.Type),
+		}
+	}
+	return res
+}
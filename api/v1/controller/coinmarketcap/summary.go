@@ -0,0 +1,103 @@
+package coinmarketcap
+
+import (
+	coinGeckoService "github.com/dezswap/dezswap-api/api/v1/service/coingecko"
+	coinMarketCapService "github.com/dezswap/dezswap-api/api/v1/service/coinmarketcap"
+	"net/http"
+	"time"
+
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+type summaryController struct {
+	coinMarketCapService.SummaryService
+	// trades are shared with CoinGecko, only the fields are renamed
+	trades coinGeckoService.TradeService
+	logger logging.Logger
+	summaryMapper
+}
+
+func InitSummaryController(s coinMarketCapService.SummaryService, trades coinGeckoService.TradeService, route *gin.RouterGroup, logger logging.Logger) *summaryController {
+	c := summaryController{s, trades, logger, summaryMapper{}}
+	c.register(route)
+	return &c
+}
+
+func (c *summaryController) register(route *gin.RouterGroup) {
+	route.GET("/summary", c.Summary)
+	route.GET("/assets", c.Assets)
+	route.GET("/trades/:market_pair", c.Trades)
+}
+
+// CoinMarketCap godoc
+//
+//	@Summary		Summary
+//	@Description	get the 24h summary of every market pair
+//	@Tags			coinmarketcap
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	SummariesRes
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/coinmarketcap/summary [get]
+func (c *summaryController) Summary(ctx *gin.Context) {
+	summaries, err := c.Summaries(ctx.Request.Context())
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.summariesToRes(summaries))
+}
+
+// CoinMarketCap godoc
+//
+//	@Summary		Assets
+//	@Description	get the assets of the market pairs keyed by the address
+//	@Tags			coinmarketcap
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	AssetsRes
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/coinmarketcap/assets [get]
+func (c *summaryController) Assets(ctx *gin.Context) {
+	assets, err := c.SummaryService.Assets(ctx.Request.Context())
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.assetsToRes(assets))
+}
+
+// CoinMarketCap godoc
+//
+//	@Summary		Trades
+//	@Description	get the swaps of the market pair in 24h, the latest first
+//	@Tags			coinmarketcap
+//	@Accept			json
+//	@Produce		json
+//	@Param			market_pair	path		string	true	"Market pair in the form of base_quote"
+//	@Success		200			{object}	TradesRes
+//	@Failure		400			{object}	httputil.BadRequestError
+//	@Failure		500			{object}	httputil.InternalServerError
+//	@Router			/coinmarketcap/trades/{market_pair} [get]
+func (c *summaryController) Trades(ctx *gin.Context) {
+	since := time.Now().Add(-24 * time.Hour).UnixMilli()
+	trades, err := c.trades.HistoricalTrades(ctx.Request.Context(), ctx.Param("market_pair"), "", coinGeckoService.MaxTradesLimit, since, 0)
+	if err != nil {
+		if errors.Is(err, coinGeckoService.ErrInvalidTickerId) {
+			httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid market pair"))
+			return
+		}
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.tradesToRes(trades))
+}
@@ -60,9 +60,9 @@ func (c *dashboardController) register(route *gin.RouterGroup) {
 //	@Failure		500	{object}	httputil.InternalServerError
 //	@Router			/dashboard/recent [get]
 func (c *dashboardController) Recent(ctx *gin.Context) {
-	recent, err := c.Dashboard.Recent()
+	recent, err := c.Dashboard.Recent(ctx.Request.Context())
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -108,11 +108,11 @@ func (c *dashboardController) ChartByToken(ctx *gin.Context) {
 	var chart dashboard2.TokenChart
 	switch chartType {
 	case ChartTypeVolume:
-		chart, err = c.TokenVolumes(addr, duration)
+		chart, err = c.TokenVolumes(ctx.Request.Context(), addr, duration)
 	case ChartTypeTvl:
-		chart, err = c.TokenTvls(addr, duration)
+		chart, err = c.TokenTvls(ctx.Request.Context(), addr, duration)
 	case ChartTypePrice:
-		chart, err = c.TokenPrices(addr, duration)
+		chart, err = c.TokenPrices(ctx.Request.Context(), addr, duration)
 	default:
 		httputil.NewError(ctx, http.StatusBadRequest, errors.New("unsupported chart type"))
 		return
@@ -123,7 +123,7 @@ func (c *dashboardController) ChartByToken(ctx *gin.Context) {
 	}
 
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -170,19 +170,19 @@ func (c *dashboardController) ChartByPool(ctx *gin.Context) {
 	switch chartType {
 	case ChartTypeVolume:
 		var volumes dashboard2.Volumes
-		volumes, err = c.VolumesOf(addr, duration)
+		volumes, err = c.VolumesOf(ctx.Request.Context(), addr, duration)
 		res = c.volumesToChartRes(volumes)
 	case ChartTypeTvl:
 		var tvls dashboard2.Tvls
-		tvls, err = c.TvlsOf(addr, duration)
+		tvls, err = c.TvlsOf(ctx.Request.Context(), addr, duration)
 		res = c.tvlsToChartRes(tvls)
 	case ChartTypeApr:
 		var aprs dashboard2.Aprs
-		aprs, err = c.AprsOf(addr, duration)
+		aprs, err = c.AprsOf(ctx.Request.Context(), addr, duration)
 		res = c.aprsToChartRes(aprs)
 	case ChartTypeFee:
 		var fees dashboard2.Fees
-		fees, err = c.FeesOf(addr, duration)
+		fees, err = c.FeesOf(ctx.Request.Context(), addr, duration)
 		res = c.feesToChartRes(fees)
 	default:
 		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid chart type"))
@@ -190,7 +190,7 @@ func (c *dashboardController) ChartByPool(ctx *gin.Context) {
 	}
 
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -230,19 +230,19 @@ func (c *dashboardController) Chart(ctx *gin.Context) {
 	switch chartType {
 	case ChartTypeVolume:
 		var volumes dashboard2.Volumes
-		volumes, err = c.Volumes(duration)
+		volumes, err = c.Volumes(ctx.Request.Context(), duration)
 		res = c.volumesToChartRes(volumes)
 	case ChartTypeTvl:
 		var tvls dashboard2.Tvls
-		tvls, err = c.Tvls(duration)
+		tvls, err = c.Tvls(ctx.Request.Context(), duration)
 		res = c.tvlsToChartRes(tvls)
 	case ChartTypeApr:
 		var aprs dashboard2.Aprs
-		aprs, err = c.Aprs(duration)
+		aprs, err = c.Aprs(ctx.Request.Context(), duration)
 		res = c.aprsToChartRes(aprs)
 	case ChartTypeFee:
 		var fees dashboard2.Fees
-		fees, err = c.Fees(duration)
+		fees, err = c.Fees(ctx.Request.Context(), duration)
 		res = c.feesToChartRes(fees)
 	default:
 		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid chart type"))
@@ -250,7 +250,7 @@ func (c *dashboardController) Chart(ctx *gin.Context) {
 	}
 
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -270,9 +270,9 @@ func (c *dashboardController) Chart(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.InternalServerError
 //	@Router			/dashboard/statistics [get]
 func (c *dashboardController) Statistic(ctx *gin.Context) {
-	statistic, err := c.Dashboard.Statistic()
+	statistic, err := c.Dashboard.Statistic(ctx.Request.Context())
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -298,16 +298,16 @@ func (c *dashboardController) Pools(ctx *gin.Context) {
 	var pools dashboard2.Pools
 	var err error
 	if len(token) > 0 {
-		pools, err = c.Dashboard.Pools(dashboard2.Addr(token))
+		pools, err = c.Dashboard.Pools(ctx.Request.Context(), dashboard2.Addr(token))
 		if err != nil {
-			c.logger.Warn(err)
+			logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 			return
 		}
 	} else {
-		pools, err = c.Dashboard.Pools()
+		pools, err = c.Dashboard.Pools(ctx.Request.Context())
 		if err != nil {
-			c.logger.Warn(err)
+			logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 			return
 		}
@@ -337,9 +337,9 @@ func (c *dashboardController) Pool(ctx *gin.Context) {
 		return
 	}
 
-	poolDetail, err := c.PoolDetail(dashboard2.Addr(address))
+	poolDetail, err := c.PoolDetail(ctx.Request.Context(), dashboard2.Addr(address))
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -373,9 +373,9 @@ func (c *dashboardController) Token(ctx *gin.Context) {
 	}
 	address = httputil.DecodeAddressParam(address)
 
-	token, err := c.Dashboard.Token(dashboard2.Addr(address))
+	token, err := c.Dashboard.Token(ctx.Request.Context(), dashboard2.Addr(address))
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -400,9 +400,9 @@ func (c *dashboardController) Token(ctx *gin.Context) {
 //	@Failure		500	{object}	httputil.InternalServerError
 //	@Router			/dashboard/tokens [get]
 func (c *dashboardController) Tokens(ctx *gin.Context) {
-	tokens, err := c.Dashboard.Tokens()
+	tokens, err := c.Dashboard.Tokens(ctx.Request.Context())
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -438,14 +438,14 @@ func (c *dashboardController) Txs(ctx *gin.Context) {
 	var txs dashboard2.Txs
 	var err error
 	if len(tokens) > 0 {
-		txs, err = c.TxsOfToken(txType, tokens...)
+		txs, err = c.TxsOfToken(ctx.Request.Context(), txType, tokens...)
 	} else if len(pool) > 0 {
-		txs, err = c.Dashboard.Txs(txType, pool)
+		txs, err = c.Dashboard.Txs(ctx.Request.Context(), txType, pool)
 	} else {
-		txs, err = c.Dashboard.Txs(txType)
+		txs, err = c.Dashboard.Txs(ctx.Request.Context(), txType)
 	}
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
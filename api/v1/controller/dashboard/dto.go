@@ -53,6 +53,8 @@ type TokenRes struct {
 	Address         string  `json:"address"`
 	Price           string  `json:"price"`
 	PriceChange     float32 `json:"priceChange"`
+	MarketCap       string  `json:"marketCap"`
+	Fdv             string  `json:"fdv"`
 	Volume24h       string  `json:"volume24h"`
 	Volume24hChange string  `json:"volume24hChange,omitempty"`
 	Volume7d        string  `json:"volume7d,omitempty"`
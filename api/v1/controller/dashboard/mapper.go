@@ -17,6 +17,8 @@ func (m *mapper) tokenToRes(token ds.Token) TokenRes {
 		Address:         string(token.Addr),
 		Price:           token.Price,
 		PriceChange:     token.PriceChange,
+		MarketCap:       token.MarketCap,
+		Fdv:             token.Fdv,
 		Volume24h:       token.Volume,
 		Volume24hChange: token.VolumeChange,
 		Volume7d:        token.Volume7d,
@@ -0,0 +1,116 @@
+package depth
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/dezswap/dezswap-api/api/v1/service/depth"
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+type depthController struct {
+	depth.Service
+	*mapper
+	logger logging.Logger
+}
+
+type mapper struct{}
+
+func InitDepthController(s depth.Service, route *gin.RouterGroup, logger logging.Logger) *depthController {
+	c := depthController{s, &mapper{}, logger}
+	c.register(route)
+	return &c
+}
+
+func (c *depthController) register(route *gin.RouterGroup) {
+	route.GET("", c.Depths)
+	route.GET("/:address", c.Depth)
+}
+
+// Depths godoc
+//
+// @Tags			depth
+// @Summary		All depths
+// @Description	get the constant product depths of every pool at the configured percentages on the latest indexed reserves
+// @Accept			json
+// @Produce		json
+// @Success		200	{object}	DepthsRes
+// @Failure		500	{object}	httputil.InternalServerError
+// @Router			/depth [get]
+func (c *depthController) Depths(ctx *gin.Context) {
+	depths, err := c.Service.Depths(ctx.Request.Context())
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.depthsToRes(depths))
+}
+
+// Depth godoc
+//
+// @Tags			depth
+// @Summary		Get a depth
+// @Description	get the constant product depth of the pair at the configured percentages on the latest indexed reserves
+// @Accept			json
+// @Produce		json
+// @Param			address	path		string	true	"Pair Address"
+// @Success		200		{object}	DepthRes
+// @Failure		400		{object}	httputil.BadRequestError
+// @Failure		404		{object}	httputil.NotFoundError
+// @Failure		500		{object}	httputil.InternalServerError
+// @Router			/depth/{address} [get]
+func (c *depthController) Depth(ctx *gin.Context) {
+	address := ctx.Param("address")
+	if address == "" {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid address"))
+		return
+	}
+
+	d, err := c.Service.Depth(ctx.Request.Context(), address)
+	if err != nil {
+		if errors.Is(err, depth.ErrPoolNotFound) {
+			httputil.NewError(ctx, http.StatusNotFound, err)
+			return
+		}
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.depthToRes(*d))
+}
+
+func (m *mapper) depthToRes(d depth.Depth) DepthRes {
+	levels := make([]DepthLevelRes, len(d.Levels))
+	for i, level := range d.Levels {
+		levels[i] = DepthLevelRes{
+			Percentage:   level.Percentage.String(),
+			Asset0Amount: level.Asset0Amount.String(),
+			Asset1Amount: level.Asset1Amount.String(),
+			ValueInUsd:   level.ValueInPrice.String(),
+		}
+	}
+
+	return DepthRes{
+		Pair:             d.Pair,
+		Asset0:           d.Asset0,
+		Asset1:           d.Asset1,
+		SpreadPercentage: d.SpreadPercentage.String(),
+		Levels:           levels,
+		Height:           d.Height,
+	}
+}
+
+func (m *mapper) depthsToRes(depths map[string]depth.Depth) DepthsRes {
+	res := make(DepthsRes, 0, len(depths))
+	for _, d := range depths {
+		res = append(res, m.depthToRes(d))
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Pair < res[j].Pair })
+	return res
+}
@@ -0,0 +1,21 @@
+package depth
+
+type DepthsRes []DepthRes
+
+type DepthRes struct {
+	Pair             string          `json:"pair"`
+	Asset0           string          `json:"asset0"`
+	Asset1           string          `json:"asset1"`
+	SpreadPercentage string          `json:"spreadPercentage"`
+	Levels           []DepthLevelRes `json:"levels"`
+	Height           uint64          `json:"height"`
+}
+
+type DepthLevelRes struct {
+	// Percentage is the price move of asset0 in asset1, negative on the bid side
+	Percentage   string `json:"percentage"`
+	Asset0Amount string `json:"asset0Amount"`
+	Asset1Amount string `json:"asset1Amount"`
+	// ValueInUsd is the value of asset1Amount, the price token is regarded as USD
+	ValueInUsd string `json:"valueInUsd"`
+}
@@ -0,0 +1,234 @@
+package dexscreener
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+
+	cmath "cosmossdk.io/math"
+	ds "github.com/dezswap/dezswap-api/api/v1/service/dexscreener"
+	"github.com/dezswap/dezswap-api/pkg/dezswap"
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const dexKey = "dezswap"
+
+// maxBlockRange caps the blocks of an events request, about 17 hours of 6 second blocks
+const maxBlockRange = 10_000
+
+type dexScreenerController struct {
+	ds.Service
+	*mapper
+	logger logging.Logger
+}
+
+type mapper struct{}
+
+func InitDexScreenerController(s ds.Service, route *gin.RouterGroup, logger logging.Logger) *dexScreenerController {
+	c := dexScreenerController{s, &mapper{}, logger}
+	c.register(route)
+	return &c
+}
+
+func (c *dexScreenerController) register(route *gin.RouterGroup) {
+	route.GET("/latest-block", c.LatestBlock)
+	route.GET("/asset", c.Asset)
+	route.GET("/pair", c.Pair)
+	route.GET("/events", c.Events)
+}
+
+// DexScreener godoc
+//
+//	@Summary		Latest block
+//	@Description	get the latest block of which events are all indexed, timed by the nodes
+//	@Tags			dexscreener
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	LatestBlockRes
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/dexscreener/latest-block [get]
+func (c *dexScreenerController) LatestBlock(ctx *gin.Context) {
+	block, err := c.Service.LatestBlock(ctx.Request.Context())
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, LatestBlockRes{Block: c.blockToRes(*block)})
+}
+
+// DexScreener godoc
+//
+//	@Summary		Get an asset
+//	@Description	get the asset by the token address
+//	@Tags			dexscreener
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	query		string	true	"Token address"
+//	@Success		200	{object}	AssetRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/dexscreener/asset [get]
+func (c *dexScreenerController) Asset(ctx *gin.Context) {
+	id := ctx.Query("id")
+	if id == "" {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid id"))
+		return
+	}
+
+	asset, err := c.Service.Asset(ctx.Request.Context(), id)
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+	if asset == nil {
+		httputil.NewError(ctx, http.StatusNotFound, errors.New("asset not found"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, AssetRes{Asset: c.assetToRes(*asset)})
+}
+
+// DexScreener godoc
+//
+//	@Summary		Get a pair
+//	@Description	get the pair by the pair address
+//	@Tags			dexscreener
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	query		string	true	"Pair address"
+//	@Success		200	{object}	PairRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/dexscreener/pair [get]
+func (c *dexScreenerController) Pair(ctx *gin.Context) {
+	id := ctx.Query("id")
+	if id == "" {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid id"))
+		return
+	}
+
+	pair, err := c.Service.Pair(ctx.Request.Context(), id)
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+	if pair == nil {
+		httputil.NewError(ctx, http.StatusNotFound, errors.New("pair not found"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, PairRes{Pair: c.pairToRes(*pair)})
+}
+
+// DexScreener godoc
+//
+//	@Summary		Events
+//	@Description	get the swaps, joins and exits between the blocks inclusive, the reserves are at the end of the block.
+//	@Description	A range over 10000 blocks is rejected.
+//	@Tags			dexscreener
+//	@Accept			json
+//	@Produce		json
+//	@Param			fromBlock	query		int	true	"From block"
+//	@Param			toBlock		query		int	true	"To block"
+//	@Success		200			{object}	EventsRes
+//	@Failure		400			{object}	httputil.BadRequestError
+//	@Failure		500			{object}	httputil.InternalServerError
+//	@Router			/dexscreener/events [get]
+func (c *dexScreenerController) Events(ctx *gin.Context) {
+	fromBlock, err := strconv.ParseUint(ctx.Query("fromBlock"), 10, 64)
+	if err != nil {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid fromBlock"))
+		return
+	}
+	toBlock, err := strconv.ParseUint(ctx.Query("toBlock"), 10, 64)
+	if err != nil || toBlock < fromBlock {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid toBlock"))
+		return
+	}
+	if toBlock-fromBlock >= maxBlockRange {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.Errorf("block range exceeds %d", maxBlockRange))
+		return
+	}
+
+	events, err := c.Service.Events(ctx.Request.Context(), fromBlock, toBlock)
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.eventsToRes(events))
+}
+
+func (m *mapper) blockToRes(block ds.Block) BlockRes {
+	return BlockRes{BlockNumber: block.BlockNumber, BlockTimestamp: block.BlockTimestamp}
+}
+
+func (m *mapper) assetToRes(asset ds.Asset) AssetItemRes {
+	totalSupply := asset.TotalSupply
+	if totalSupply == "" {
+		totalSupply = "0"
+	}
+	return AssetItemRes{
+		Id:                asset.Id,
+		Name:              asset.Name,
+		Symbol:            asset.Symbol,
+		TotalSupply:       totalSupply,
+		CirculatingSupply: asset.CirculatingSupply,
+	}
+}
+
+func (m *mapper) pairToRes(pair ds.Pair) PairItemRes {
+	return PairItemRes{
+		Id:                      pair.Id,
+		DexKey:                  dexKey,
+		Asset0Id:                pair.Asset0Id,
+		Asset1Id:                pair.Asset1Id,
+		CreatedAtBlockNumber:    pair.CreatedAtBlockNumber,
+		CreatedAtBlockTimestamp: pair.CreatedAtBlockTimestamp,
+		CreatedAtTxnId:          pair.CreatedAtTxnId,
+		Creator:                 pair.Creator,
+		FeeBps:                  int(math.Round(dezswap.SWAP_FEE * 10_000)),
+	}
+}
+
+func (m *mapper) eventsToRes(events []ds.Event) EventsRes {
+	res := EventsRes{Events: make([]EventRes, len(events))}
+	for i, e := range events {
+		res.Events[i] = EventRes{
+			Block:       m.blockToRes(e.Block),
+			EventType:   string(e.EventType),
+			TxnId:       e.TxnId,
+			TxnIndex:    e.TxnIndex,
+			EventIndex:  e.EventIndex,
+			Maker:       e.Maker,
+			PairId:      e.PairId,
+			Asset0In:    decToRes(e.Asset0In),
+			Asset1In:    decToRes(e.Asset1In),
+			Asset0Out:   decToRes(e.Asset0Out),
+			Asset1Out:   decToRes(e.Asset1Out),
+			PriceNative: decToRes(e.PriceNative),
+			Amount0:     decToRes(e.Amount0),
+			Amount1:     decToRes(e.Amount1),
+			Reserves:    ReservesRes{Asset0: decToRes(e.Reserve0), Asset1: decToRes(e.Reserve1)},
+		}
+	}
+	return res
+}
+
+// decToRes returns an empty string for a nil decimal which is omitted
+func decToRes(d cmath.LegacyDec) string {
+	if d.IsNil() {
+		return ""
+	}
+	return d.String()
+}
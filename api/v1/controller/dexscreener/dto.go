@@ -0,0 +1,67 @@
+package dexscreener
+
+type LatestBlockRes struct {
+	Block BlockRes `json:"block"`
+}
+
+type BlockRes struct {
+	BlockNumber    uint64 `json:"blockNumber"`
+	BlockTimestamp int64  `json:"blockTimestamp"`
+}
+
+type AssetRes struct {
+	Asset AssetItemRes `json:"asset"`
+}
+
+type AssetItemRes struct {
+	Id                string `json:"id"`
+	Name              string `json:"name"`
+	Symbol            string `json:"symbol"`
+	TotalSupply       string `json:"totalSupply"`
+	CirculatingSupply string `json:"circulatingSupply,omitempty"`
+}
+
+type PairRes struct {
+	Pair PairItemRes `json:"pair"`
+}
+
+type PairItemRes struct {
+	Id                      string `json:"id"`
+	DexKey                  string `json:"dexKey"`
+	Asset0Id                string `json:"asset0Id"`
+	Asset1Id                string `json:"asset1Id"`
+	CreatedAtBlockNumber    uint64 `json:"createdAtBlockNumber,omitempty"`
+	CreatedAtBlockTimestamp int64  `json:"createdAtBlockTimestamp,omitempty"`
+	CreatedAtTxnId          string `json:"createdAtTxnId,omitempty"`
+	Creator                 string `json:"creator,omitempty"`
+	FeeBps                  int    `json:"feeBps"`
+}
+
+type EventsRes struct {
+	Events []EventRes `json:"events"`
+}
+
+type EventRes struct {
+	Block      BlockRes `json:"block"`
+	EventType  string   `json:"eventType"`
+	TxnId      string   `json:"txnId"`
+	TxnIndex   int      `json:"txnIndex"`
+	EventIndex int      `json:"eventIndex"`
+	Maker      string   `json:"maker"`
+	PairId     string   `json:"pairId"`
+	// swap only
+	Asset0In    string `json:"asset0In,omitempty"`
+	Asset1In    string `json:"asset1In,omitempty"`
+	Asset0Out   string `json:"asset0Out,omitempty"`
+	Asset1Out   string `json:"asset1Out,omitempty"`
+	PriceNative string `json:"priceNative,omitempty"`
+	// join and exit only
+	Amount0  string      `json:"amount0,omitempty"`
+	Amount1  string      `json:"amount1,omitempty"`
+	Reserves ReservesRes `json:"reserves"`
+}
+
+type ReservesRes struct {
+	Asset0 string `json:"asset0"`
+	Asset1 string `json:"asset1"`
+}
@@ -5,14 +5,43 @@ import (
 )
 
 type HealthDependency struct {
-	Name   string `json:"name"`
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+}
+
+// HealthIndexer is the freshness of the indexed data, the ages are omitted when there is no data or nothing is behind
+type HealthIndexer struct {
 	Status string `json:"status"`
+	// SyncedHeight is the height synced by the parser, its age is of since the parser synced it
+	SyncedHeight           uint64 `json:"syncedHeight"`
+	SyncedHeightAgeSeconds *int64 `json:"syncedHeightAgeSeconds,omitempty"`
+	// PoolHeight is the newest height of the latest pools
+	PoolHeight uint64 `json:"poolHeight"`
+	// PoolsBehindSeconds is the age of the oldest pool change synced above the pool height, omitted when the pools are up to date
+	PoolsBehindSeconds  *int64 `json:"poolsBehindSeconds,omitempty"`
+	MaxStalenessSeconds int64  `json:"maxStalenessSeconds,omitempty"`
+}
+
+type HealthPrices struct {
+	Status    string `json:"status"`
+	LastFetch string `json:"lastFetch,omitempty"`
+}
+
+type HealthRoutes struct {
+	Status     string `json:"status"`
+	Count      int64  `json:"count"`
+	ModifiedAt string `json:"modifiedAt,omitempty"`
+	AgeSeconds *int64 `json:"ageSeconds,omitempty"`
 }
 
 type HealthResponse struct {
 	Status       string             `json:"status"`
 	Timestamp    string             `json:"timestamp"`
 	Dependencies []HealthDependency `json:"dependencies"`
+	Indexer      HealthIndexer      `json:"indexer"`
+	Prices       HealthPrices       `json:"prices"`
+	Routes       HealthRoutes       `json:"routes"`
 }
 
 type PoolsRes []PoolRes
@@ -34,6 +63,16 @@ type TokenRes struct {
 	Icon        string `json:"icon"`
 	Protocol    string `json:"protocol"`
 	Verified    bool   `json:"verified"`
+
+	Ibc *IbcOriginRes `json:"ibc,omitempty"`
+}
+
+// IbcOriginRes describes where an IBC token comes from
+type IbcOriginRes struct {
+	Path        string `json:"path"`
+	Channel     string `json:"channel"`
+	BaseDenom   string `json:"baseDenom"`
+	OriginChain string `json:"originChain,omitempty"`
 }
 
 type PairsRes struct {
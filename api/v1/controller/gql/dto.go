@@ -0,0 +1,17 @@
+package gql
+
+type Req struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type Res struct {
+	Data   map[string]any `json:"data"`
+	Errors []ErrorRes     `json:"errors,omitempty"`
+}
+
+type ErrorRes struct {
+	Message string   `json:"message"`
+	Path    []string `json:"path,omitempty"`
+}
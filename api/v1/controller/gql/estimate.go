@@ -0,0 +1,174 @@
+package gql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/ast"
+)
+
+// txsCount is of the latest txs served by the dashboard
+const txsCount = 100
+
+// selection is a field selected under a root field, the fragments are flattened and the aliases of a field count once
+type selection struct {
+	name     string
+	children []*selection
+}
+
+// estimator charges the selections of a root field as the budget charges the execution,
+// the lists are counted by the getters of the query and each time series is run once per distinct object
+type estimator struct {
+	ctx   context.Context
+	root  *ast.ObjectTypeDefinition
+	limit int
+}
+
+// estimate charges the complexity of a root field before it resolves anything.
+// The selections are of the document graph-gophers parsed and validated for the execution, the query is not parsed again.
+func estimate(ctx context.Context, root *ast.ObjectTypeDefinition, field string) error {
+	r := requestOf(ctx)
+	e := &estimator{ctx: ctx, root: root, limit: int(r.estimated.limit)}
+	cost, err := e.rootField(field, graphql.SelectedFieldNames(ctx))
+	if err != nil {
+		return err
+	}
+	return r.estimated.charge(cost)
+}
+
+// rootField is the complexity of a root field with the selected paths under it
+func (e *estimator) rootField(field string, paths []string) (int, error) {
+	def := e.root.Fields.Get(field)
+	if def == nil {
+		return 0, nil
+	}
+	return e.field(def, selectionsOf(paths), e.root, 1)
+}
+
+// selectionsOf builds the selections of the dot-delimited paths, a path comes after the path of its parent
+func selectionsOf(paths []string) []*selection {
+	root := &selection{}
+	nodes := map[string]*selection{"": root}
+	for _, path := range paths {
+		parent, name := "", path
+		if i := strings.LastIndex(path, "."); i >= 0 {
+			parent, name = path[:i], path[i+1:]
+		}
+		p, ok := nodes[parent]
+		if !ok {
+			continue
+		}
+		node := &selection{name: name}
+		p.children = append(p.children, node)
+		nodes[path] = node
+	}
+	return root.children
+}
+
+func (e *estimator) selections(selections []*selection, parent *ast.ObjectTypeDefinition, parents int) (int, error) {
+	cost := 0
+	for _, s := range selections {
+		def := parent.Fields.Get(s.name)
+		if def == nil {
+			continue
+		}
+		c, err := e.field(def, s.children, parent, parents)
+		if err != nil {
+			return 0, err
+		}
+		if cost = e.add(cost, c); cost > e.limit {
+			break
+		}
+	}
+	return cost, nil
+}
+
+func (e *estimator) field(def *ast.FieldDefinition, children []*selection, parent *ast.ObjectTypeDefinition, parents int) (int, error) {
+	typ, list := unwrap(def.Type)
+	object, ok := typ.(*ast.ObjectTypeDefinition)
+	if !ok {
+		return 0, nil
+	}
+
+	cost := 0
+	switch object.Name {
+	case "TimeValue":
+		runs, err := e.runs(parent, parents)
+		return e.mul(runs, seriesCost), err
+	case "Tx":
+		runs, err := e.runs(parent, parents)
+		if err != nil {
+			return 0, err
+		}
+		cost = e.mul(runs, seriesCost)
+	}
+	objects := parents
+	if list {
+		size, err := e.size(object.Name)
+		if err != nil {
+			return 0, err
+		}
+		objects = e.mul(parents, size)
+	}
+	nested, err := e.selections(children, object, objects)
+	if err != nil {
+		return 0, err
+	}
+	return e.add(e.add(cost, objects), nested), nil
+}
+
+// runs is of the queries of a field of the parents, which are memoized by the parent
+func (e *estimator) runs(parent *ast.ObjectTypeDefinition, parents int) (int, error) {
+	if parent == e.root {
+		return min(parents, 1), nil
+	}
+	size, err := e.size(parent.Name)
+	return min(parents, size), err
+}
+
+// size is the length of a list of the type, the whole pairs, pools or tokens at most
+func (e *estimator) size(name string) (int, error) {
+	r := requestOf(e.ctx)
+	switch name {
+	case "Pair":
+		pairs, err := r.pairs.GetAll(e.ctx)
+		return len(pairs), err
+	case "Pool":
+		pools, err := r.pools.GetAll(e.ctx)
+		return len(pools), err
+	case "Token":
+		tokens, err := r.tokens.GetAll(e.ctx)
+		return len(tokens), err
+	case "Tx":
+		return txsCount, nil
+	}
+	return 1, nil
+}
+
+// add and mul saturate beyond the limit
+func (e *estimator) add(a, b int) int {
+	return min(a+b, e.limit+1)
+}
+
+func (e *estimator) mul(a, b int) int {
+	if b != 0 && a > (e.limit+1)/b {
+		return e.limit + 1
+	}
+	return min(a*b, e.limit+1)
+}
+
+func unwrap(t ast.Type) (ast.Type, bool) {
+	list := false
+	for {
+		switch u := t.(type) {
+		case *ast.NonNull:
+			t = u.OfType
+		case *ast.List:
+			list = true
+			t = u.OfType
+		default:
+			return t, list
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package gql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+
+	"github.com/dezswap/dezswap-api/api/v1/service"
+	"github.com/dezswap/dezswap-api/api/v1/service/dashboard"
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/ast"
+	"github.com/pkg/errors"
+)
+
+//go:embed schema.graphql
+var schema string
+
+type graphqlController struct {
+	schema        *graphql.Schema
+	resolver      *resolver
+	maxComplexity int
+	logger        logging.Logger
+}
+
+func InitGraphqlController(
+	pairs service.Getter[service.Pair], pools service.Getter[service.Pool], tokens service.Getter[service.Token], dashboard dashboard.Dashboard,
+	maxDepth int, maxComplexity int, route *gin.RouterGroup, logger logging.Logger,
+) *graphqlController {
+	r := &resolver{pairs: pairs, pools: pools, tokens: tokens, dashboard: dashboard}
+	c := graphqlController{
+		schema:        parseSchema(r, graphql.MaxDepth(maxDepth)),
+		resolver:      r,
+		maxComplexity: maxComplexity,
+		logger:        logger,
+	}
+	c.register(route)
+	return &c
+}
+
+// parseSchema parses the schema of r, the query type is kept for the estimates of the root fields
+func parseSchema(r *resolver, opts ...graphql.SchemaOpt) *graphql.Schema {
+	s := graphql.MustParseSchema(schema, r, opts...)
+	r.root = s.ASTSchema().RootOperationTypes["query"].(*ast.ObjectTypeDefinition)
+	return s
+}
+
+func (c *graphqlController) register(route *gin.RouterGroup) {
+	route.GET("", c.Query)
+	route.POST("", c.Query)
+}
+
+// Graphql godoc
+//
+//	@Summary		GraphQL query
+//	@Description	run a GraphQL query of the pairs, the pools, the tokens, the txs and their time series, the schema is served by introspection.
+//	@Description	A query deeper than the depth limit is rejected before the execution, a field of the query estimated to resolve more objects than the complexity limit fails before it resolves anything, a time series or txs query counts as 500 objects.
+//	@Tags			graphql
+//	@Accept			json
+//	@Produce		json
+//	@Param			query			query		string	false	"Query of a GET request"
+//	@Param			operationName	query		string	false	"Operation of a GET request"
+//	@Param			variables		query		string	false	"JSON encoded variables of a GET request"
+//	@Param			request			body		Req		false	"Query of a POST request"
+//	@Success		200				{object}	Res
+//	@Failure		400				{object}	httputil.BadRequestError
+//	@Router			/graphql [get]
+//	@Router			/graphql [post]
+func (c *graphqlController) Query(ctx *gin.Context) {
+	req := Req{}
+	if ctx.Request.Method == http.MethodPost {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid request body"))
+			return
+		}
+	} else {
+		req.Query = ctx.Query("query")
+		req.OperationName = ctx.Query("operationName")
+		if variables := ctx.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid variables"))
+				return
+			}
+		}
+	}
+	if req.Query == "" {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("query is required"))
+		return
+	}
+
+	res := c.schema.Exec(withRequest(ctx.Request.Context(), c.resolver, c.maxComplexity), req.Query, req.OperationName, req.Variables)
+	ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,179 @@
+package gql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dezswap/dezswap-api/api/v1/service"
+	"github.com/dezswap/dezswap-api/api/v1/service/dashboard"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type getterMock[T any] struct {
+	mock.Mock
+}
+
+func (m *getterMock[T]) Get(_ context.Context, key string) (*T, error) {
+	args := m.Called(key)
+	item, _ := args.Get(0).(*T)
+	return item, args.Error(1)
+}
+
+func (m *getterMock[T]) GetAll(_ context.Context) ([]T, error) {
+	args := m.Called()
+	items, _ := args.Get(0).([]T)
+	return items, args.Error(1)
+}
+
+type dashboardMock struct {
+	dashboard.Dashboard
+	mock.Mock
+}
+
+func (m *dashboardMock) TokenPrices(_ context.Context, addr dashboard.Addr, itv dashboard.Duration) (dashboard.TokenChart, error) {
+	args := m.Called(addr, itv)
+	chart, _ := args.Get(0).(dashboard.TokenChart)
+	return chart, args.Error(1)
+}
+
+func token(address string, symbol string) service.Token {
+	t := service.Token{Symbol: symbol}
+	t.Address = address
+	return t
+}
+
+func newTestResolver(t *testing.T) (*resolver, *getterMock[service.Pair], *getterMock[service.Token], *dashboardMock) {
+	pairs := &getterMock[service.Pair]{}
+	pair := service.Pair{Address: "pair", Asset0: token("axpla", ""), Asset1: token("xpla1token", ""), Lp: token("xpla1lp", "")}
+	pairs.On("GetAll").Return([]service.Pair{pair}, nil)
+	tokens := &getterMock[service.Token]{}
+	tokens.On("GetAll").Return([]service.Token{token("axpla", "XPLA"), token("xpla1token", "TKN")}, nil)
+	d := &dashboardMock{}
+	t.Cleanup(func() { d.AssertExpectations(t) })
+	return &resolver{pairs: pairs, pools: &getterMock[service.Pool]{}, tokens: tokens, dashboard: d}, pairs, tokens, d
+}
+
+func exec(r *resolver, maxDepth int, maxComplexity int, query string) *graphql.Response {
+	s := parseSchema(r, graphql.MaxDepth(maxDepth))
+	return s.Exec(withRequest(context.Background(), r, maxComplexity), query, "", nil)
+}
+
+func TestQuery_Nested(t *testing.T) {
+	r, pairs, tokens, d := newTestResolver(t)
+	d.On("TokenPrices", dashboard.Addr("axpla"), dashboard.Month).Return(dashboard.TokenChart{{Timestamp: "1700000000", Value: "0.5"}}, nil).Once()
+
+	res := exec(r, 6, 2_000, `{
+		pairs { address asset0 { symbol prices { timestamp value } pairs { asset0 { prices { value } } } } asset1 { symbol } lp { symbol } }
+	}`)
+	require.Empty(t, res.Errors)
+
+	expected := `{"pairs":[{"address":"pair",
+		"asset0":{"symbol":"XPLA","prices":[{"timestamp":"1700000000","value":"0.5"}],"pairs":[{"asset0":{"prices":[{"value":"0.5"}]}}]},
+		"asset1":{"symbol":"TKN"},
+		"lp":{"symbol":""}}]}`
+	assert.JSONEq(t, expected, string(res.Data))
+	// a getter and a time series are looked up once however many times they are resolved
+	pairs.AssertNumberOfCalls(t, "GetAll", 1)
+	tokens.AssertNumberOfCalls(t, "GetAll", 1)
+}
+
+func TestQuery_Limits(t *testing.T) {
+	t.Run("depth", func(t *testing.T) {
+		r, pairs, _, _ := newTestResolver(t)
+		s := parseSchema(r, graphql.MaxDepth(3))
+		res := s.Exec(withRequest(context.Background(), r, 100), `{ pairs { asset0 { pairs { address } } } }`, "", nil)
+		require.Len(t, res.Errors, 1)
+		assert.Nil(t, res.Data)
+		// rejected before the execution
+		pairs.AssertNotCalled(t, "GetAll")
+	})
+
+	t.Run("complexity", func(t *testing.T) {
+		r, _, _, _ := newTestResolver(t)
+		// the aliases of a field count once in the estimate, the execution charges a pair and the token twice
+		query := `{ pairs { a: asset0 { symbol } b: asset0 { symbol } } }`
+		res := exec(r, 6, 3, query)
+		require.Empty(t, res.Errors)
+
+		res = exec(r, 6, 2, query)
+		require.NotEmpty(t, res.Errors)
+		assert.Equal(t, ErrComplexityExceeded.Error(), res.Errors[0].Message)
+	})
+
+	t.Run("complexity estimated", func(t *testing.T) {
+		r, _, _, _ := newTestResolver(t)
+		// two tokens and a series of each, failed before the series are run
+		res := exec(r, 6, 1_000, `{ tokens { prices { value } } }`)
+		require.NotEmpty(t, res.Errors)
+		assert.Equal(t, ErrComplexityExceeded.Error(), res.Errors[0].Message)
+	})
+
+	t.Run("complexity estimated per root field", func(t *testing.T) {
+		r, _, _, _ := newTestResolver(t)
+		// the fragments are flattened by graph-gophers, each aliased root field is estimated at two tokens
+		query := `{ a: tokens { ...symbol } b: tokens { ...symbol } } fragment symbol on Token { symbol }`
+		res := exec(r, 6, 4, query)
+		require.Empty(t, res.Errors)
+
+		res = exec(r, 6, 3, query)
+		require.Len(t, res.Errors, 1)
+		assert.Equal(t, ErrComplexityExceeded.Error(), res.Errors[0].Message)
+	})
+}
+
+func TestEstimate(t *testing.T) {
+	estimateOf := func(r *resolver, limit int, field string, paths ...string) (int, error) {
+		parseSchema(r)
+		e := &estimator{ctx: withRequest(context.Background(), r, limit), root: r.root, limit: limit}
+		return e.rootField(field, paths)
+	}
+
+	tcs := []struct {
+		name     string
+		field    string
+		paths    []string
+		expected int
+	}{
+		{"objects", "pairs", []string{"asset0", "asset0.symbol", "asset1", "asset1.symbol", "lp", "lp.symbol"}, 4},
+		// a series of each token
+		{"series", "tokens", []string{"prices", "prices.value"}, 2 + 2*seriesCost},
+		// the series of a token is run once however many times the token is resolved
+		{"memoized series", "pairs", []string{
+			"asset0", "asset0.prices", "asset0.prices.value",
+			"asset0.pairs", "asset0.pairs.asset0", "asset0.pairs.asset0.prices", "asset0.pairs.asset0.prices.value",
+		}, 4 + 2*seriesCost},
+		{"txs", "txs", []string{"hash"}, seriesCost + txsCount},
+		{"root series", "volumes", []string{"value"}, seriesCost},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			r, _, _, _ := newTestResolver(t)
+			complexity, err := estimateOf(r, 5_000, tc.field, tc.paths...)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, complexity)
+		})
+	}
+
+	t.Run("beyond the limit", func(t *testing.T) {
+		r, _, _, _ := newTestResolver(t)
+		paths := []string{
+			"prices", "prices.value", "volumes", "volumes.value", "tvls", "tvls.value",
+			"pairs", "pairs.txs", "pairs.txs.hash", "pairs.txs.pair", "pairs.txs.pair.txs", "pairs.txs.pair.txs.hash",
+		}
+		complexity, err := estimateOf(r, 2_000, "tokens", paths...)
+		require.NoError(t, err)
+		assert.Greater(t, complexity, 2_000)
+	})
+
+	t.Run("getter error", func(t *testing.T) {
+		r, _, _, _ := newTestResolver(t)
+		pools := &getterMock[service.Pool]{}
+		pools.On("GetAll").Return(nil, assert.AnError)
+		r.pools = pools
+		_, err := estimateOf(r, 5_000, "pools", "address")
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,125 @@
+package gql
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/dezswap/dezswap-api/api/v1/service"
+	"github.com/pkg/errors"
+)
+
+var ErrComplexityExceeded = errors.New("query complexity exceeds the limit")
+
+type requestKey struct{}
+
+// request is the state of a query, the services are looked up once per query however many times the objects are nested
+type request struct {
+	pairs  *loader[service.Pair]
+	pools  *loader[service.Pool]
+	tokens *loader[service.Token]
+	series memo
+	budget budget
+	// estimated is charged by the estimates of the root fields, apart from the budget of the execution
+	estimated budget
+}
+
+func withRequest(ctx context.Context, r *resolver, maxComplexity int) context.Context {
+	return context.WithValue(ctx, requestKey{}, &request{
+		pairs:     newLoader(r.pairs, func(p service.Pair) string { return p.Address }),
+		pools:     newLoader(r.pools, func(p service.Pool) string { return p.Address }),
+		tokens:    newLoader(r.tokens, func(t service.Token) string { return t.Address }),
+		series:    memo{entries: map[string]*memoEntry{}},
+		budget:    budget{limit: int64(maxComplexity)},
+		estimated: budget{limit: int64(maxComplexity)},
+	})
+}
+
+func requestOf(ctx context.Context) *request {
+	return ctx.Value(requestKey{}).(*request)
+}
+
+// loader batches the lookups of a query into one GetAll of the getter
+type loader[T any] struct {
+	getter service.Getter[T]
+	key    func(T) string
+
+	once  sync.Once
+	all   []T
+	items map[string]*T
+	err   error
+}
+
+func newLoader[T any](getter service.Getter[T], key func(T) string) *loader[T] {
+	return &loader[T]{getter: getter, key: key}
+}
+
+func (l *loader[T]) load(ctx context.Context) error {
+	l.once.Do(func() {
+		if l.all, l.err = l.getter.GetAll(ctx); l.err != nil {
+			return
+		}
+		l.items = make(map[string]*T, len(l.all))
+		for i := range l.all {
+			l.items[l.key(l.all[i])] = &l.all[i]
+		}
+	})
+	return l.err
+}
+
+// Get returns nil when the key is unknown
+func (l *loader[T]) Get(ctx context.Context, key string) (*T, error) {
+	if err := l.load(ctx); err != nil {
+		return nil, err
+	}
+	return l.items[key], nil
+}
+
+func (l *loader[T]) GetAll(ctx context.Context) ([]T, error) {
+	if err := l.load(ctx); err != nil {
+		return nil, err
+	}
+	return l.all, nil
+}
+
+// memo runs each of the time series queries once per query
+type memo struct {
+	mu      sync.Mutex
+	entries map[string]*memoEntry
+}
+
+type memoEntry struct {
+	once  sync.Once
+	value any
+	err   error
+}
+
+func memoize[T any](m *memo, key string, f func() (T, error)) (T, error) {
+	m.mu.Lock()
+	entry, ok := m.entries[key]
+	if !ok {
+		entry = &memoEntry{}
+		m.entries[key] = entry
+	}
+	m.mu.Unlock()
+
+	entry.once.Do(func() { entry.value, entry.err = f() })
+	if entry.err != nil {
+		var zero T
+		return zero, entry.err
+	}
+	return entry.value.(T), nil
+}
+
+// budget is the complexity left to a query, charged by the objects resolved and the time series queried
+type budget struct {
+	limit int64
+	used  atomic.Int64
+}
+
+func (b *budget) charge(cost int) error {
+	if b.used.Add(int64(cost)) > b.limit {
+		return ErrComplexityExceeded
+	}
+	return nil
+}
@@ -0,0 +1,433 @@
+package gql
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dezswap/dezswap-api/api/v1/service"
+	"github.com/dezswap/dezswap-api/api/v1/service/dashboard"
+	"github.com/graph-gophers/graphql-go/ast"
+)
+
+// seriesCost is charged for a time series or the txs, which are aggregations over the stats unlike a lookup,
+// a tenth of the default complexity limit
+const seriesCost = 500
+
+type resolver struct {
+	pairs     service.Getter[service.Pair]
+	pools     service.Getter[service.Pool]
+	tokens    service.Getter[service.Token]
+	dashboard dashboard.Dashboard
+	// root is the query type of the schema, set by parseSchema
+	root *ast.ObjectTypeDefinition
+}
+
+type durationArgs struct {
+	Duration string
+}
+
+type txTypeArgs struct {
+	Type string
+}
+
+type addressArgs struct {
+	Address string
+}
+
+func (r *resolver) Pairs(ctx context.Context) ([]*pairResolver, error) {
+	if err := estimate(ctx, r.root, "pairs"); err != nil {
+		return nil, err
+	}
+	pairs, err := requestOf(ctx).pairs.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.toPairResolvers(ctx, pairs)
+}
+
+func (r *resolver) Pair(ctx context.Context, args addressArgs) (*pairResolver, error) {
+	if err := estimate(ctx, r.root, "pair"); err != nil {
+		return nil, err
+	}
+	pair, err := requestOf(ctx).pairs.Get(ctx, args.Address)
+	if err != nil || pair == nil {
+		return nil, err
+	}
+	return r.toPairResolver(ctx, *pair)
+}
+
+func (r *resolver) Pools(ctx context.Context) ([]*poolResolver, error) {
+	if err := estimate(ctx, r.root, "pools"); err != nil {
+		return nil, err
+	}
+	pools, err := requestOf(ctx).pools.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := requestOf(ctx).budget.charge(len(pools)); err != nil {
+		return nil, err
+	}
+	resolvers := make([]*poolResolver, len(pools))
+	for i := range pools {
+		resolvers[i] = &poolResolver{r, pools[i]}
+	}
+	return resolvers, nil
+}
+
+func (r *resolver) Pool(ctx context.Context, args addressArgs) (*poolResolver, error) {
+	if err := estimate(ctx, r.root, "pool"); err != nil {
+		return nil, err
+	}
+	return r.poolOf(ctx, args.Address)
+}
+
+func (r *resolver) Tokens(ctx context.Context) ([]*tokenResolver, error) {
+	if err := estimate(ctx, r.root, "tokens"); err != nil {
+		return nil, err
+	}
+	tokens, err := requestOf(ctx).tokens.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := requestOf(ctx).budget.charge(len(tokens)); err != nil {
+		return nil, err
+	}
+	resolvers := make([]*tokenResolver, len(tokens))
+	for i := range tokens {
+		resolvers[i] = &tokenResolver{r, tokens[i]}
+	}
+	return resolvers, nil
+}
+
+func (r *resolver) Token(ctx context.Context, args addressArgs) (*tokenResolver, error) {
+	if err := estimate(ctx, r.root, "token"); err != nil {
+		return nil, err
+	}
+	return r.tokenOf(ctx, args.Address)
+}
+
+func (r *resolver) Txs(ctx context.Context, args struct {
+	Type    string
+	Address *string
+}) ([]*txResolver, error) {
+	if err := estimate(ctx, r.root, "txs"); err != nil {
+		return nil, err
+	}
+	addrs := []dashboard.Addr{}
+	if args.Address != nil {
+		addrs = append(addrs, dashboard.Addr(*args.Address))
+	}
+	return r.txs(ctx, "txs", args.Type, addrs, r.dashboard.Txs)
+}
+
+func (r *resolver) Volumes(ctx context.Context, args durationArgs) ([]*timeValueResolver, error) {
+	if err := estimate(ctx, r.root, "volumes"); err != nil {
+		return nil, err
+	}
+	return series(ctx, "volumes/"+args.Duration, func() ([]*timeValueResolver, error) {
+		volumes, err := r.dashboard.Volumes(ctx, toDuration(args.Duration))
+		if err != nil {
+			return nil, err
+		}
+		values := make([]*timeValueResolver, len(volumes))
+		for i, v := range volumes {
+			values[i] = &timeValueResolver{v.Timestamp.UTC().Format(time.RFC3339), v.Volume}
+		}
+		return values, nil
+	})
+}
+
+func (r *resolver) Tvls(ctx context.Context, args durationArgs) ([]*timeValueResolver, error) {
+	if err := estimate(ctx, r.root, "tvls"); err != nil {
+		return nil, err
+	}
+	return series(ctx, "tvls/"+args.Duration, func() ([]*timeValueResolver, error) {
+		tvls, err := r.dashboard.Tvls(ctx, toDuration(args.Duration))
+		if err != nil {
+			return nil, err
+		}
+		return tvlsToResolvers(tvls), nil
+	})
+}
+
+func (r *resolver) toPairResolvers(ctx context.Context, pairs []service.Pair) ([]*pairResolver, error) {
+	if err := requestOf(ctx).budget.charge(len(pairs)); err != nil {
+		return nil, err
+	}
+	resolvers := make([]*pairResolver, len(pairs))
+	for i := range pairs {
+		resolvers[i] = &pairResolver{r, pairs[i]}
+	}
+	return resolvers, nil
+}
+
+func (r *resolver) toPairResolver(ctx context.Context, pair service.Pair) (*pairResolver, error) {
+	if err := requestOf(ctx).budget.charge(1); err != nil {
+		return nil, err
+	}
+	return &pairResolver{r, pair}, nil
+}
+
+func (r *resolver) pairOf(ctx context.Context, address string) (*pairResolver, error) {
+	pair, err := requestOf(ctx).pairs.Get(ctx, address)
+	if err != nil || pair == nil {
+		return nil, err
+	}
+	return r.toPairResolver(ctx, *pair)
+}
+
+func (r *resolver) poolOf(ctx context.Context, address string) (*poolResolver, error) {
+	pool, err := requestOf(ctx).pools.Get(ctx, address)
+	if err != nil || pool == nil {
+		return nil, err
+	}
+	if err := requestOf(ctx).budget.charge(1); err != nil {
+		return nil, err
+	}
+	return &poolResolver{r, *pool}, nil
+}
+
+func (r *resolver) tokenOf(ctx context.Context, address string) (*tokenResolver, error) {
+	token, err := requestOf(ctx).tokens.Get(ctx, address)
+	if err != nil || token == nil {
+		return nil, err
+	}
+	if err := requestOf(ctx).budget.charge(1); err != nil {
+		return nil, err
+	}
+	return &tokenResolver{r, *token}, nil
+}
+
+// tokenOrUnknown resolves a token which is not indexed yet by the address only
+func (r *resolver) tokenOrUnknown(ctx context.Context, address string) (*tokenResolver, error) {
+	token, err := r.tokenOf(ctx, address)
+	if err != nil || token != nil {
+		return token, err
+	}
+	unknown := service.Token{}
+	unknown.Address = address
+	return &tokenResolver{r, unknown}, nil
+}
+
+func (r *resolver) txs(ctx context.Context, key string, txType string, addrs []dashboard.Addr, query func(context.Context, dashboard.TxType, ...dashboard.Addr) (dashboard.Txs, error)) ([]*txResolver, error) {
+	keys := make([]string, len(addrs))
+	for i, addr := range addrs {
+		keys[i] = string(addr)
+	}
+	txs, err := memoize(&requestOf(ctx).series, key+"/"+txType+"/"+strings.Join(keys, ","), func() (dashboard.Txs, error) {
+		if err := requestOf(ctx).budget.charge(seriesCost); err != nil {
+			return nil, err
+		}
+		return query(ctx, toTxType(txType), addrs...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := requestOf(ctx).budget.charge(len(txs)); err != nil {
+		return nil, err
+	}
+	resolvers := make([]*txResolver, len(txs))
+	for i := range txs {
+		resolvers[i] = &txResolver{r, txs[i]}
+	}
+	return resolvers, nil
+}
+
+type pairResolver struct {
+	*resolver
+	pair service.Pair
+}
+
+func (p *pairResolver) Address() string { return p.pair.Address }
+
+func (p *pairResolver) Asset0(ctx context.Context) (*tokenResolver, error) {
+	return p.tokenOrUnknown(ctx, p.pair.Asset0.Address)
+}
+
+func (p *pairResolver) Asset1(ctx context.Context) (*tokenResolver, error) {
+	return p.tokenOrUnknown(ctx, p.pair.Asset1.Address)
+}
+
+func (p *pairResolver) Lp(ctx context.Context) (*tokenResolver, error) {
+	return p.tokenOrUnknown(ctx, p.pair.Lp.Address)
+}
+
+func (p *pairResolver) Pool(ctx context.Context) (*poolResolver, error) {
+	return p.poolOf(ctx, p.pair.Address)
+}
+
+func (p *pairResolver) Txs(ctx context.Context, args txTypeArgs) ([]*txResolver, error) {
+	return p.txs(ctx, "txs", args.Type, []dashboard.Addr{dashboard.Addr(p.pair.Address)}, p.dashboard.Txs)
+}
+
+func (p *pairResolver) Volumes(ctx context.Context, args durationArgs) ([]*timeValueResolver, error) {
+	return series(ctx, "volumesOf/"+p.pair.Address+"/"+args.Duration, func() ([]*timeValueResolver, error) {
+		volumes, err := p.dashboard.VolumesOf(ctx, dashboard.Addr(p.pair.Address), toDuration(args.Duration))
+		if err != nil {
+			return nil, err
+		}
+		values := make([]*timeValueResolver, len(volumes))
+		for i, v := range volumes {
+			values[i] = &timeValueResolver{v.Timestamp.UTC().Format(time.RFC3339), v.Volume}
+		}
+		return values, nil
+	})
+}
+
+func (p *pairResolver) Tvls(ctx context.Context, args durationArgs) ([]*timeValueResolver, error) {
+	return series(ctx, "tvlsOf/"+p.pair.Address+"/"+args.Duration, func() ([]*timeValueResolver, error) {
+		tvls, err := p.dashboard.TvlsOf(ctx, dashboard.Addr(p.pair.Address), toDuration(args.Duration))
+		if err != nil {
+			return nil, err
+		}
+		return tvlsToResolvers(tvls), nil
+	})
+}
+
+type poolResolver struct {
+	*resolver
+	pool service.Pool
+}
+
+func (p *poolResolver) Address() string      { return p.pool.Address }
+func (p *poolResolver) Height() int32        { return int32(p.pool.Height) }
+func (p *poolResolver) Asset0Amount() string { return p.pool.Asset0Amount }
+func (p *poolResolver) Asset1Amount() string { return p.pool.Asset1Amount }
+func (p *poolResolver) LpAmount() string     { return p.pool.LpAmount }
+
+func (p *poolResolver) Asset0(ctx context.Context) (*tokenResolver, error) {
+	return p.tokenOrUnknown(ctx, p.pool.Asset0)
+}
+
+func (p *poolResolver) Asset1(ctx context.Context) (*tokenResolver, error) {
+	return p.tokenOrUnknown(ctx, p.pool.Asset1)
+}
+
+func (p *poolResolver) Lp(ctx context.Context) (*tokenResolver, error) {
+	return p.tokenOrUnknown(ctx, p.pool.Lp)
+}
+
+func (p *poolResolver) Pair(ctx context.Context) (*pairResolver, error) {
+	return p.pairOf(ctx, p.pool.Address)
+}
+
+type tokenResolver struct {
+	*resolver
+	token service.Token
+}
+
+func (t *tokenResolver) Address() string { return t.token.Address }
+func (t *tokenResolver) Name() string    { return t.token.Name }
+func (t *tokenResolver) Symbol() string  { return t.token.Symbol }
+func (t *tokenResolver) Decimals() int32 { return int32(t.token.Decimals) }
+func (t *tokenResolver) Icon() string    { return t.token.Icon }
+func (t *tokenResolver) Verified() bool  { return t.token.Verified }
+
+func (t *tokenResolver) Pairs(ctx context.Context) ([]*pairResolver, error) {
+	all, err := requestOf(ctx).pairs.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pairs := []service.Pair{}
+	for _, pair := range all {
+		if pair.Asset0.Address == t.token.Address || pair.Asset1.Address == t.token.Address {
+			pairs = append(pairs, pair)
+		}
+	}
+	return t.toPairResolvers(ctx, pairs)
+}
+
+func (t *tokenResolver) Txs(ctx context.Context, args txTypeArgs) ([]*txResolver, error) {
+	return t.txs(ctx, "txsOfToken", args.Type, []dashboard.Addr{dashboard.Addr(t.token.Address)}, t.dashboard.TxsOfToken)
+}
+
+func (t *tokenResolver) Prices(ctx context.Context, args durationArgs) ([]*timeValueResolver, error) {
+	return t.chart(ctx, "tokenPrices", args.Duration, t.dashboard.TokenPrices)
+}
+
+func (t *tokenResolver) Volumes(ctx context.Context, args durationArgs) ([]*timeValueResolver, error) {
+	return t.chart(ctx, "tokenVolumes", args.Duration, t.dashboard.TokenVolumes)
+}
+
+func (t *tokenResolver) Tvls(ctx context.Context, args durationArgs) ([]*timeValueResolver, error) {
+	return t.chart(ctx, "tokenTvls", args.Duration, t.dashboard.TokenTvls)
+}
+
+func (t *tokenResolver) chart(ctx context.Context, key string, duration string, query func(context.Context, dashboard.Addr, dashboard.Duration) (dashboard.TokenChart, error)) ([]*timeValueResolver, error) {
+	return series(ctx, key+"/"+t.token.Address+"/"+duration, func() ([]*timeValueResolver, error) {
+		chart, err := query(ctx, dashboard.Addr(t.token.Address), toDuration(duration))
+		if err != nil {
+			return nil, err
+		}
+		values := make([]*timeValueResolver, len(chart))
+		for i, v := range chart {
+			values[i] = &timeValueResolver{v.Timestamp, v.Value}
+		}
+		return values, nil
+	})
+}
+
+type txResolver struct {
+	*resolver
+	tx dashboard.Tx
+}
+
+func (t *txResolver) Action() string       { return t.tx.Action }
+func (t *txResolver) Hash() string         { return t.tx.Hash }
+func (t *txResolver) Sender() string       { return t.tx.Sender }
+func (t *txResolver) Asset0Amount() string { return t.tx.Asset0Amount }
+func (t *txResolver) Asset1Amount() string { return t.tx.Asset1Amount }
+func (t *txResolver) TotalValue() string   { return t.tx.TotalValue }
+func (t *txResolver) Timestamp() string    { return t.tx.Timestamp.UTC().Format(time.RFC3339) }
+
+func (t *txResolver) Pair(ctx context.Context) (*pairResolver, error) {
+	return t.pairOf(ctx, t.tx.Address)
+}
+
+func (t *txResolver) Asset0(ctx context.Context) (*tokenResolver, error) {
+	return t.tokenOf(ctx, t.tx.Asset0)
+}
+
+func (t *txResolver) Asset1(ctx context.Context) (*tokenResolver, error) {
+	return t.tokenOf(ctx, t.tx.Asset1)
+}
+
+type timeValueResolver struct {
+	timestamp string
+	value     string
+}
+
+func (v *timeValueResolver) Timestamp() string { return v.timestamp }
+func (v *timeValueResolver) Value() string     { return v.value }
+
+// series runs the query once per query and key, charging it on the first run
+func series(ctx context.Context, key string, query func() ([]*timeValueResolver, error)) ([]*timeValueResolver, error) {
+	return memoize(&requestOf(ctx).series, key, func() ([]*timeValueResolver, error) {
+		if err := requestOf(ctx).budget.charge(seriesCost); err != nil {
+			return nil, err
+		}
+		return query()
+	})
+}
+
+func tvlsToResolvers(tvls dashboard.Tvls) []*timeValueResolver {
+	values := make([]*timeValueResolver, len(tvls))
+	for i, v := range tvls {
+		values[i] = &timeValueResolver{v.Timestamp.UTC().Format(time.RFC3339), v.Tvl}
+	}
+	return values
+}
+
+// toDuration maps the enum of the schema, MONTH to month
+func toDuration(value string) dashboard.Duration {
+	return dashboard.Duration(strings.ToLower(value))
+}
+
+// toTxType maps the enum of the schema, ALL to every type
+func toTxType(value string) dashboard.TxType {
+	if value == "ALL" {
+		return dashboard.TX_TYPE_ALL
+	}
+	return dashboard.TxType(strings.ToLower(value))
+}
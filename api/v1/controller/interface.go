@@ -5,6 +5,7 @@ import "github.com/gin-gonic/gin"
 type StatusController interface {
 	Version(ctx *gin.Context)
 	Health(ctx *gin.Context)
+	Ready(ctx *gin.Context)
 }
 
 type PairController interface {
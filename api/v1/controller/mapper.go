@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"strings"
+
 	"github.com/dezswap/dezswap-api/api/v1/service"
 	"github.com/dezswap/dezswap-api/pkg"
 	"github.com/dezswap/dezswap-api/pkg/dezswap"
@@ -70,9 +72,27 @@ func (m *tokenMapper) tokenToRes(token service.Token) TokenRes {
 		Protocol: token.Protocol,
 		Verified: token.Verified,
 	}
+	if token.IbcPath != "" || token.IbcBaseDenom != "" {
+		res.Ibc = &IbcOriginRes{
+			Path:        token.IbcPath,
+			Channel:     ibcChannel(token.IbcPath),
+			BaseDenom:   token.IbcBaseDenom,
+			OriginChain: token.IbcOriginChain,
+		}
+	}
 	return res
 }
 
+// ibcChannel returns the channel of the first hop which is the one opened on this chain,
+// e.g. "transfer/channel-1/transfer/channel-7" => "channel-1"
+func ibcChannel(path string) string {
+	hops := strings.Split(path, "/")
+	if len(hops) < 2 {
+		return ""
+	}
+	return hops[1]
+}
+
 func (m *tokenMapper) tokensToRes(tokens []service.Token) []TokenRes {
 	res := make([]TokenRes, len(tokens))
 	for i, token := range tokens {
@@ -67,9 +67,9 @@ func (c *noticeController) Notices(ctx *gin.Context) {
 		}
 	}
 
-	notices, err := c.s.Notices(reqParams.Chain, startTsParsed, reqParams.ToCondition())
+	notices, err := c.s.Notices(ctx.Request.Context(), reqParams.Chain, startTsParsed, reqParams.ToCondition())
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -39,9 +39,9 @@ func (c *pairController) register(route *gin.RouterGroup) {
 //	@Failure		500	{object}	httputil.InternalServerError
 //	@Router			/pairs [get]
 func (c *pairController) Pairs(ctx *gin.Context) {
-	pairs, err := c.GetAll()
+	pairs, err := c.GetAll(ctx.Request.Context())
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -68,9 +68,9 @@ func (c *pairController) Pair(ctx *gin.Context) {
 		return
 	}
 
-	pair, err := c.Get(address)
+	pair, err := c.Get(ctx.Request.Context(), address)
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
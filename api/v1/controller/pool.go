@@ -39,9 +39,9 @@ func (c *poolController) register(route *gin.RouterGroup) {
 //	@Failure		500	{object}	httputil.InternalServerError
 //	@Router			/pools [get]
 func (c *poolController) Pools(ctx *gin.Context) {
-	pools, err := c.GetAll()
+	pools, err := c.GetAll(ctx.Request.Context())
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -63,9 +63,9 @@ func (c *poolController) Pools(ctx *gin.Context) {
 //	@Router			/pools/{address} [get]
 func (c *poolController) Pool(ctx *gin.Context) {
 	address := ctx.Param("address")
-	pool, err := c.Get(address)
+	pool, err := c.Get(ctx.Request.Context(), address)
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -3,8 +3,28 @@ package router
 type RoutesRes []RouteRes
 
 type RouteRes struct {
-	From     string   `json:"from"`
-	To       string   `json:"to"`
-	HopCount int      `json:"hopCount"`
-	Route    []string `json:"route"`
+	From     string    `json:"from"`
+	To       string    `json:"to"`
+	HopCount int       `json:"hopCount"`
+	Route    []string  `json:"route"`
+	Quote    *QuoteRes `json:"quote,omitempty"`
+}
+
+type QuoteRes struct {
+	OfferAmount  string     `json:"offerAmount"`
+	ReturnAmount string     `json:"returnAmount"`
+	PriceImpact  string     `json:"priceImpact"`
+	FeeRate      string     `json:"feeRate"`
+	Hops         []QuoteHop `json:"hops"`
+}
+
+type QuoteHop struct {
+	Pair             string `json:"pair"`
+	OfferAsset       string `json:"offerAsset"`
+	OfferAmount      string `json:"offerAmount"`
+	AskAsset         string `json:"askAsset"`
+	ReturnAmount     string `json:"returnAmount"`
+	SpreadAmount     string `json:"spreadAmount"`
+	CommissionAmount string `json:"commissionAmount"`
+	Height           uint64 `json:"height"`
 }
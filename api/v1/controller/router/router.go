@@ -1,10 +1,12 @@
 package router
 
 import (
+	"cosmossdk.io/math"
 	rs "github.com/dezswap/dezswap-api/api/v1/service/router"
 	"net/http"
 	"strconv"
 
+	ss "github.com/dezswap/dezswap-api/api/v1/service/simulate"
 	"github.com/dezswap/dezswap-api/pkg/httputil"
 	"github.com/dezswap/dezswap-api/pkg/logging"
 	"github.com/gin-gonic/gin"
@@ -13,14 +15,15 @@ import (
 
 type routerController struct {
 	rs.Router
+	quoter rs.Quoter
 	*mapper
 	logger logging.Logger
 }
 
 type mapper struct{}
 
-func InitRouterController(s rs.Router, route *gin.RouterGroup, logger logging.Logger) *routerController {
-	c := routerController{s, &mapper{}, logger}
+func InitRouterController(s rs.Router, quoter rs.Quoter, route *gin.RouterGroup, logger logging.Logger) *routerController {
+	c := routerController{s, quoter, &mapper{}, logger}
 	c.register(route)
 	return &c
 }
@@ -33,7 +36,7 @@ func (c *routerController) register(route *gin.RouterGroup) {
 //
 //	@Tags			router
 //	@Summary		All Routes
-//	@Description	get routes based on the given token address
+//	@Description	get routes based on the given token address, routes are quoted and sorted by the return amount when the amount is given with both from and to, or by the required offer amount when the askAmount is given instead
 //	@Accept			json
 //	@Produce		json
 //	@Success		200	{object}	RoutesRes
@@ -44,6 +47,8 @@ func (c *routerController) register(route *gin.RouterGroup) {
 // @Param			from		query		string	false	"Offer token address"
 // @Param			to			query		string	false	"Ask token Address"
 // @Param 			hopCount	query		int		false	"Number of hops between the starting token and the ending token"
+// @Param			amount		query		string	false	"Offer amount in the smallest unit to quote the routes"
+// @Param			askAmount	query		string	false	"Ask amount in the smallest unit to quote the required offer amount of the routes"
 func (c *routerController) Routes(ctx *gin.Context) {
 	from := ctx.Query("from")
 	to := ctx.Query("to")
@@ -62,11 +67,57 @@ func (c *routerController) Routes(ctx *gin.Context) {
 		}
 	}
 
+	if amountStr := ctx.Query("amount"); amountStr != "" {
+		amount, ok := math.NewIntFromString(amountStr)
+		if !ok || !amount.IsPositive() {
+			httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid amount"))
+			return
+		}
+		if from == "" || to == "" {
+			httputil.NewError(ctx, http.StatusBadRequest, errors.New("required from and to with amount"))
+			return
+		}
+
+		quotes, err := c.quoter.Quotes(ctx.Request.Context(), from, to, hopCount, amount)
+		if err != nil {
+			logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+			return
+		}
+		ctx.JSON(http.StatusOK, c.quotesToRes(quotes, from, to))
+		return
+	}
+
+	if askAmountStr := ctx.Query("askAmount"); askAmountStr != "" {
+		askAmount, ok := math.NewIntFromString(askAmountStr)
+		if !ok || !askAmount.IsPositive() {
+			httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid ask amount"))
+			return
+		}
+		if from == "" || to == "" {
+			httputil.NewError(ctx, http.StatusBadRequest, errors.New("required from and to with ask amount"))
+			return
+		}
+
+		quotes, err := c.quoter.ReverseQuotes(ctx.Request.Context(), from, to, hopCount, askAmount)
+		if err != nil {
+			if errors.Is(err, ss.ErrInsufficientReserves) {
+				httputil.NewError(ctx, http.StatusBadRequest, err)
+				return
+			}
+			logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+			httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+			return
+		}
+		ctx.JSON(http.StatusOK, c.quotesToRes(quotes, from, to))
+		return
+	}
+
 	// full path
 	if from != "" && to != "" {
 		routes, err := c.Router.Routes(from, to, hopCount)
 		if err != nil {
-			c.logger.Warn(err)
+			logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 			httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 			return
 		}
@@ -84,7 +135,7 @@ func (c *routerController) Routes(ctx *gin.Context) {
 
 	routes, err := c.RoutesOfToken(addr, hopCount, reverse)
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -108,3 +159,36 @@ func (m *mapper) routesToRes(routes []rs.Route, addr string, reverse bool) []Rou
 	}
 	return res
 }
+
+func (m *mapper) quotesToRes(quotes []rs.Quote, from, to string) RoutesRes {
+	res := make(RoutesRes, len(quotes))
+	for i, q := range quotes {
+		hops := make([]QuoteHop, len(q.Hops))
+		for j, h := range q.Hops {
+			hops[j] = QuoteHop{
+				Pair:             h.Pair,
+				OfferAsset:       h.OfferAsset,
+				OfferAmount:      h.OfferAmount.String(),
+				AskAsset:         h.AskAsset,
+				ReturnAmount:     h.ReturnAmount.String(),
+				SpreadAmount:     h.SpreadAmount.String(),
+				CommissionAmount: h.CommissionAmount.String(),
+				Height:           h.Height,
+			}
+		}
+		res[i] = RouteRes{
+			From:     from,
+			To:       to,
+			HopCount: q.HopCount,
+			Route:    q.Route,
+			Quote: &QuoteRes{
+				OfferAmount:  q.OfferAmount.String(),
+				ReturnAmount: q.ReturnAmount.String(),
+				PriceImpact:  q.PriceImpact.String(),
+				FeeRate:      q.FeeRate.String(),
+				Hops:         hops,
+			},
+		}
+	}
+	return res
+}
@@ -0,0 +1,37 @@
+package simulate
+
+type SwapSimulationRes struct {
+	Pair             string `json:"pair"`
+	OfferAsset       string `json:"offerAsset"`
+	OfferAmount      string `json:"offerAmount"`
+	AskAsset         string `json:"askAsset"`
+	ReturnAmount     string `json:"returnAmount"`
+	SpreadAmount     string `json:"spreadAmount"`
+	CommissionAmount string `json:"commissionAmount"`
+	Height           uint64 `json:"height"`
+}
+
+type ProvideSimulationRes struct {
+	Pair          string `json:"pair"`
+	Lp            string `json:"lp"`
+	LpAmount      string `json:"lpAmount"`
+	Share         string `json:"share"`
+	Asset0        string `json:"asset0"`
+	Asset0Deposit string `json:"asset0Deposit"`
+	Asset0Refund  string `json:"asset0Refund"`
+	Asset1        string `json:"asset1"`
+	Asset1Deposit string `json:"asset1Deposit"`
+	Asset1Refund  string `json:"asset1Refund"`
+	Height        uint64 `json:"height"`
+}
+
+type WithdrawSimulationRes struct {
+	Pair         string `json:"pair"`
+	Lp           string `json:"lp"`
+	LpAmount     string `json:"lpAmount"`
+	Asset0       string `json:"asset0"`
+	Asset0Amount string `json:"asset0Amount"`
+	Asset1       string `json:"asset1"`
+	Asset1Amount string `json:"asset1Amount"`
+	Height       uint64 `json:"height"`
+}
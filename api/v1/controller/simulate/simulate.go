@@ -0,0 +1,342 @@
+package simulate
+
+import (
+	"net/http"
+	"strconv"
+
+	"cosmossdk.io/math"
+	ss "github.com/dezswap/dezswap-api/api/v1/service/simulate"
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+type simulateController struct {
+	ss.Simulator
+	nodeSimulator ss.NodeSimulator
+	*mapper
+	logger logging.Logger
+}
+
+type mapper struct{}
+
+// InitSimulateController registers the on-chain simulations only when nodeSimulator is given
+func InitSimulateController(s ss.Simulator, nodeSimulator ss.NodeSimulator, route *gin.RouterGroup, logger logging.Logger) *simulateController {
+	c := simulateController{s, nodeSimulator, &mapper{}, logger}
+	c.register(route)
+	return &c
+}
+
+func (c *simulateController) register(route *gin.RouterGroup) {
+	route.GET("/swap", c.Swap)
+	route.GET("/reverse-swap", c.ReverseSwap)
+	route.GET("/provide", c.Provide)
+	route.GET("/withdraw", c.Withdraw)
+	if c.nodeSimulator != nil {
+		route.GET("/onchain/swap", c.OnchainSwap)
+		route.GET("/onchain/reverse-swap", c.OnchainReverseSwap)
+	}
+}
+
+//	Swap godoc
+//
+//	@Tags			simulate
+//	@Summary		Simulate a swap
+//	@Description	simulate a swap on the latest indexed reserves of the pair without querying the node
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	SwapSimulationRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/simulate/swap [get]
+//
+// @Param			offer	query		string	true	"Offer token address"
+// @Param			ask		query		string	true	"Ask token address"
+// @Param			amount	query		string	true	"Offer amount in the smallest unit"
+func (c *simulateController) Swap(ctx *gin.Context) {
+	offer, ask, amount, ok := swapParams(ctx)
+	if !ok {
+		return
+	}
+
+	simulation, err := c.Simulator.Swap(ctx.Request.Context(), offer, ask, amount)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.swapSimulationToRes(simulation))
+}
+
+//	ReverseSwap godoc
+//
+//	@Tags			simulate
+//	@Summary		Simulate a reverse swap
+//	@Description	simulate the offer amount including commission required to receive the ask amount on the latest indexed reserves of the pair
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	SwapSimulationRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/simulate/reverse-swap [get]
+//
+// @Param			offer	query		string	true	"Offer token address"
+// @Param			ask		query		string	true	"Ask token address"
+// @Param			amount	query		string	true	"Ask amount in the smallest unit"
+func (c *simulateController) ReverseSwap(ctx *gin.Context) {
+	offer, ask, amount, ok := swapParams(ctx)
+	if !ok {
+		return
+	}
+
+	simulation, err := c.Simulator.ReverseSwap(ctx.Request.Context(), offer, ask, amount)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.swapSimulationToRes(simulation))
+}
+
+//	Provide godoc
+//
+//	@Tags			simulate
+//	@Summary		Simulate a liquidity provision
+//	@Description	simulate the lp tokens minted, the share of the pool and the refund of the excess deposit on the latest indexed reserves of the pair
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	ProvideSimulationRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/simulate/provide [get]
+//
+// @Param			pair	query		string	true	"Pair address"
+// @Param			amount0	query		string	true	"Deposit amount of the asset0 of the pair in the smallest unit"
+// @Param			amount1	query		string	true	"Deposit amount of the asset1 of the pair in the smallest unit"
+func (c *simulateController) Provide(ctx *gin.Context) {
+	pair := ctx.Query("pair")
+	if pair == "" {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid pair"))
+		return
+	}
+
+	amount0, ok0 := math.NewIntFromString(ctx.Query("amount0"))
+	amount1, ok1 := math.NewIntFromString(ctx.Query("amount1"))
+	if !ok0 || !ok1 || !amount0.IsPositive() || !amount1.IsPositive() {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid amount"))
+		return
+	}
+
+	simulation, err := c.Simulator.Provide(ctx.Request.Context(), pair, amount0, amount1)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.provideSimulationToRes(simulation))
+}
+
+//	Withdraw godoc
+//
+//	@Tags			simulate
+//	@Summary		Simulate a liquidity withdrawal
+//	@Description	simulate the assets received by burning the lp tokens on the latest indexed reserves of the pair
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	WithdrawSimulationRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/simulate/withdraw [get]
+//
+// @Param			pair	query		string	true	"Pair address"
+// @Param			amount	query		string	true	"Lp amount in the smallest unit"
+func (c *simulateController) Withdraw(ctx *gin.Context) {
+	pair := ctx.Query("pair")
+	if pair == "" {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid pair"))
+		return
+	}
+
+	amount, ok := math.NewIntFromString(ctx.Query("amount"))
+	if !ok || !amount.IsPositive() {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid amount"))
+		return
+	}
+
+	simulation, err := c.Simulator.Withdraw(ctx.Request.Context(), pair, amount)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.withdrawSimulationToRes(simulation))
+}
+
+//	OnchainSwap godoc
+//
+//	@Tags			simulate
+//	@Summary		Simulate a swap on-chain
+//	@Description	query the simulation to the pair contract through the node, the result is cached per block
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	SwapSimulationRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Failure		502	{object}	httputil.BadGatewayError
+//	@Router			/simulate/onchain/swap [get]
+//
+// @Param			offer	query		string	true	"Offer token address"
+// @Param			ask		query		string	true	"Ask token address"
+// @Param			amount	query		string	true	"Offer amount in the smallest unit"
+// @Param			height	query		int		false	"Block height to query, the latest height of the node by default"
+func (c *simulateController) OnchainSwap(ctx *gin.Context) {
+	offer, ask, amount, ok := swapParams(ctx)
+	if !ok {
+		return
+	}
+	height, ok := heightParam(ctx)
+	if !ok {
+		return
+	}
+
+	simulation, err := c.nodeSimulator.Swap(ctx.Request.Context(), offer, ask, amount, height)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.swapSimulationToRes(simulation))
+}
+
+//	OnchainReverseSwap godoc
+//
+//	@Tags			simulate
+//	@Summary		Simulate a reverse swap on-chain
+//	@Description	query the reverse simulation to the pair contract through the node, the result is cached per block
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	SwapSimulationRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Failure		502	{object}	httputil.BadGatewayError
+//	@Router			/simulate/onchain/reverse-swap [get]
+//
+// @Param			offer	query		string	true	"Offer token address"
+// @Param			ask		query		string	true	"Ask token address"
+// @Param			amount	query		string	true	"Ask amount in the smallest unit"
+// @Param			height	query		int		false	"Block height to query, the latest height of the node by default"
+func (c *simulateController) OnchainReverseSwap(ctx *gin.Context) {
+	offer, ask, amount, ok := swapParams(ctx)
+	if !ok {
+		return
+	}
+	height, ok := heightParam(ctx)
+	if !ok {
+		return
+	}
+
+	simulation, err := c.nodeSimulator.ReverseSwap(ctx.Request.Context(), offer, ask, amount, height)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.swapSimulationToRes(simulation))
+}
+
+// swapParams parses the offer, ask and amount query, it responds 400 and returns false when they are invalid
+func swapParams(ctx *gin.Context) (string, string, math.Int, bool) {
+	offer := httputil.DecodeAddressParam(ctx.Query("offer"))
+	ask := httputil.DecodeAddressParam(ctx.Query("ask"))
+	if offer == "" || ask == "" || offer == ask {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid offer or ask"))
+		return "", "", math.Int{}, false
+	}
+
+	amount, ok := math.NewIntFromString(ctx.Query("amount"))
+	if !ok || !amount.IsPositive() {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid amount"))
+		return "", "", math.Int{}, false
+	}
+	return offer, ask, amount, true
+}
+
+// heightParam parses the optional height query, 0 when it is not given
+func heightParam(ctx *gin.Context) (uint64, bool) {
+	heightStr := ctx.Query("height")
+	if heightStr == "" {
+		return 0, true
+	}
+	height, err := strconv.ParseUint(heightStr, 10, 64)
+	if err != nil {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid height"))
+		return 0, false
+	}
+	return height, true
+}
+
+func (c *simulateController) handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ss.ErrPoolNotFound):
+		httputil.NewError(ctx, http.StatusNotFound, err)
+	case errors.Is(err, ss.ErrInsufficientLiquidity), errors.Is(err, ss.ErrInsufficientReserves),
+		errors.Is(err, ss.ErrInvalidDeposit), errors.Is(err, ss.ErrInvalidShare),
+		errors.Is(err, ss.ErrSimulationRejected):
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+	case errors.Is(err, ss.ErrNodeQueryFailed):
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusBadGateway, ss.ErrNodeQueryFailed)
+	default:
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+	}
+}
+
+func (m *mapper) swapSimulationToRes(s *ss.SwapSimulation) SwapSimulationRes {
+	return SwapSimulationRes{
+		Pair:             s.Pair,
+		OfferAsset:       s.OfferAsset,
+		OfferAmount:      s.OfferAmount.String(),
+		AskAsset:         s.AskAsset,
+		ReturnAmount:     s.ReturnAmount.String(),
+		SpreadAmount:     s.SpreadAmount.String(),
+		CommissionAmount: s.CommissionAmount.String(),
+		Height:           s.Height,
+	}
+}
+
+func (m *mapper) provideSimulationToRes(s *ss.ProvideSimulation) ProvideSimulationRes {
+	return ProvideSimulationRes{
+		Pair:          s.Pair,
+		Lp:            s.Lp,
+		LpAmount:      s.LpAmount.String(),
+		Share:         s.Share.String(),
+		Asset0:        s.Asset0,
+		Asset0Deposit: s.Asset0Deposit.String(),
+		Asset0Refund:  s.Asset0Refund.String(),
+		Asset1:        s.Asset1,
+		Asset1Deposit: s.Asset1Deposit.String(),
+		Asset1Refund:  s.Asset1Refund.String(),
+		Height:        s.Height,
+	}
+}
+
+func (m *mapper) withdrawSimulationToRes(s *ss.WithdrawSimulation) WithdrawSimulationRes {
+	return WithdrawSimulationRes{
+		Pair:         s.Pair,
+		Lp:           s.Lp,
+		LpAmount:     s.LpAmount.String(),
+		Asset0:       s.Asset0,
+		Asset0Amount: s.Asset0Amount.String(),
+		Asset1:       s.Asset1,
+		Asset1Amount: s.Asset1Amount.String(),
+		Height:       s.Height,
+	}
+}
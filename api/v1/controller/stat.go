@@ -38,9 +38,9 @@ func (c *statController) register(route *gin.RouterGroup) {
 //	@Failure		500	{object}	httputil.InternalServerError
 //	@Router			/stats [get]
 func (c *statController) Stats(ctx *gin.Context) {
-	stats, err := c.GetAll()
+	stats, err := c.GetAll(ctx.Request.Context())
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -67,9 +67,9 @@ func (c *statController) Stat(ctx *gin.Context) {
 		return
 	}
 
-	stat, err := c.Get(period)
+	stat, err := c.Get(ctx.Request.Context(), period)
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
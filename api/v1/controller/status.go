@@ -1,21 +1,30 @@
 package controller
 
 import (
+	"net/http"
+	"time"
+
 	"github.com/dezswap/dezswap-api/api/v1/service"
 	"github.com/dezswap/dezswap-api/pkg/logging"
 	"github.com/gin-gonic/gin"
-	"net/http"
-	"time"
+)
+
+const (
+	statusOk        = "ok"
+	statusStale     = "stale"
+	statusUnhealthy = "unhealthy"
 )
 
 type statusController struct {
 	service service.StatusService
 	version string
-	logger  logging.Logger
+	// maxStaleness is unchecked when 0
+	maxStaleness time.Duration
+	logger       logging.Logger
 }
 
-func InitStatusController(service service.StatusService, r *gin.RouterGroup, version string, logger logging.Logger) StatusController {
-	c := statusController{service, version, logger}
+func InitStatusController(service service.StatusService, r *gin.RouterGroup, version string, maxStaleness time.Duration, logger logging.Logger) StatusController {
+	c := statusController{service, version, maxStaleness, logger}
 	c.register(r)
 	return &c
 }
@@ -23,6 +32,7 @@ func InitStatusController(service service.StatusService, r *gin.RouterGroup, ver
 func (c *statusController) register(r *gin.RouterGroup) {
 	r.GET("/version", c.Version)
 	r.GET("/health", c.Health)
+	r.GET("/ready", c.Ready)
 }
 
 // Version godoc
@@ -40,17 +50,39 @@ func (c *statusController) Version(ctx *gin.Context) {
 
 // Health godoc
 // @Summary      Health check
-// @Description  Checks overall service and dependency health
+// @Description  Checks overall service and dependency health with their latency, the freshness of the indexed data, the last CoinGecko price fetch and the aggregator routes
 // @Tags         status
 // @Produce      json
 // @Success      200 {object} HealthResponse
 // @Router       /health [get]
 func (c *statusController) Health(ctx *gin.Context) {
-	status := "ok"
+	ctx.JSON(http.StatusOK, c.health())
+}
+
+// Ready godoc
+// @Summary      Readiness check
+// @Description  Checks the dependencies and fails when the indexed data is staler than the configured threshold
+// @Tags         status
+// @Produce      json
+// @Success      200 {object} HealthResponse
+// @Failure      503 {object} HealthResponse
+// @Router       /ready [get]
+func (c *statusController) Ready(ctx *gin.Context) {
+	res := c.health()
+	if res.Status != statusOk {
+		ctx.JSON(http.StatusServiceUnavailable, res)
+		return
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (c *statusController) health() HealthResponse {
+	now := time.Now().UTC()
+	status := statusOk
 
 	checks := []struct {
 		name  string
-		check func() error
+		check func() (time.Duration, error)
 	}{
 		{name: "db", check: c.service.CheckDB},
 		{name: "cache", check: c.service.CheckCache},
@@ -58,23 +90,90 @@ func (c *statusController) Health(ctx *gin.Context) {
 
 	deps := make([]HealthDependency, 0, len(checks))
 	for _, d := range checks {
-		depStatus := "ok"
-		if err := d.check(); err != nil {
-			status = "unhealthy"
+		depStatus := statusOk
+		latency, err := d.check()
+		if err != nil {
+			status = statusUnhealthy
 			depStatus = "error: " + err.Error()
 		}
 
 		deps = append(deps, HealthDependency{
-			Name:   d.name,
-			Status: depStatus,
+			Name:      d.name,
+			Status:    depStatus,
+			LatencyMs: float64(latency.Microseconds()) / 1_000,
 		})
 	}
 
-	res := HealthResponse{
+	indexer := c.indexer(now)
+	switch {
+	case indexer.Status == statusStale && status == statusOk:
+		status = statusStale
+	case indexer.Status != statusOk && indexer.Status != statusStale:
+		status = statusUnhealthy
+	}
+
+	prices := HealthPrices{Status: "not fetched"}
+	if fetched := c.service.LastPriceFetch(); !fetched.IsZero() {
+		prices = HealthPrices{Status: statusOk, LastFetch: fetched.UTC().Format(time.RFC3339)}
+	}
+
+	return HealthResponse{
 		Status:       status,
-		Timestamp:    time.Now().UTC().Format(time.RFC3339),
+		Timestamp:    now.Format(time.RFC3339),
 		Dependencies: deps,
+		Indexer:      indexer,
+		Prices:       prices,
+		Routes:       c.routes(now),
 	}
+}
 
-	ctx.JSON(http.StatusOK, res)
+// indexer is stale when the synced height or the pool changes are older than the max staleness, an idle chain stays fresh
+func (c *statusController) indexer(now time.Time) HealthIndexer {
+	s, err := c.service.Indexer()
+	if err != nil {
+		return HealthIndexer{Status: "error: " + err.Error()}
+	}
+
+	res := HealthIndexer{
+		Status:                 statusOk,
+		SyncedHeight:           s.SyncedHeight,
+		SyncedHeightAgeSeconds: ageSeconds(now, s.SyncedAt),
+		PoolHeight:             s.PoolHeight,
+		PoolsBehindSeconds:     ageSeconds(now, s.PoolsBehindSince),
+		MaxStalenessSeconds:    int64(c.maxStaleness.Seconds()),
+	}
+	if c.maxStaleness == 0 {
+		return res
+	}
+	for _, age := range []*int64{res.SyncedHeightAgeSeconds, res.PoolsBehindSeconds} {
+		if age != nil && *age > res.MaxStalenessSeconds {
+			res.Status = statusStale
+		}
+	}
+	return res
+}
+
+func (c *statusController) routes(now time.Time) HealthRoutes {
+	s, err := c.service.Routes()
+	if err != nil {
+		return HealthRoutes{Status: "error: " + err.Error()}
+	}
+
+	res := HealthRoutes{Status: statusOk, Count: s.Count, AgeSeconds: ageSeconds(now, s.ModifiedAt)}
+	if s.Count == 0 {
+		res.Status = "empty"
+	}
+	if !s.ModifiedAt.IsZero() {
+		res.ModifiedAt = s.ModifiedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+// ageSeconds is nil for the zero time
+func ageSeconds(now time.Time, t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+	age := int64(now.Sub(t).Seconds())
+	return &age
 }
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dezswap/dezswap-api/api/v1/service"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type statusServiceMock struct {
+	mock.Mock
+}
+
+func (m *statusServiceMock) CheckDB() (time.Duration, error) {
+	args := m.Called()
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (m *statusServiceMock) CheckCache() (time.Duration, error) {
+	args := m.Called()
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (m *statusServiceMock) Indexer() (*service.IndexerStatus, error) {
+	args := m.Called()
+	status, _ := args.Get(0).(*service.IndexerStatus)
+	return status, args.Error(1)
+}
+
+func (m *statusServiceMock) Routes() (*service.RouteStatus, error) {
+	args := m.Called()
+	status, _ := args.Get(0).(*service.RouteStatus)
+	return status, args.Error(1)
+}
+
+func (m *statusServiceMock) LastPriceFetch() time.Time {
+	return m.Called().Get(0).(time.Time)
+}
+
+func TestStatusController_Ready(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	now := time.Now().UTC()
+
+	tcs := []struct {
+		name         string
+		maxStaleness time.Duration
+		dbErr        error
+		indexer      *service.IndexerStatus
+		indexerErr   error
+		status       string
+		code         int
+	}{
+		{
+			name:         "fresh",
+			maxStaleness: time.Minute,
+			indexer:      &service.IndexerStatus{SyncedHeight: 100, SyncedAt: now.Add(-10 * time.Second), PoolHeight: 95, PoolsBehindSince: now.Add(-5 * time.Second)},
+			status:       statusOk,
+			code:         http.StatusOK,
+		},
+		{
+			// no tx for hours, the parser still syncs every block and no pool change is behind
+			name:         "idle chain",
+			maxStaleness: time.Minute,
+			indexer:      &service.IndexerStatus{SyncedHeight: 5_000, SyncedAt: now.Add(-2 * time.Second), PoolHeight: 100},
+			status:       statusOk,
+			code:         http.StatusOK,
+		},
+		{
+			name:         "stale synced height",
+			maxStaleness: time.Minute,
+			indexer:      &service.IndexerStatus{SyncedHeight: 100, SyncedAt: now.Add(-time.Hour), PoolHeight: 100},
+			status:       statusStale,
+			code:         http.StatusServiceUnavailable,
+		},
+		{
+			name:         "stale pools",
+			maxStaleness: time.Minute,
+			indexer:      &service.IndexerStatus{SyncedHeight: 100, SyncedAt: now.Add(-10 * time.Second), PoolHeight: 95, PoolsBehindSince: now.Add(-time.Hour)},
+			status:       statusStale,
+			code:         http.StatusServiceUnavailable,
+		},
+		{
+			name:    "staleness unchecked",
+			indexer: &service.IndexerStatus{SyncedHeight: 100, SyncedAt: now.Add(-time.Hour), PoolHeight: 95, PoolsBehindSince: now.Add(-time.Hour)},
+			status:  statusOk,
+			code:    http.StatusOK,
+		},
+		{
+			name:         "no indexed data",
+			maxStaleness: time.Minute,
+			indexer:      &service.IndexerStatus{},
+			status:       statusOk,
+			code:         http.StatusOK,
+		},
+		{
+			name:         "DB error",
+			maxStaleness: time.Minute,
+			dbErr:        errors.New("connection refused"),
+			indexerErr:   errors.New("connection refused"),
+			status:       statusUnhealthy,
+			code:         http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &statusServiceMock{}
+			m.On("CheckDB").Return(2*time.Millisecond, tc.dbErr)
+			m.On("CheckCache").Return(500*time.Microsecond, nil)
+			m.On("Indexer").Return(tc.indexer, tc.indexerErr)
+			m.On("Routes").Return(&service.RouteStatus{Count: 3, ModifiedAt: now.Add(-time.Hour)}, nil)
+			m.On("LastPriceFetch").Return(now)
+
+			engine := gin.New()
+			InitStatusController(m, engine.Group(""), "test", tc.maxStaleness, nil)
+
+			for path, code := range map[string]int{"/ready": tc.code, "/health": http.StatusOK} {
+				recorder := httptest.NewRecorder()
+				engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+				assert.Equal(t, code, recorder.Code, path)
+
+				res := HealthResponse{}
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+				assert.Equal(t, tc.status, res.Status, path)
+				assert.Equal(t, int64(3), res.Routes.Count)
+				assert.Equal(t, statusOk, res.Prices.Status)
+			}
+		})
+	}
+}
+
+func TestStatusController_Health(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	now := time.Now().UTC()
+
+	m := &statusServiceMock{}
+	m.On("CheckDB").Return(2*time.Millisecond, nil)
+	m.On("CheckCache").Return(500*time.Microsecond, nil)
+	m.On("Indexer").Return(&service.IndexerStatus{SyncedHeight: 100, SyncedAt: now.Add(-10 * time.Second), PoolHeight: 105}, nil)
+	m.On("Routes").Return(&service.RouteStatus{}, nil)
+	m.On("LastPriceFetch").Return(time.Time{})
+
+	engine := gin.New()
+	InitStatusController(m, engine.Group(""), "test", time.Minute, nil)
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/health", nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+
+	res := HealthResponse{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, []HealthDependency{
+		{Name: "db", Status: statusOk, LatencyMs: 2},
+		{Name: "cache", Status: statusOk, LatencyMs: 0.5},
+	}, res.Dependencies)
+
+	assert.Equal(t, uint64(100), res.Indexer.SyncedHeight)
+	require.NotNil(t, res.Indexer.SyncedHeightAgeSeconds)
+	assert.InDelta(t, 10, *res.Indexer.SyncedHeightAgeSeconds, 1)
+	assert.Equal(t, uint64(105), res.Indexer.PoolHeight)
+	assert.Nil(t, res.Indexer.PoolsBehindSeconds)
+	assert.Equal(t, int64(60), res.Indexer.MaxStalenessSeconds)
+
+	assert.Equal(t, HealthPrices{Status: "not fetched"}, res.Prices)
+	assert.Equal(t, HealthRoutes{Status: "empty"}, res.Routes)
+}
@@ -0,0 +1,38 @@
+package stream
+
+type TxRes struct {
+	Id           uint64  `json:"id"`
+	Height       uint64  `json:"height"`
+	Timestamp    float64 `json:"timestamp"`
+	Hash         string  `json:"hash"`
+	Type         string  `json:"type"`
+	Sender       string  `json:"sender"`
+	Pair         string  `json:"pair"`
+	Asset0       string  `json:"asset0"`
+	Asset0Amount string  `json:"asset0Amount"`
+	Asset1       string  `json:"asset1"`
+	Asset1Amount string  `json:"asset1Amount"`
+}
+
+type PoolRes struct {
+	Pair         string `json:"pair"`
+	Asset0       string `json:"asset0"`
+	Asset0Amount string `json:"asset0Amount"`
+	Asset1       string `json:"asset1"`
+	Asset1Amount string `json:"asset1Amount"`
+	Height       uint64 `json:"height"`
+}
+
+type PriceRes struct {
+	Pair   string `json:"pair"`
+	Asset0 string `json:"asset0"`
+	Asset1 string `json:"asset1"`
+	// Price is of asset0 in asset1
+	Price     string  `json:"price"`
+	Height    uint64  `json:"height"`
+	Timestamp float64 `json:"timestamp"`
+}
+
+type ErrorRes struct {
+	Message string `json:"message"`
+}
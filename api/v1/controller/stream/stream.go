@@ -0,0 +1,214 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dezswap/dezswap-api/api/v1/service/stream"
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// keepAliveInterval keeps the idle connections open through the proxies
+const keepAliveInterval = 15 * time.Second
+
+type streamController struct {
+	stream.Service
+	*mapper
+	logger logging.Logger
+}
+
+type mapper struct{}
+
+func InitStreamController(s stream.Service, route *gin.RouterGroup, logger logging.Logger) *streamController {
+	c := streamController{s, &mapper{}, logger}
+	c.register(route)
+	return &c
+}
+
+func (c *streamController) register(route *gin.RouterGroup) {
+	route.GET("", c.Stream)
+}
+
+// Stream godoc
+//
+//	@Summary		Stream events
+//	@Description	stream the parsed txs, the pool reserves and the swap prices as Server-Sent Events, of which the event is the topic and the id is the height.
+//	@Description	The events from the height are replayed first when fromHeight or the Last-Event-ID header is given, the events of the height may be sent again.
+//	@Description	A subscriber falling behind the live events is disconnected with an error event and may resume from the last height.
+//	@Tags			stream
+//	@Produce		text/event-stream
+//	@Param			topics		query		string	false	"Comma separated topics of tx, pool and price, every topic when empty"
+//	@Param			pairs		query		string	false	"Comma separated pair addresses"
+//	@Param			tokens		query		string	false	"Comma separated token addresses, the events of the pairs or the tokens are sent, every event when both are empty"
+//	@Param			fromHeight	query		int		false	"Height to replay from"
+//	@Success		200			{object}	TxRes
+//	@Failure		400			{object}	httputil.BadRequestError
+//	@Failure		429			{object}	httputil.HTTPError
+//	@Failure		500			{object}	httputil.InternalServerError
+//	@Router			/stream [get]
+func (c *streamController) Stream(ctx *gin.Context) {
+	filter, err := c.filter(ctx)
+	if err != nil {
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	fromHeight, err := c.fromHeight(ctx)
+	if err != nil {
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+
+	// the events and the keep-alives are written from the different goroutines
+	var mu sync.Mutex
+	write := func(format string, args ...any) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, err := fmt.Fprintf(ctx.Writer, format, args...); err != nil {
+			return err
+		}
+		ctx.Writer.Flush()
+		return nil
+	}
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		ticker := time.NewTicker(keepAliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := write(": keep-alive\n\n"); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	err = c.Service.Subscribe(ctx.Request.Context(), filter, fromHeight, func(event stream.Event) error {
+		data, err := json.Marshal(c.eventToRes(event))
+		if err != nil {
+			return err
+		}
+		return write("id: %d\nevent: %s\ndata: %s\n\n", event.Height, event.Topic, data)
+	})
+	if err == nil {
+		return
+	}
+
+	status, message := http.StatusInternalServerError, "internal server error"
+	switch {
+	case errors.Is(err, stream.ErrTooManySubscribers):
+		status, message = http.StatusTooManyRequests, err.Error()
+	case errors.Is(err, stream.ErrReplayTooFar):
+		status, message = http.StatusBadRequest, err.Error()
+	case errors.Is(err, stream.ErrLagged):
+		status, message = http.StatusOK, err.Error()
+	default:
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+	}
+	mu.Lock()
+	written := ctx.Writer.Written()
+	mu.Unlock()
+	if !written {
+		ctx.Header("Content-Type", "application/json; charset=utf-8")
+		httputil.NewError(ctx, status, errors.New(message))
+		return
+	}
+	data, _ := json.Marshal(ErrorRes{Message: message})
+	_ = write("event: error\ndata: %s\n\n", data)
+}
+
+func (c *streamController) filter(ctx *gin.Context) (stream.Filter, error) {
+	filter := stream.Filter{Pairs: splitQuery(ctx, "pairs"), Tokens: splitQuery(ctx, "tokens")}
+	for _, topic := range splitQuery(ctx, "topics") {
+		switch t := stream.Topic(topic); t {
+		case stream.TopicTx, stream.TopicPool, stream.TopicPrice:
+			filter.Topics = append(filter.Topics, t)
+		default:
+			return stream.Filter{}, errors.Errorf("invalid topic(%s)", topic)
+		}
+	}
+	return filter, nil
+}
+
+// fromHeight returns the height to replay from, the Last-Event-ID of a reconnecting EventSource is the height
+func (c *streamController) fromHeight(ctx *gin.Context) (uint64, error) {
+	value := ctx.Query("fromHeight")
+	if value == "" {
+		value = ctx.GetHeader("Last-Event-ID")
+	}
+	if value == "" {
+		return 0, nil
+	}
+	height, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid fromHeight")
+	}
+	return height, nil
+}
+
+func splitQuery(ctx *gin.Context, key string) []string {
+	values := []string{}
+	for _, value := range strings.Split(ctx.Query(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func (m *mapper) eventToRes(event stream.Event) any {
+	switch {
+	case event.Tx != nil:
+		tx := event.Tx
+		return TxRes{
+			Id:           tx.Id,
+			Height:       tx.Height,
+			Timestamp:    tx.Timestamp,
+			Hash:         tx.Hash,
+			Type:         tx.Type,
+			Sender:       tx.Sender,
+			Pair:         tx.Pair,
+			Asset0:       tx.Asset0,
+			Asset0Amount: tx.Asset0Amount,
+			Asset1:       tx.Asset1,
+			Asset1Amount: tx.Asset1Amount,
+		}
+	case event.Pool != nil:
+		pool := event.Pool
+		return PoolRes{
+			Pair:         pool.Pair,
+			Asset0:       pool.Asset0,
+			Asset0Amount: pool.Asset0Amount,
+			Asset1:       pool.Asset1,
+			Asset1Amount: pool.Asset1Amount,
+			Height:       pool.Height,
+		}
+	case event.Price != nil:
+		price := event.Price
+		return PriceRes{
+			Pair:      price.Pair,
+			Asset0:    price.Asset0,
+			Asset1:    price.Asset1,
+			Price:     price.Price,
+			Height:    price.Height,
+			Timestamp: price.Timestamp,
+		}
+	}
+	return nil
+}
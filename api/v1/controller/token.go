@@ -11,20 +11,27 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	totalSupplySuffix       = "/supply/total"
+	circulatingSupplySuffix = "/supply/circulating"
+)
+
 type tokenController struct {
 	service2.Getter[service2.Token]
-	logger logging.Logger
+	supplyService service2.Getter[service2.TokenSupply]
+	logger        logging.Logger
 	tokenMapper
 }
 
-func InitTokenController(s service2.Getter[service2.Token], route *gin.RouterGroup, logger logging.Logger) TokenController {
-	c := tokenController{s, logger, tokenMapper{}}
+func InitTokenController(s service2.Getter[service2.Token], supplyService service2.Getter[service2.TokenSupply], route *gin.RouterGroup, logger logging.Logger) TokenController {
+	c := tokenController{s, supplyService, logger, tokenMapper{}}
 	c.register(route)
 	return &c
 }
 
 func (c *tokenController) register(route *gin.RouterGroup) {
 	route.GET("/tokens", c.Tokens)
+	// supply endpoints share the wildcard because an address may contain slashes (e.g. ibc/...)
 	route.GET("/tokens/*address", c.Token)
 }
 
@@ -40,9 +47,9 @@ func (c *tokenController) register(route *gin.RouterGroup) {
 //	@Failure		500	{object}	httputil.InternalServerError
 //	@Router			/tokens [get]
 func (c *tokenController) Tokens(ctx *gin.Context) {
-	tokens, err := c.GetAll()
+	tokens, err := c.GetAll(ctx.Request.Context())
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -70,10 +77,19 @@ func (c *tokenController) Token(ctx *gin.Context) {
 	}
 
 	address = strings.TrimPrefix(address, "/")
+	if addr, ok := strings.CutSuffix(address, totalSupplySuffix); ok {
+		c.totalSupply(ctx, httputil.DecodeAddressParam(addr))
+		return
+	}
+	if addr, ok := strings.CutSuffix(address, circulatingSupplySuffix); ok {
+		c.circulatingSupply(ctx, httputil.DecodeAddressParam(addr))
+		return
+	}
+
 	address = httputil.DecodeAddressParam(address)
-	token, err := c.Get(address)
+	token, err := c.Get(ctx.Request.Context(), address)
 	if err != nil {
-		c.logger.Warn(err)
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
 		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
 		return
 	}
@@ -86,3 +102,60 @@ func (c *tokenController) Token(ctx *gin.Context) {
 	res := c.tokenToRes(*token)
 	ctx.JSON(http.StatusOK, res)
 }
+
+// totalSupply godoc
+//
+//	@Summary		Total supply of a token
+//	@Description	get the total supply of a token normalized by its decimals as plain text
+//	@Produce		plain
+//	@Param			address	path		string	true	"Token Address"
+//	@Success		200		{string}	string
+//	@Failure		400		{object}	httputil.BadRequestError
+//	@Failure		404		{object}	httputil.NotFoundError
+//	@Failure		500		{object}	httputil.InternalServerError
+//	@Router			/tokens/{address}/supply/total [get]
+func (c *tokenController) totalSupply(ctx *gin.Context, address string) {
+	supply := c.supply(ctx, address)
+	if supply == nil {
+		return
+	}
+	ctx.String(http.StatusOK, supply.TotalSupply)
+}
+
+// circulatingSupply godoc
+//
+//	@Summary		Circulating supply of a token
+//	@Description	get the circulating supply of a token normalized by its decimals as plain text, balances of the configured holders are excluded
+//	@Produce		plain
+//	@Param			address	path		string	true	"Token Address"
+//	@Success		200		{string}	string
+//	@Failure		400		{object}	httputil.BadRequestError
+//	@Failure		404		{object}	httputil.NotFoundError
+//	@Failure		500		{object}	httputil.InternalServerError
+//	@Router			/tokens/{address}/supply/circulating [get]
+func (c *tokenController) circulatingSupply(ctx *gin.Context, address string) {
+	supply := c.supply(ctx, address)
+	if supply == nil {
+		return
+	}
+	ctx.String(http.StatusOK, supply.CirculatingSupply)
+}
+
+func (c *tokenController) supply(ctx *gin.Context, address string) *service2.TokenSupply {
+	if address == "" {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid address"))
+		return nil
+	}
+
+	supply, err := c.supplyService.Get(ctx.Request.Context(), address)
+	if err != nil {
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+		return nil
+	}
+	if supply == nil {
+		httputil.NewError(ctx, http.StatusNotFound, errors.New("token supply not found"))
+		return nil
+	}
+	return supply
+}
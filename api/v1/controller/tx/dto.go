@@ -0,0 +1,31 @@
+package tx
+
+import "encoding/json"
+
+type CoinRes struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+type MsgExecuteContractRes struct {
+	Sender   string          `json:"sender"`
+	Contract string          `json:"contract"`
+	Msg      json.RawMessage `json:"msg" swaggertype:"object"`
+	Funds    []CoinRes       `json:"funds"`
+}
+
+type MsgRes struct {
+	TypeUrl string                `json:"typeUrl"`
+	Value   MsgExecuteContractRes `json:"value"`
+}
+
+type TxRes struct {
+	Msgs []MsgRes `json:"msgs"`
+}
+
+type SwapTxRes struct {
+	Msgs           []MsgRes `json:"msgs"`
+	MinimumReceive string   `json:"minimumReceive"`
+	ReturnAmount   string   `json:"returnAmount"`
+	PriceImpact    string   `json:"priceImpact"`
+}
@@ -0,0 +1,235 @@
+package tx
+
+import (
+	"net/http"
+	"strings"
+
+	"cosmossdk.io/math"
+	ss "github.com/dezswap/dezswap-api/api/v1/service/simulate"
+	ts "github.com/dezswap/dezswap-api/api/v1/service/tx"
+	"github.com/dezswap/dezswap-api/pkg"
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	msgExecuteContractTypeUrl = "/cosmwasm.wasm.v1.MsgExecuteContract"
+	defaultSlippage           = "0.005"
+)
+
+type txController struct {
+	ts.Builder
+	*mapper
+	logger logging.Logger
+}
+
+type mapper struct{}
+
+func InitTxController(b ts.Builder, route *gin.RouterGroup, logger logging.Logger) *txController {
+	c := txController{b, &mapper{}, logger}
+	c.register(route)
+	return &c
+}
+
+func (c *txController) register(route *gin.RouterGroup) {
+	route.GET("/swap", c.Swap)
+	route.GET("/provide", c.Provide)
+	route.GET("/withdraw", c.Withdraw)
+}
+
+//	Swap godoc
+//
+//	@Tags			tx
+//	@Summary		Swap messages
+//	@Description	build unsigned execute_swap_operations messages of the router for the route, minimum_receive is the simulated return less the slippage
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	SwapTxRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Failure		501	{object}	httputil.HTTPError
+//	@Router			/tx/swap [get]
+//
+// @Param			sender		query		string	true	"Sender address"
+// @Param			route		query		string	true	"Comma separated token addresses from the offer token to the ask token"
+// @Param			amount		query		string	true	"Offer amount in the smallest unit"
+// @Param			slippage	query		string	false	"Slippage tolerance, 0.005 by default"
+func (c *txController) Swap(ctx *gin.Context) {
+	sender := ctx.Query("sender")
+	if sender == "" {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid sender"))
+		return
+	}
+
+	route := []string{}
+	for _, addr := range strings.Split(ctx.Query("route"), ",") {
+		if addr = httputil.DecodeAddressParam(strings.TrimSpace(addr)); addr != "" {
+			route = append(route, addr)
+		}
+	}
+	if len(route) < 2 {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid route"))
+		return
+	}
+
+	amount, ok := amountParam(ctx, "amount")
+	if !ok {
+		return
+	}
+	slippage, ok := slippageParam(ctx)
+	if !ok {
+		return
+	}
+
+	tx, err := c.Builder.Swap(ctx.Request.Context(), sender, route, amount, slippage)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, SwapTxRes{
+		Msgs:           c.msgsToRes(tx.Msgs),
+		MinimumReceive: tx.MinimumReceive.String(),
+		ReturnAmount:   tx.Simulation.ReturnAmount.String(),
+		PriceImpact:    tx.Simulation.PriceImpact.String(),
+	})
+}
+
+//	Provide godoc
+//
+//	@Tags			tx
+//	@Summary		Provide liquidity messages
+//	@Description	build unsigned provide_liquidity messages of the pair, preceded by increase_allowance messages of cw20 deposits
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	TxRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/tx/provide [get]
+//
+// @Param			sender		query		string	true	"Sender address"
+// @Param			pair		query		string	true	"Pair address"
+// @Param			amount0		query		string	true	"Deposit amount of the asset0 of the pair in the smallest unit"
+// @Param			amount1		query		string	true	"Deposit amount of the asset1 of the pair in the smallest unit"
+// @Param			slippage	query		string	false	"Slippage tolerance, 0.005 by default"
+func (c *txController) Provide(ctx *gin.Context) {
+	sender, pair := ctx.Query("sender"), ctx.Query("pair")
+	if sender == "" || pair == "" {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid sender or pair"))
+		return
+	}
+
+	amount0, ok := amountParam(ctx, "amount0")
+	if !ok {
+		return
+	}
+	amount1, ok := amountParam(ctx, "amount1")
+	if !ok {
+		return
+	}
+	slippage, ok := slippageParam(ctx)
+	if !ok {
+		return
+	}
+
+	msgs, err := c.Builder.Provide(ctx.Request.Context(), sender, pair, amount0, amount1, slippage)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, TxRes{Msgs: c.msgsToRes(msgs)})
+}
+
+//	Withdraw godoc
+//
+//	@Tags			tx
+//	@Summary		Withdraw liquidity messages
+//	@Description	build unsigned send messages of the lp token which withdraw the liquidity from the pair
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	TxRes
+//	@Failure		400	{object}	httputil.BadRequestError
+//	@Failure		404	{object}	httputil.NotFoundError
+//	@Failure		500	{object}	httputil.InternalServerError
+//	@Router			/tx/withdraw [get]
+//
+// @Param			sender	query		string	true	"Sender address"
+// @Param			pair	query		string	true	"Pair address"
+// @Param			amount	query		string	true	"Lp amount in the smallest unit"
+func (c *txController) Withdraw(ctx *gin.Context) {
+	sender, pair := ctx.Query("sender"), ctx.Query("pair")
+	if sender == "" || pair == "" {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid sender or pair"))
+		return
+	}
+
+	amount, ok := amountParam(ctx, "amount")
+	if !ok {
+		return
+	}
+
+	msgs, err := c.Builder.Withdraw(ctx.Request.Context(), sender, pair, amount)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, TxRes{Msgs: c.msgsToRes(msgs)})
+}
+
+func amountParam(ctx *gin.Context, key string) (math.Int, bool) {
+	amount, ok := math.NewIntFromString(ctx.Query(key))
+	if !ok || !amount.IsPositive() {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.Errorf("invalid %s", key))
+		return math.Int{}, false
+	}
+	return amount, true
+}
+
+func slippageParam(ctx *gin.Context) (math.LegacyDec, bool) {
+	slippage, err := math.LegacyNewDecFromStr(ctx.DefaultQuery("slippage", defaultSlippage))
+	if err != nil {
+		httputil.NewError(ctx, http.StatusBadRequest, ts.ErrInvalidSlippage)
+		return math.LegacyDec{}, false
+	}
+	return slippage, true
+}
+
+func (c *txController) handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ss.ErrPoolNotFound):
+		httputil.NewError(ctx, http.StatusNotFound, err)
+	case errors.Is(err, ts.ErrInvalidSlippage), errors.Is(err, ss.ErrInvalidShare), errors.Is(err, ss.ErrInsufficientLiquidity):
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+	case errors.Is(err, pkg.ErrUnregisteredRouterAddress), errors.Is(err, pkg.ErrUnsupportedNetwork):
+		httputil.NewError(ctx, http.StatusNotImplemented, err)
+	default:
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+	}
+}
+
+func (m *mapper) msgsToRes(msgs []ts.ExecuteMsg) []MsgRes {
+	res := make([]MsgRes, len(msgs))
+	for i, msg := range msgs {
+		funds := make([]CoinRes, len(msg.Funds))
+		for j, f := range msg.Funds {
+			funds[j] = CoinRes{Denom: f.Denom, Amount: f.Amount.String()}
+		}
+		res[i] = MsgRes{
+			TypeUrl: msgExecuteContractTypeUrl,
+			Value: MsgExecuteContractRes{
+				Sender:   msg.Sender,
+				Contract: msg.Contract,
+				Msg:      msg.Msg,
+				Funds:    funds,
+			},
+		}
+	}
+	return res
+}
@@ -0,0 +1,66 @@
+package udf
+
+type ConfigRes struct {
+	SupportedResolutions   []string        `json:"supported_resolutions"`
+	SupportsSearch         bool            `json:"supports_search"`
+	SupportsGroupRequest   bool            `json:"supports_group_request"`
+	SupportsMarks          bool            `json:"supports_marks"`
+	SupportsTimescaleMarks bool            `json:"supports_timescale_marks"`
+	SupportsTime           bool            `json:"supports_time"`
+	Exchanges              []ExchangeRes   `json:"exchanges"`
+	SymbolsTypes           []SymbolTypeRes `json:"symbols_types"`
+}
+
+type ExchangeRes struct {
+	Value string `json:"value"`
+	Name  string `json:"name"`
+	Desc  string `json:"desc"`
+}
+
+type SymbolTypeRes struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type SymbolRes struct {
+	Name                 string   `json:"name"`
+	Ticker               string   `json:"ticker"`
+	Description          string   `json:"description"`
+	Type                 string   `json:"type"`
+	Session              string   `json:"session"`
+	Exchange             string   `json:"exchange"`
+	ListedExchange       string   `json:"listed_exchange"`
+	Timezone             string   `json:"timezone"`
+	Minmov               int      `json:"minmov"`
+	Pricescale           int64    `json:"pricescale"`
+	HasIntraday          bool     `json:"has_intraday"`
+	HasDaily             bool     `json:"has_daily"`
+	HasWeeklyAndMonthly  bool     `json:"has_weekly_and_monthly"`
+	SupportedResolutions []string `json:"supported_resolutions"`
+	VolumePrecision      int      `json:"volume_precision"`
+	DataStatus           string   `json:"data_status"`
+}
+
+type SearchRes []SearchItemRes
+
+type SearchItemRes struct {
+	Symbol      string `json:"symbol"`
+	FullName    string `json:"full_name"`
+	Description string `json:"description"`
+	Exchange    string `json:"exchange"`
+	Ticker      string `json:"ticker"`
+	Type        string `json:"type"`
+}
+
+// HistoryRes has the bars in the columns, s is ok or no_data
+type HistoryRes struct {
+	S string    `json:"s"`
+	T []int64   `json:"t,omitempty"`
+	O []float64 `json:"o,omitempty"`
+	H []float64 `json:"h,omitempty"`
+	L []float64 `json:"l,omitempty"`
+	C []float64 `json:"c,omitempty"`
+	V []float64 `json:"v,omitempty"`
+	// NextTime is the time of the latest bar before the requested range on no_data
+	NextTime int64 `json:"nextTime,omitempty"`
+}
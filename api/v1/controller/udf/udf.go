@@ -0,0 +1,281 @@
+package udf
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dezswap/dezswap-api/api/v1/service/udf"
+	"github.com/dezswap/dezswap-api/pkg/httputil"
+	"github.com/dezswap/dezswap-api/pkg/logging"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+const (
+	exchange   = "Dezswap"
+	symbolType = "crypto"
+	// the prices of the pairs vary by many orders, 8 decimal places are shown
+	pricescale = 100_000_000
+	// volume_precision of the chart, decimal places of the base volume
+	volumePrecision = 6
+
+	defaultSearchLimit = 30
+	maxSearchLimit     = 100
+)
+
+type udfController struct {
+	udf.Service
+	*mapper
+	logger logging.Logger
+}
+
+type mapper struct{}
+
+func InitUdfController(s udf.Service, route *gin.RouterGroup, logger logging.Logger) *udfController {
+	c := udfController{s, &mapper{}, logger}
+	c.register(route)
+	return &c
+}
+
+func (c *udfController) register(route *gin.RouterGroup) {
+	route.GET("/config", c.Config)
+	route.GET("/symbols", c.Symbols)
+	route.GET("/search", c.Search)
+	route.GET("/history", c.History)
+	route.GET("/time", c.Time)
+}
+
+// Udf godoc
+//
+//	@Summary		Datafeed configuration
+//	@Description	get the configuration of the TradingView UDF datafeed
+//	@Tags			udf
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	ConfigRes
+//	@Router			/udf/config [get]
+func (c *udfController) Config(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, ConfigRes{
+		SupportedResolutions: c.resolutionsToRes(),
+		SupportsSearch:       true,
+		SupportsTime:         true,
+		Exchanges:            []ExchangeRes{{Value: exchange, Name: exchange, Desc: exchange}},
+		SymbolsTypes:         []SymbolTypeRes{{Name: symbolType, Value: symbolType}},
+	})
+}
+
+// Udf godoc
+//
+//	@Summary		Resolve a symbol
+//	@Description	get the symbol information of the ticker in the form of asset0_asset1
+//	@Tags			udf
+//	@Accept			json
+//	@Produce		json
+//	@Param			symbol	query		string	true	"Ticker in the form of asset0_asset1"
+//	@Success		200		{object}	SymbolRes
+//	@Failure		400		{object}	httputil.BadRequestError
+//	@Failure		404		{object}	httputil.NotFoundError
+//	@Failure		500		{object}	httputil.InternalServerError
+//	@Router			/udf/symbols [get]
+func (c *udfController) Symbols(ctx *gin.Context) {
+	symbol, err := c.Service.Symbol(ctx.Request.Context(), ctx.Query("symbol"))
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.symbolToRes(*symbol))
+}
+
+// Udf godoc
+//
+//	@Summary		Search symbols
+//	@Description	get the symbols of which the pair name contains the query, or the pair or token address is the query
+//	@Tags			udf
+//	@Accept			json
+//	@Produce		json
+//	@Param			query		query		string	true	"Query"
+//	@Param			type		query		string	false	"Symbol type"
+//	@Param			exchange	query		string	false	"Exchange"
+//	@Param			limit		query		int		false	"Limit"	default(30)	maximum(100)
+//	@Success		200			{object}	SearchRes
+//	@Failure		400			{object}	httputil.BadRequestError
+//	@Failure		500			{object}	httputil.InternalServerError
+//	@Router			/udf/search [get]
+func (c *udfController) Search(ctx *gin.Context) {
+	limit := defaultSearchLimit
+	if value := ctx.Query("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit <= 0 {
+			httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid limit"))
+			return
+		}
+		limit = min(limit, maxSearchLimit)
+	}
+	// every symbol is of the one type and the exchange
+	if t := ctx.Query("type"); t != "" && t != symbolType {
+		ctx.JSON(http.StatusOK, SearchRes{})
+		return
+	}
+	if e := ctx.Query("exchange"); e != "" && e != exchange {
+		ctx.JSON(http.StatusOK, SearchRes{})
+		return
+	}
+
+	symbols, err := c.Service.Search(ctx.Request.Context(), ctx.Query("query"), limit)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.searchToRes(symbols))
+}
+
+// Udf godoc
+//
+//	@Summary		Bars
+//	@Description	get the OHLCV bars of the ticker built from the swaps, the price is of asset0 in asset1 and the volume is of asset0
+//	@Tags			udf
+//	@Accept			json
+//	@Produce		json
+//	@Param			symbol		query		string	true	"Ticker in the form of asset0_asset1"
+//	@Param			resolution	query		string	true	"Resolution"	Enums(1, 5, 15, 30, 60, 240, 1D, 1W)
+//	@Param			from		query		int		true	"From in unix seconds, inclusive"
+//	@Param			to			query		int		true	"To in unix seconds, exclusive"
+//	@Param			countback	query		int		false	"Number of the bars before to, from is ignored when given"
+//	@Success		200			{object}	HistoryRes
+//	@Failure		400			{object}	httputil.BadRequestError
+//	@Failure		404			{object}	httputil.NotFoundError
+//	@Failure		500			{object}	httputil.InternalServerError
+//	@Router			/udf/history [get]
+func (c *udfController) History(ctx *gin.Context) {
+	resolution, err := udf.ParseResolution(ctx.Query("resolution"))
+	if err != nil {
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+		return
+	}
+	from, err := strconv.ParseInt(ctx.Query("from"), 10, 64)
+	if err != nil {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid from"))
+		return
+	}
+	to, err := strconv.ParseInt(ctx.Query("to"), 10, 64)
+	if err != nil || to < from {
+		httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid to"))
+		return
+	}
+	countback := 0
+	if value := ctx.Query("countback"); value != "" {
+		if countback, err = strconv.Atoi(value); err != nil || countback < 0 {
+			httputil.NewError(ctx, http.StatusBadRequest, errors.New("invalid countback"))
+			return
+		}
+	}
+
+	history, err := c.Service.History(ctx.Request.Context(), ctx.Query("symbol"), resolution, from, to, countback)
+	if err != nil {
+		c.handleError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, c.historyToRes(*history))
+}
+
+// Udf godoc
+//
+//	@Summary		Server time
+//	@Description	get the server time in unix seconds
+//	@Tags			udf
+//	@Produce		plain
+//	@Success		200	{string}	string
+//	@Router			/udf/time [get]
+func (c *udfController) Time(ctx *gin.Context) {
+	ctx.String(http.StatusOK, strconv.FormatInt(time.Now().Unix(), 10))
+}
+
+func (c *udfController) handleError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, udf.ErrInvalidTicker), errors.Is(err, udf.ErrUnsupportedResolution):
+		httputil.NewError(ctx, http.StatusBadRequest, err)
+	case errors.Is(err, udf.ErrSymbolNotFound):
+		httputil.NewError(ctx, http.StatusNotFound, err)
+	default:
+		logging.WithContext(c.logger, ctx.Request.Context()).Warn(err)
+		httputil.NewError(ctx, http.StatusInternalServerError, errors.New("internal server error"))
+	}
+}
+
+func (m *mapper) resolutionsToRes() []string {
+	res := make([]string, len(udf.Resolutions))
+	for i, r := range udf.Resolutions {
+		res[i] = string(r)
+	}
+	return res
+}
+
+func (m *mapper) symbolToRes(symbol udf.Symbol) SymbolRes {
+	return SymbolRes{
+		Name:                 m.symbolName(symbol),
+		Ticker:               symbol.Ticker,
+		Description:          m.symbolDescription(symbol),
+		Type:                 symbolType,
+		Session:              "24x7",
+		Exchange:             exchange,
+		ListedExchange:       exchange,
+		Timezone:             "Etc/UTC",
+		Minmov:               1,
+		Pricescale:           pricescale,
+		HasIntraday:          true,
+		HasDaily:             true,
+		HasWeeklyAndMonthly:  true,
+		SupportedResolutions: m.resolutionsToRes(),
+		VolumePrecision:      volumePrecision,
+		DataStatus:           "streaming",
+	}
+}
+
+func (m *mapper) searchToRes(symbols []udf.Symbol) SearchRes {
+	res := make(SearchRes, len(symbols))
+	for i, symbol := range symbols {
+		name := m.symbolName(symbol)
+		res[i] = SearchItemRes{
+			Symbol:      name,
+			FullName:    exchange + ":" + name,
+			Description: m.symbolDescription(symbol),
+			Exchange:    exchange,
+			Ticker:      symbol.Ticker,
+			Type:        symbolType,
+		}
+	}
+	return res
+}
+
+func (m *mapper) historyToRes(history udf.History) HistoryRes {
+	if len(history.Bars) == 0 {
+		return HistoryRes{S: "no_data", NextTime: history.NextTime}
+	}
+
+	res := HistoryRes{
+		S: "ok",
+		T: make([]int64, len(history.Bars)),
+		O: make([]float64, len(history.Bars)),
+		H: make([]float64, len(history.Bars)),
+		L: make([]float64, len(history.Bars)),
+		C: make([]float64, len(history.Bars)),
+		V: make([]float64, len(history.Bars)),
+	}
+	for i, bar := range history.Bars {
+		res.T[i], res.O[i], res.H[i], res.L[i], res.C[i], res.V[i] = bar.Time, bar.Open, bar.High, bar.Low, bar.Close, bar.Volume
+	}
+	return res
+}
+
+func (m *mapper) symbolName(symbol udf.Symbol) string {
+	return fmt.Sprintf("%s/%s", symbol.BaseSymbol, symbol.QuoteSymbol)
+}
+
+func (m *mapper) symbolDescription(symbol udf.Symbol) string {
+	return fmt.Sprintf("%s / %s on %s (%s)", symbol.BaseSymbol, symbol.QuoteSymbol, exchange, symbol.Pair)
+}
@@ -1,18 +1,38 @@
 package v1
 
 import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
 	"github.com/dezswap/dezswap-api/api/v1/controller"
+	"github.com/dezswap/dezswap-api/api/v1/controller/adapter"
 	"github.com/dezswap/dezswap-api/api/v1/controller/coingecko"
 	"github.com/dezswap/dezswap-api/api/v1/controller/coinmarketcap"
 	"github.com/dezswap/dezswap-api/api/v1/controller/dashboard"
+	"github.com/dezswap/dezswap-api/api/v1/controller/depth"
+	"github.com/dezswap/dezswap-api/api/v1/controller/dexscreener"
+	"github.com/dezswap/dezswap-api/api/v1/controller/gql"
 	"github.com/dezswap/dezswap-api/api/v1/controller/notice"
 	"github.com/dezswap/dezswap-api/api/v1/controller/router"
+	"github.com/dezswap/dezswap-api/api/v1/controller/simulate"
+	"github.com/dezswap/dezswap-api/api/v1/controller/stream"
+	"github.com/dezswap/dezswap-api/api/v1/controller/tx"
+	"github.com/dezswap/dezswap-api/api/v1/controller/udf"
 	"github.com/dezswap/dezswap-api/api/v1/service"
+	as "github.com/dezswap/dezswap-api/api/v1/service/adapter"
 	cgs "github.com/dezswap/dezswap-api/api/v1/service/coingecko"
 	cmcs "github.com/dezswap/dezswap-api/api/v1/service/coinmarketcap"
 	ds "github.com/dezswap/dezswap-api/api/v1/service/dashboard"
+	dps "github.com/dezswap/dezswap-api/api/v1/service/depth"
+	dss "github.com/dezswap/dezswap-api/api/v1/service/dexscreener"
 	ns "github.com/dezswap/dezswap-api/api/v1/service/notice"
 	rs "github.com/dezswap/dezswap-api/api/v1/service/router"
+	ss "github.com/dezswap/dezswap-api/api/v1/service/simulate"
+	sts "github.com/dezswap/dezswap-api/api/v1/service/stream"
+	ts "github.com/dezswap/dezswap-api/api/v1/service/tx"
+	us "github.com/dezswap/dezswap-api/api/v1/service/udf"
+	"github.com/dezswap/dezswap-api/configs"
 	"github.com/dezswap/dezswap-api/pkg"
 	"github.com/dezswap/dezswap-api/pkg/cache"
 	"github.com/dezswap/dezswap-api/pkg/db/api"
@@ -21,40 +41,143 @@ import (
 	"gorm.io/gorm"
 )
 
+// StreamPath serves the live events, the responses must not be cached
+const StreamPath = "/stream"
+
+// HealthPath and ReadyPath serve the status of the API, the responses must not be cached
+const (
+	HealthPath = "/health"
+	ReadyPath  = "/ready"
+)
+
+// Services are the services behind the v1 endpoints, which the other transports of the API share
+type Services struct {
+	Pairs     service.Getter[service.Pair]
+	Pools     service.Getter[service.Pool]
+	Tokens    service.Getter[service.Token]
+	Stats     service.Getter[service.PairStats]
+	Router    rs.Router
+	Quoter    rs.Quoter
+	Dashboard ds.Dashboard
+	Stream    sts.Service
+}
+
 // RegisterRoutes sets up v1 API endpoints
-func RegisterRoutes(rg *gin.RouterGroup, chainId string, coinGeckoApiKey string, version string, networkMetadata pkg.NetworkMetadata, db *gorm.DB, cache cache.Cache, logger logging.Logger) {
-	statusService := service.NewStatusService(db, cache)
+func RegisterRoutes(rg *gin.RouterGroup, chainId string, coinGeckoApiKey string, version string, networkMetadata pkg.NetworkMetadata, db *gorm.DB, cache cache.Cache, grpcClients []pkg.GrpcClient, routerConfig configs.RouterConfig, depthConfig configs.DepthConfig, streamConfig configs.StreamConfig, graphqlConfig configs.GraphqlConfig, statusConfig configs.StatusConfig, logger logging.Logger) Services {
 	pairService := service.NewPairService(chainId, db)
 	poolService := service.NewPoolService(chainId, db)
 	tokenService := service.NewTokenService(chainId, db)
+	tokenSupplyService := service.NewTokenSupplyService(chainId, db)
 	statService := service.NewStatService(chainId, db)
 
-	controller.InitStatusController(statusService, rg, version, logger)
 	controller.InitPairController(pairService, rg, networkMetadata, logger)
 	controller.InitPoolController(poolService, rg, networkMetadata, logger)
-	controller.InitTokenController(tokenService, rg, logger)
+	controller.InitTokenController(tokenService, tokenSupplyService, rg, logger)
 	controller.InitStatController(statService, rg, logger)
 
+	depthRepo := api.NewDepthDbRepo(chainId, db)
+	depthService := dps.New(depthRepo, depthPercentages(depthConfig.Percentages))
+	depth.InitDepthController(depthService, rg.Group("/depth"), logger)
+
 	// CoinGecko endpoint
 	r := rg.Group("/coingecko")
 	coinGeckoPairService := cgs.NewPairService(chainId, db)
-	coinGeckoTickerService := cgs.NewTickerService(chainId, db, coinGeckoApiKey)
+	coinGeckoTickerService := cgs.NewTickerService(chainId, db, coinGeckoApiKey, depthService)
+
+	// the status reports the last price fetch of the ticker service
+	statusService := service.NewStatusService(chainId, db, cache, coinGeckoTickerService)
+	controller.InitStatusController(statusService, rg, version, time.Duration(statusConfig.MaxStalenessSeconds)*time.Second, logger)
 
 	coingecko.InitPairController(coinGeckoPairService, r, logger)
 	coingecko.InitTickerController(coinGeckoTickerService, r, logger)
+	tradeService := cgs.NewTradeService(chainId, db)
+	coingecko.InitTradeController(tradeService, r, logger)
+	orderbookDepthService := dps.New(depthRepo, depthPercentages(depthConfig.OrderbookPercentages))
+	coingecko.InitOrderbookController(cgs.NewOrderbookService(chainId, db, orderbookDepthService), r, logger)
 
 	// CoinMarketCap endpoint
 	r = rg.Group("/coinmarketcap")
 	coinMarketCapTickerService := cmcs.NewTickerService(chainId, db)
 	coinmarketcap.InitTickerController(coinMarketCapTickerService, r, logger)
+	coinmarketcap.InitSummaryController(cmcs.NewSummaryService(chainId, db, coinMarketCapTickerService), tradeService, r, logger)
+
+	// DEX Screener endpoint
+	dexScreenerService := dss.NewService(chainId, db, tokenSupplyService, grpcClients)
+	dexscreener.InitDexScreenerController(dexScreenerService, rg.Group("/dexscreener"), logger)
+
+	// TradingView UDF endpoint
+	udf.InitUdfController(us.NewService(chainId, db), rg.Group("/udf"), logger)
 
 	dashboardService := ds.NewDashboardService(chainId, db)
 	dashboard.InitDashboardController(dashboardService, rg.Group("/dashboard"), logger)
 
+	gql.InitGraphqlController(pairService, poolService, tokenService, dashboardService, graphqlConfig.MaxDepth, graphqlConfig.MaxComplexity, rg.Group("/graphql"), logger)
+
+	// DefiLlama and GeckoTerminal endpoint
+	adapterService := as.New(dashboardService, coinGeckoTickerService, cache)
+	adapter.InitAdapterController(adapterService, rg.Group("/adapters"), logger)
+
+	streamRepo := api.NewStreamDbRepo(chainId, db)
+	streamService := sts.New(streamRepo, time.Duration(networkMetadata.BlockSecond)*time.Second, streamConfig.BufferSize, streamConfig.MaxReplayBlocks, streamConfig.MaxSubscribers)
+	stream.InitStreamController(streamService, rg.Group(StreamPath), logger)
+
 	noticeService := ns.NewService(db)
 	notice.InitNoticeController(noticeService, rg.Group("/notices"), logger)
 
-	routerRepo := api.NewRouterDbRepo(chainId, db)
-	routerService := rs.New(routerRepo)
-	router.InitRouterController(routerService, rg.Group("/routes"), logger)
+	poolRepo := api.NewPoolDbRepo(chainId, db)
+	simulator := ss.New(poolRepo)
+	var nodeSimulator ss.NodeSimulator
+	if len(grpcClients) > 0 {
+		nodeSimulator = ss.NewNodeSimulator(poolRepo, networkMetadata, grpcClients, cache)
+	}
+	simulate.InitSimulateController(simulator, nodeSimulator, rg.Group("/simulate"), logger)
+
+	txBuilder := ts.NewBuilder(chainId, networkMetadata, poolRepo, simulator)
+	tx.InitTxController(txBuilder, rg.Group("/tx"), logger)
+
+	routerService := rs.New(routerRepo(chainId, networkMetadata, db, routerConfig))
+	quoter := rs.NewQuoter(routerService, simulator)
+	router.InitRouterController(routerService, quoter, rg.Group("/routes"), logger)
+
+	return Services{
+		Pairs:     pairService,
+		Pools:     poolService,
+		Tokens:    tokenService,
+		Stats:     statService,
+		Router:    routerService,
+		Quoter:    quoter,
+		Dashboard: dashboardService,
+		Stream:    streamService,
+	}
+}
+
+func routerRepo(chainId string, networkMetadata pkg.NetworkMetadata, db *gorm.DB, c configs.RouterConfig) rs.RouterRepo {
+	switch c.Source {
+	case "", configs.RouterSourceAggregator:
+		return api.NewRouterDbRepo(chainId, db)
+	case configs.RouterSourceGraph:
+		minLiquidity := math.ZeroInt()
+		if c.MinPoolLiquidity != "" {
+			var ok bool
+			if minLiquidity, ok = math.NewIntFromString(c.MinPoolLiquidity); !ok {
+				panic(fmt.Sprintf("invalid router min pool liquidity(%s)", c.MinPoolLiquidity))
+			}
+		}
+		// pools change once a block at most
+		return api.NewRouterGraphRepo(chainId, db, c.MaxHopCount, minLiquidity, time.Duration(networkMetadata.BlockSecond)*time.Second)
+	default:
+		panic(fmt.Sprintf("unknown router source(%s)", c.Source))
+	}
+}
+
+func depthPercentages(values []string) []math.LegacyDec {
+	percentages := make([]math.LegacyDec, len(values))
+	for i, p := range values {
+		percentage, err := math.LegacyNewDecFromStr(p)
+		if err != nil || !percentage.IsPositive() || percentage.GTE(math.LegacyNewDec(100)) {
+			panic(fmt.Sprintf("invalid depth percentage(%s)", p))
+		}
+		percentages[i] = percentage
+	}
+	return percentages
 }
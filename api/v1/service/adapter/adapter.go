@@ -0,0 +1,147 @@
+package adapter
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/dezswap/dezswap-api/api/v1/service/dashboard"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/pkg/errors"
+)
+
+// the series are daily, so they are cached long regardless of the block time
+const seriesCacheTtl = time.Hour
+
+const (
+	tvlsCacheKey    = "adapter:tvls"
+	volumesCacheKey = "adapter:volumes"
+)
+
+// PriceSource returns the USD price of the price token in which the dashboard values are
+type PriceSource interface {
+	PriceInUsd(ctx context.Context, timestamp float64) (float64, error)
+}
+
+// Service serves the protocol level daily series of the whole history for the trackers, denominated in USD
+type Service interface {
+	Tvls(ctx context.Context) (*Tvls, error)
+	Volumes(ctx context.Context) ([]Point, error)
+}
+
+type adapterImpl struct {
+	dashboard dashboard.Dashboard
+	prices    PriceSource
+	cache     cache.Cache
+}
+
+// New returns an adapter Service, cache is optional
+func New(dashboard dashboard.Dashboard, prices PriceSource, cache cache.Cache) Service {
+	return &adapterImpl{dashboard, prices, cache}
+}
+
+// Tvls implements Service
+func (s *adapterImpl) Tvls(ctx context.Context) (*Tvls, error) {
+	ctx, span := tracing.Start(ctx, "adapter.Tvls")
+	defer span.End()
+	tvls := Tvls{}
+	if s.cached(tvlsCacheKey, &tvls) {
+		return &tvls, nil
+	}
+
+	series, err := s.dashboard.DailyTvls(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "adapter.Tvls")
+	}
+	tvls.Series = make([]Point, len(series))
+	for i, tvl := range series {
+		if tvls.Series[i], err = s.point(ctx, tvl.Tvl, tvl.Timestamp); err != nil {
+			return nil, errors.Wrap(err, "adapter.Tvls")
+		}
+	}
+
+	tokens, err := s.dashboard.Tokens(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "adapter.Tvls")
+	}
+	tvls.Timestamp = time.Now().UTC().Truncate(time.Second)
+	price, err := s.prices.PriceInUsd(ctx, float64(tvls.Timestamp.Unix()))
+	if err != nil {
+		return nil, errors.Wrap(err, "adapter.Tvls")
+	}
+	tvls.Tokens = make([]TokenTvl, 0, len(tokens))
+	for _, token := range tokens {
+		tvl, err := parseValue(token.Tvl)
+		if err != nil {
+			return nil, errors.Wrap(err, "adapter.Tvls")
+		}
+		if tvl > 0 {
+			tvls.Tokens = append(tvls.Tokens, TokenTvl{Token: string(token.Addr), TvlInUsd: tvl * price})
+		}
+	}
+	sort.SliceStable(tvls.Tokens, func(i, j int) bool { return tvls.Tokens[i].TvlInUsd > tvls.Tokens[j].TvlInUsd })
+
+	s.store(tvlsCacheKey, &tvls)
+	return &tvls, nil
+}
+
+// Volumes implements Service
+func (s *adapterImpl) Volumes(ctx context.Context) ([]Point, error) {
+	ctx, span := tracing.Start(ctx, "adapter.Volumes")
+	defer span.End()
+	volumes := []Point{}
+	if s.cached(volumesCacheKey, &volumes) {
+		return volumes, nil
+	}
+
+	series, err := s.dashboard.DailyVolumes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "adapter.Volumes")
+	}
+	volumes = make([]Point, len(series))
+	for i, volume := range series {
+		if volumes[i], err = s.point(ctx, volume.Volume, volume.Timestamp); err != nil {
+			return nil, errors.Wrap(err, "adapter.Volumes")
+		}
+	}
+
+	s.store(volumesCacheKey, &volumes)
+	return volumes, nil
+}
+
+func (s *adapterImpl) point(ctx context.Context, valueInPrice string, timestamp time.Time) (Point, error) {
+	value, err := parseValue(valueInPrice)
+	if err != nil {
+		return Point{}, err
+	}
+	price, err := s.prices.PriceInUsd(ctx, float64(timestamp.Unix()))
+	if err != nil {
+		return Point{}, err
+	}
+	return Point{Timestamp: timestamp, ValueInUsd: value * price}, nil
+}
+
+func (s *adapterImpl) cached(key string, dest interface{}) bool {
+	return s.cache != nil && s.cache.Get(key, dest) == nil
+}
+
+func (s *adapterImpl) store(key string, value interface{}) {
+	if s.cache != nil {
+		// a failure of caching must not fail the query
+		_ = s.cache.Set(key, value, seriesCacheTtl)
+	}
+}
+
+// parseValue parses a value of the dashboard, an empty value is zero
+func parseValue(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid value(%s)", value)
+	}
+	return parsed, nil
+}
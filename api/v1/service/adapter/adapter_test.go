@@ -0,0 +1,83 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dezswap/dezswap-api/api/v1/service/dashboard"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/dezswap/dezswap-api/pkg/cache/memory"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type dashboardMock struct {
+	dashboard.Dashboard
+	mock.Mock
+}
+
+func (m *dashboardMock) DailyTvls(_ context.Context) (dashboard.Tvls, error) {
+	args := m.Called()
+	tvls, _ := args.Get(0).(dashboard.Tvls)
+	return tvls, args.Error(1)
+}
+
+func (m *dashboardMock) DailyVolumes(_ context.Context) (dashboard.Volumes, error) {
+	args := m.Called()
+	volumes, _ := args.Get(0).(dashboard.Volumes)
+	return volumes, args.Error(1)
+}
+
+func (m *dashboardMock) Tokens(_ context.Context) (dashboard.Tokens, error) {
+	args := m.Called()
+	tokens, _ := args.Get(0).(dashboard.Tokens)
+	return tokens, args.Error(1)
+}
+
+type priceSourceMock struct {
+	mock.Mock
+}
+
+func (m *priceSourceMock) PriceInUsd(_ context.Context, timestamp float64) (float64, error) {
+	args := m.Called(timestamp)
+	return args.Get(0).(float64), args.Error(1)
+}
+
+func TestAdapter_Tvls(t *testing.T) {
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := &dashboardMock{}
+	d.On("DailyTvls").Return(dashboard.Tvls{{Tvl: "100", Timestamp: ts}, {Tvl: "", Timestamp: ts.AddDate(0, 0, 1)}}, nil).Once()
+	d.On("Tokens").Return(dashboard.Tokens{{Addr: "a", Tvl: "10"}, {Addr: "b", Tvl: "0"}, {Addr: "c", Tvl: "30"}}, nil).Once()
+	prices := &priceSourceMock{}
+	prices.On("PriceInUsd", mock.Anything).Return(0.5, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := New(d, prices, memory.NewMemoryCache(ctx, cache.NewByteCodec()))
+
+	tvls, err := s.Tvls(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []Point{{Timestamp: ts, ValueInUsd: 50}, {Timestamp: ts.AddDate(0, 0, 1), ValueInUsd: 0}}, tvls.Series)
+	require.Equal(t, []TokenTvl{{Token: "c", TvlInUsd: 15}, {Token: "a", TvlInUsd: 5}}, tvls.Tokens)
+
+	// the second call is served from the cache
+	cached, err := s.Tvls(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, tvls.Tokens, cached.Tokens)
+	require.Len(t, cached.Series, len(tvls.Series))
+	d.AssertExpectations(t)
+}
+
+func TestAdapter_Volumes(t *testing.T) {
+	ts := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := &dashboardMock{}
+	d.On("DailyVolumes").Return(dashboard.Volumes{{Volume: "12.5", Timestamp: ts}}, nil).Once()
+	prices := &priceSourceMock{}
+	prices.On("PriceInUsd", float64(ts.Unix())).Return(2.0, nil).Once()
+
+	volumes, err := New(d, prices, nil).Volumes(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, []Point{{Timestamp: ts, ValueInUsd: 25}}, volumes)
+}
@@ -0,0 +1,21 @@
+package adapter
+
+import "time"
+
+type Point struct {
+	Timestamp  time.Time
+	ValueInUsd float64
+}
+
+type TokenTvl struct {
+	Token    string
+	TvlInUsd float64
+}
+
+type Tvls struct {
+	Series []Point
+	// Tokens are the current TVLs by the token, the largest first
+	Tokens []TokenTvl
+	// Timestamp is when the token TVLs are valued
+	Timestamp time.Time
+}
@@ -11,6 +11,17 @@ type Ticker struct {
 	BaseLiquidityInPrice string
 	PoolId               string
 	Timestamp            float64
+	// BidAskSpreadPercentage and Depth are left empty when the depth is not served
+	BidAskSpreadPercentage string       `gorm:"-"`
+	Depth                  []DepthLevel `gorm:"-"`
+}
+
+// DepthLevel is the depth of the pool at the percentage in the token amounts with the decimals applied
+type DepthLevel struct {
+	Percentage   string
+	BaseAmount   string
+	TargetAmount string
+	UsdAmount    string
 }
 
 type Pair struct {
@@ -19,3 +30,37 @@ type Pair struct {
 	Target   string
 	PoolId   string
 }
+
+type TradeType string
+
+const (
+	TradeTypeBuy  TradeType = "buy"
+	TradeTypeSell TradeType = "sell"
+)
+
+// Trade is a swap of the pair, buy when the base is returned from the pool and sell when it is offered
+type Trade struct {
+	TradeId uint64
+	// Price is the target per base with the decimals applied
+	Price        string
+	BaseVolume   string
+	TargetVolume string
+	// TradeTimestamp is in milliseconds
+	TradeTimestamp int64
+	Type           TradeType
+}
+
+type Orderbook struct {
+	TickerId string
+	// Timestamp is in milliseconds
+	Timestamp int64
+	// Bids and Asks are ordered from the mid price outwards
+	Bids []Order
+	Asks []Order
+}
+
+// Order is a slice of the constant product curve, the base amount at the average price including the commission
+type Order struct {
+	Price  string
+	Amount string
+}
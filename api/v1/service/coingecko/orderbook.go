@@ -0,0 +1,127 @@
+package coingecko
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	cmath "cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/dezswap/dezswap-api/api/v1/service/depth"
+	"github.com/dezswap/dezswap-api/pkg/dezswap"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+var ErrTickerNotFound = errors.New("ticker not found")
+
+type OrderbookService interface {
+	// Orderbook returns the orders synthesized from the constant product curve of the pool,
+	// depth is the number of the orders split evenly on both sides, rounded up on each, and 0 returns every level
+	Orderbook(ctx context.Context, tickerId string, depth int) (*Orderbook, error)
+}
+
+type orderbookService struct {
+	chainId string
+	*gorm.DB
+	depth          depth.Service
+	commissionRate cmath.LegacyDec
+}
+
+type tickerPair struct {
+	Contract       string
+	BaseDecimals   int
+	TargetDecimals int
+}
+
+// NewOrderbookService returns the orderbook of which levels are the percentages of depthService
+func NewOrderbookService(chainId string, db *gorm.DB, depthService depth.Service) OrderbookService {
+	return &orderbookService{chainId, db, depthService, cmath.LegacyMustNewDecFromStr(strconv.FormatFloat(dezswap.SWAP_FEE, 'f', -1, 64))}
+}
+
+// Orderbook implements OrderbookService
+func (s *orderbookService) Orderbook(ctx context.Context, tickerId string, orderDepth int) (*Orderbook, error) {
+	ctx, span := tracing.Start(ctx, "orderbookService.Orderbook")
+	defer span.End()
+	base, target, err := parseTickerId(tickerId)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := []tickerPair{}
+	if err := s.WithContext(ctx).Table("pair AS p").
+		Joins("JOIN tokens AS t0 ON t0.chain_id = p.chain_id AND t0.address = p.asset0").
+		Joins("JOIN tokens AS t1 ON t1.chain_id = p.chain_id AND t1.address = p.asset1").
+		Where("p.chain_id = ? AND p.asset0 = ? AND p.asset1 = ?", s.chainId, base, target).
+		Select("p.contract, t0.decimals base_decimals, t1.decimals target_decimals").
+		Limit(1).
+		Scan(&pairs).Error; err != nil {
+		return nil, errors.Wrap(err, "orderbookService.Orderbook")
+	}
+	if len(pairs) == 0 {
+		return nil, ErrTickerNotFound
+	}
+	pair := pairs[0]
+
+	d, err := s.depth.Depth(ctx, pair.Contract)
+	if err != nil {
+		if errors.Is(err, depth.ErrPoolNotFound) {
+			return nil, ErrTickerNotFound
+		}
+		return nil, errors.Wrap(err, "orderbookService.Orderbook")
+	}
+
+	// the levels are ordered by the percentage, the bid levels are reversed to be ordered outwards from the mid price
+	bidLevels := []depth.Level{}
+	askLevels := []depth.Level{}
+	for i := len(d.Levels) - 1; i >= 0; i-- {
+		if d.Levels[i].Percentage.IsNegative() {
+			bidLevels = append(bidLevels, d.Levels[i])
+		}
+	}
+	for _, level := range d.Levels {
+		if level.Percentage.IsPositive() {
+			askLevels = append(askLevels, level)
+		}
+	}
+
+	feeRatio := cmath.LegacyOneDec().Sub(s.commissionRate)
+	bids := toOrders(bidLevels, feeRatio, pair)
+	asks := toOrders(askLevels, cmath.LegacyOneDec().Quo(feeRatio), pair)
+	if orderDepth > 0 {
+		// an odd depth rounds up the levels of a side, a depth of 1 has a level on each
+		side := (orderDepth + 1) / 2
+		bids = bids[:min(side, len(bids))]
+		asks = asks[:min(side, len(asks))]
+	}
+
+	return &Orderbook{
+		TickerId:  tickerId,
+		Timestamp: time.Now().UnixMilli(),
+		Bids:      bids,
+		Asks:      asks,
+	}, nil
+}
+
+// toOrders slices the curve between the cumulative levels,
+// the price of each slice is the average price of the slice adjusted by the commission
+func toOrders(levels []depth.Level, commissionRatio cmath.LegacyDec, pair tickerPair) []Order {
+	orders := []Order{}
+	prevBase, prevTarget := cmath.ZeroInt(), cmath.ZeroInt()
+	for _, level := range levels {
+		baseAmount := level.Asset0Amount.Sub(prevBase)
+		targetAmount := level.Asset1Amount.Sub(prevTarget)
+		prevBase, prevTarget = level.Asset0Amount, level.Asset1Amount
+		if !baseAmount.IsPositive() {
+			continue
+		}
+
+		base := cmath.LegacyNewDecFromIntWithPrec(baseAmount, int64(pair.BaseDecimals))
+		target := cmath.LegacyNewDecFromIntWithPrec(targetAmount, int64(pair.TargetDecimals))
+		orders = append(orders, Order{
+			Price:  target.Quo(base).Mul(commissionRatio).String(),
+			Amount: base.String(),
+		})
+	}
+	return orders
+}
@@ -0,0 +1,76 @@
+package coingecko
+
+import (
+	"context"
+	"testing"
+
+	cmath "cosmossdk.io/math"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/dezswap/dezswap-api/api/v1/service/depth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type depthServiceMock struct {
+	depth.Service
+	depth *depth.Depth
+}
+
+func (m *depthServiceMock) Depth(_ context.Context, _ string) (*depth.Depth, error) {
+	return m.depth, nil
+}
+
+func TestToOrders(t *testing.T) {
+	levels := []depth.Level{
+		{Percentage: cmath.LegacyNewDec(1), Asset0Amount: cmath.NewInt(1_000_000), Asset1Amount: cmath.NewInt(2_000_000)},
+		// an empty slice of the curve is skipped
+		{Percentage: cmath.LegacyNewDec(2), Asset0Amount: cmath.NewInt(1_000_000), Asset1Amount: cmath.NewInt(2_000_000)},
+		{Percentage: cmath.LegacyNewDec(5), Asset0Amount: cmath.NewInt(3_000_000), Asset1Amount: cmath.NewInt(7_000_000)},
+	}
+
+	orders := toOrders(levels, cmath.LegacyNewDec(2), tickerPair{BaseDecimals: 6, TargetDecimals: 3})
+
+	assert.Equal(t, []Order{
+		{Price: "4000.000000000000000000", Amount: "1.000000000000000000"},
+		{Price: "5000.000000000000000000", Amount: "2.000000000000000000"},
+	}, orders)
+}
+
+func TestOrderbook_Depth(t *testing.T) {
+	levels := []depth.Level{}
+	for _, p := range []int64{-5, -2, -1, 1, 2, 5} {
+		amount := cmath.NewInt(1_000_000 * max(p, -p))
+		levels = append(levels, depth.Level{Percentage: cmath.LegacyNewDec(p), Asset0Amount: amount, Asset1Amount: amount})
+	}
+
+	tcs := []struct {
+		depth int
+		side  int
+	}{
+		{0, 3},
+		{1, 1},
+		{2, 1},
+		{3, 2},
+		{4, 2},
+		{100, 3},
+	}
+	for _, tc := range tcs {
+		sqlDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+		require.NoError(t, err)
+		mock.ExpectQuery(`FROM pair AS p`).WithArgs("test-chain", "base", "target").
+			WillReturnRows(sqlmock.NewRows([]string{"contract", "base_decimals", "target_decimals"}).AddRow("pair", 6, 6))
+		s := NewOrderbookService("test-chain", gormDB, &depthServiceMock{depth: &depth.Depth{Pair: "pair", Levels: levels}})
+
+		orderbook, err := s.Orderbook(context.Background(), "base_target", tc.depth)
+
+		require.NoError(t, err)
+		assert.Len(t, orderbook.Bids, tc.side, "depth(%d)", tc.depth)
+		assert.Len(t, orderbook.Asks, tc.side, "depth(%d)", tc.depth)
+		require.NoError(t, mock.ExpectationsWereMet())
+		sqlDB.Close()
+	}
+}
@@ -1,6 +1,9 @@
 package coingecko
 
 import (
+	"context"
+
+	"github.com/dezswap/dezswap-api/api/tracing"
 	"github.com/dezswap/dezswap-api/api/v1/service"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -16,10 +19,12 @@ func NewPairService(chainId string, db *gorm.DB) service.Getter[Pair] {
 }
 
 // Get implements Getter
-func (s *pairService) Get(key string) (*Pair, error) {
+func (s *pairService) Get(ctx context.Context, key string) (*Pair, error) {
+	ctx, span := tracing.Start(ctx, "pairService.Get")
+	defer span.End()
 	pair := &Pair{}
 
-	if tx := s.Table("pair").Where("chain_id = ? and contract = ?", s.chainId, key).Select(
+	if tx := s.WithContext(ctx).Table("pair").Where("chain_id = ? and contract = ?", s.chainId, key).Select(
 		"concat(asset0, '_', asset1) ticker_id," +
 			"asset0 base," +
 			"asset1 target," +
@@ -32,10 +37,12 @@ func (s *pairService) Get(key string) (*Pair, error) {
 }
 
 // GetAll implements Getter
-func (s *pairService) GetAll() ([]Pair, error) {
+func (s *pairService) GetAll(ctx context.Context) ([]Pair, error) {
+	ctx, span := tracing.Start(ctx, "pairService.GetAll")
+	defer span.End()
 	pairs := []Pair{}
 
-	if tx := s.Table("pair").Where("chain_id = ?", s.chainId).Select(
+	if tx := s.WithContext(ctx).Table("pair").Where("chain_id = ?", s.chainId).Select(
 		"concat(asset0, '_', asset1) ticker_id," +
 			"asset0 base," +
 			"asset1 target," +
@@ -12,7 +12,10 @@ import (
 	"time"
 
 	cmath "cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/metrics"
+	"github.com/dezswap/dezswap-api/api/tracing"
 	"github.com/dezswap/dezswap-api/api/v1/service"
+	"github.com/dezswap/dezswap-api/api/v1/service/depth"
 	"github.com/dezswap/dezswap-api/pkg"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -25,6 +28,9 @@ const coinGeckoEndpoint = "https://api.coingecko.com/api/v3/coins/"
 const queryTimeout = 10 * time.Second
 const priceCacheTTL = 24 * time.Hour
 
+// defaultClient fetches the prices when the service has no client of its own
+var defaultClient = &http.Client{Transport: tracing.NewTransport(http.DefaultTransport)}
+
 type priceInfo int
 
 const (
@@ -33,20 +39,33 @@ const (
 	priceInfoLength
 )
 
+// TickerService serves the tickers and the USD price of the price token which values them
+type TickerService interface {
+	service.Getter[Ticker]
+	// PriceInUsd returns the USD price of the price token at the timestamp in seconds,
+	// the timestamps before the cached prices take the earliest cached one
+	PriceInUsd(ctx context.Context, timestamp float64) (float64, error)
+	// LastPriceFetch is when the prices were fetched from CoinGecko last, zero when they never were
+	LastPriceFetch() time.Time
+}
+
 type tickerService struct {
 	chainId string
 	*gorm.DB
 	mu           sync.RWMutex
 	cachedPrices [][priceInfoLength]float64
 	cacheExpiry  time.Time
+	lastFetch    time.Time
 	sfGroup      singleflight.Group
 	httpClient   *http.Client
 	endpoint     string
 	apiKey       string
+	depth        depth.Service
 }
 
-func NewTickerService(chainId string, db *gorm.DB, apiKey string) service.Getter[Ticker] {
-	s := &tickerService{chainId: chainId, DB: db, endpoint: coinGeckoEndpoint, apiKey: apiKey}
+// NewTickerService returns the ticker service, the tickers have no depth when depthService is nil
+func NewTickerService(chainId string, db *gorm.DB, apiKey string, depthService depth.Service) TickerService {
+	s := &tickerService{chainId: chainId, DB: db, endpoint: coinGeckoEndpoint, apiKey: apiKey, depth: depthService}
 	if apiKey == "" {
 		s.cachedPrices = [][priceInfoLength]float64{{0, 1.0}, {math.MaxFloat64, 1.0}}
 		s.cacheExpiry = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -55,13 +74,15 @@ func NewTickerService(chainId string, db *gorm.DB, apiKey string) service.Getter
 }
 
 // Get implements Getter
-func (s *tickerService) Get(key string) (*Ticker, error) {
+func (s *tickerService) Get(ctx context.Context, key string) (*Ticker, error) {
+	ctx, span := tracing.Start(ctx, "tickerService.Get")
+	defer span.End()
 	tokens := strings.Split(key, "_")
 	if len(tokens) < 2 {
 		return nil, errors.New("unable to parse ticker: " + key)
 	}
 
-	tickers, err := s.tickers(" and p.asset0 = ? and p.asset1 = ?", tokens...)
+	tickers, err := s.tickers(ctx, " and p.asset0 = ? and p.asset1 = ?", tokens...)
 	if err != nil {
 		return nil, errors.Wrap(err, "tickerService.Get")
 	}
@@ -70,14 +91,14 @@ func (s *tickerService) Get(key string) (*Ticker, error) {
 	if len(tickers) > 0 {
 		ticker = &tickers[len(tickers)-1]
 		if ticker.LastPrice == "" {
-			price, err := s.lastSwapPriceFromInactive(ticker.PoolId)
+			price, err := s.lastSwapPriceFromInactive(ctx, ticker.PoolId)
 			if err != nil {
 				return nil, errors.Wrap(err, "tickerService.Get")
 			}
 			ticker.LastPrice = price
 		}
 	} else {
-		err := s.liquidity(tokens[0], tokens[1], ticker)
+		err := s.liquidity(ctx, tokens[0], tokens[1], ticker)
 		if err != nil {
 			return nil, errors.Wrap(err, "tickerService.Get")
 		}
@@ -85,7 +106,7 @@ func (s *tickerService) Get(key string) (*Ticker, error) {
 
 	if p := s.price(ticker.Timestamp, false); p == 0 {
 		if _, err, _ = s.sfGroup.Do(priceTokenId, func() (any, error) {
-			return nil, s.cachePriceInUsd(priceTokenId)
+			return nil, s.cachePriceInUsd(ctx, priceTokenId)
 		}); err != nil {
 			return nil, err
 		}
@@ -97,13 +118,44 @@ func (s *tickerService) Get(key string) (*Ticker, error) {
 	}
 	ticker.BaseLiquidityInPrice = baseLiquidityInUsd
 
+	if s.depth != nil && ticker.PoolId != "" {
+		d, err := s.depth.Depth(ctx, ticker.PoolId)
+		if err != nil && !errors.Is(err, depth.ErrPoolNotFound) {
+			return nil, errors.Wrap(err, "tickerService.Get")
+		}
+		if d != nil {
+			s.setDepth(ticker, *d)
+		}
+	}
+
 	return ticker, nil
 }
 
+// PriceInUsd implements TickerService
+func (s *tickerService) PriceInUsd(ctx context.Context, timestamp float64) (float64, error) {
+	ctx, span := tracing.Start(ctx, "tickerService.PriceInUsd")
+	defer span.End()
+	if _, err, _ := s.sfGroup.Do(priceTokenId, func() (any, error) {
+		return nil, s.cachePriceInUsd(ctx, priceTokenId)
+	}); err != nil {
+		return 0, errors.Wrap(err, "tickerService.PriceInUsd")
+	}
+	if p := s.price(timestamp, true); p != 0 {
+		return p, nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(s.cachedPrices) == 0 {
+		return 0, errors.New("tickerService.PriceInUsd: no cached price")
+	}
+	return s.cachedPrices[0][priceValue], nil
+}
+
 // lastSwapPriceFromInactive returns the most recent swap price
 // for a pool that has no activity in the recent window.
-func (s *tickerService) lastSwapPriceFromInactive(poolId string) (string, error) {
-	inactiveTickers, err := s.inactivePool(poolId)
+func (s *tickerService) lastSwapPriceFromInactive(ctx context.Context, poolId string) (string, error) {
+	inactiveTickers, err := s.inactivePool(ctx, poolId)
 	if err != nil {
 		return "", err
 	}
@@ -114,7 +166,7 @@ func (s *tickerService) lastSwapPriceFromInactive(poolId string) (string, error)
 	return inactiveTickers[0].LastPrice, nil
 }
 
-func (s *tickerService) liquidity(base string, target string, ticker *Ticker) error {
+func (s *tickerService) liquidity(ctx context.Context, base string, target string, ticker *Ticker) error {
 	query := `
 select p.asset0 base_currency,
        p.asset1 target_currency,
@@ -135,7 +187,7 @@ from pair_stats_30m ps
 	join tokens t1 on p.chain_id = t1.chain_id and p.asset1 = t1.address
 where p.chain_id = ? and p.asset0 = ? and p.asset1 = ?
 `
-	if tx := s.Raw(query, s.chainId, base, target).Find(&ticker); tx.Error != nil {
+	if tx := s.WithContext(ctx).Raw(query, s.chainId, base, target).Find(&ticker); tx.Error != nil {
 		return errors.Wrap(tx.Error, "TickerService.liquidity")
 	}
 
@@ -143,8 +195,10 @@ where p.chain_id = ? and p.asset0 = ? and p.asset1 = ?
 }
 
 // GetAll implements Getter
-func (s *tickerService) GetAll() ([]Ticker, error) {
-	tickers, err := s.tickers("")
+func (s *tickerService) GetAll(ctx context.Context) ([]Ticker, error) {
+	ctx, span := tracing.Start(ctx, "tickerService.GetAll")
+	defer span.End()
+	tickers, err := s.tickers(ctx, "")
 	if err != nil {
 		return nil, errors.Wrap(err, "tickerService.GetAll")
 	}
@@ -164,7 +218,7 @@ func (s *tickerService) GetAll() ([]Ticker, error) {
 		}
 	}
 
-	inactiveTickers, err := s.inactivePools(activePoolIds)
+	inactiveTickers, err := s.inactivePools(ctx, activePoolIds)
 	if err != nil {
 		return nil, errors.Wrap(err, "tickerService.GetAll")
 	}
@@ -186,7 +240,7 @@ func (s *tickerService) GetAll() ([]Ticker, error) {
 
 	if p := s.price(latestTs, false); p == 0 {
 		if _, err, _ = s.sfGroup.Do(priceTokenId, func() (any, error) {
-			return nil, s.cachePriceInUsd(priceTokenId)
+			return nil, s.cachePriceInUsd(ctx, priceTokenId)
 		}); err != nil {
 			return nil, err
 		}
@@ -200,10 +254,39 @@ func (s *tickerService) GetAll() ([]Ticker, error) {
 		tickers[i].BaseLiquidityInPrice = baseLiquidityInUsd
 	}
 
+	if s.depth != nil {
+		depths, err := s.depth.Depths(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "tickerService.GetAll")
+		}
+		for i, t := range tickers {
+			if d, ok := depths[t.PoolId]; ok {
+				s.setDepth(&tickers[i], d)
+			}
+		}
+	}
+
 	return tickers, nil
 }
 
-func (s *tickerService) tickers(cond string, bindings ...string) ([]Ticker, error) {
+// setDepth applies the decimals to the depth amounts and converts their values in the price token to USD
+func (s *tickerService) setDepth(ticker *Ticker, d depth.Depth) {
+	priceTokenInUsd := s.price(ticker.Timestamp, true)
+
+	ticker.BidAskSpreadPercentage = d.SpreadPercentage.String()
+	ticker.Depth = make([]DepthLevel, len(d.Levels))
+	for i, level := range d.Levels {
+		valueInPrice, _ := level.ValueInPrice.Float64()
+		ticker.Depth[i] = DepthLevel{
+			Percentage:   level.Percentage.String(),
+			BaseAmount:   cmath.LegacyNewDecFromIntWithPrec(level.Asset0Amount, int64(ticker.BaseDecimals)).String(),
+			TargetAmount: cmath.LegacyNewDecFromIntWithPrec(level.Asset1Amount, int64(ticker.TargetDecimals)).String(),
+			UsdAmount:    strconv.FormatFloat(valueInPrice*priceTokenInUsd, 'f', -1, 64),
+		}
+	}
+}
+
+func (s *tickerService) tickers(ctx context.Context, cond string, bindings ...string) ([]Ticker, error) {
 	query := `
 select distinct
     p.asset0 base_currency,
@@ -229,11 +312,11 @@ where ps.chain_id = ?
 		for i, v := range bindings {
 			b[i+1] = v
 		}
-		if tx := s.Raw(query+cond, b...).Find(&tickers); tx.Error != nil {
+		if tx := s.WithContext(ctx).Raw(query+cond, b...).Find(&tickers); tx.Error != nil {
 			return nil, errors.Wrap(tx.Error, "tickerService.tickers")
 		}
 	} else {
-		if tx := s.Raw(query, s.chainId).Find(&tickers); tx.Error != nil {
+		if tx := s.WithContext(ctx).Raw(query, s.chainId).Find(&tickers); tx.Error != nil {
 			return nil, errors.Wrap(tx.Error, "tickerService.tickers")
 		}
 	}
@@ -266,20 +349,20 @@ where ps.chain_id = ?
 }
 
 // inactivePool returns ticker data for a single inactive pool by contract address.
-func (s *tickerService) inactivePool(contractId string) ([]Ticker, error) {
-	return s.queryInactivePools(" and p.contract = ?", contractId)
+func (s *tickerService) inactivePool(ctx context.Context, contractId string) ([]Ticker, error) {
+	return s.queryInactivePools(ctx, " and p.contract = ?", contractId)
 }
 
 // inactivePools returns ticker data for all inactive pools, excluding the given pool IDs.
-func (s *tickerService) inactivePools(excludePoolIds []string) ([]Ticker, error) {
+func (s *tickerService) inactivePools(ctx context.Context, excludePoolIds []string) ([]Ticker, error) {
 	if len(excludePoolIds) > 0 {
-		return s.queryInactivePools(" and p.contract not in ?", excludePoolIds)
+		return s.queryInactivePools(ctx, " and p.contract not in ?", excludePoolIds)
 	}
-	return s.queryInactivePools("", nil)
+	return s.queryInactivePools(ctx, "", nil)
 }
 
 // queryInactivePools runs the pair_stats_30m base query with an optional extra condition and arg.
-func (s *tickerService) queryInactivePools(cond string, arg any) ([]Ticker, error) {
+func (s *tickerService) queryInactivePools(ctx context.Context, cond string, arg any) ([]Ticker, error) {
 	const query = `
 select p.asset0 base_currency,
        p.asset1 target_currency,
@@ -303,9 +386,9 @@ where p.chain_id = ?
 	var tickers []Ticker
 	var tx *gorm.DB
 	if cond != "" {
-		tx = s.Raw(query+cond, s.chainId, arg).Find(&tickers)
+		tx = s.WithContext(ctx).Raw(query+cond, s.chainId, arg).Find(&tickers)
 	} else {
-		tx = s.Raw(query, s.chainId).Find(&tickers)
+		tx = s.WithContext(ctx).Raw(query, s.chainId).Find(&tickers)
 	}
 	if tx.Error != nil {
 		return nil, errors.Wrap(tx.Error, "TickerService.queryInactivePools")
@@ -327,7 +410,7 @@ func (s *tickerService) liquidityInUsd(ticker Ticker) (string, error) {
 // cachePriceInUsd fetches the 24-hour price history of priceCoinId from the
 // CoinGecko market_chart endpoint and stores it in cachedPrices. It is a
 // no-op if the cache has not yet expired.
-func (s *tickerService) cachePriceInUsd(priceCoinId string) error {
+func (s *tickerService) cachePriceInUsd(ctx context.Context, priceCoinId string) error {
 	s.mu.RLock()
 	expiry := s.cacheExpiry
 	s.mu.RUnlock()
@@ -335,7 +418,13 @@ func (s *tickerService) cachePriceInUsd(priceCoinId string) error {
 		return nil // still within TTL
 	}
 
-	timeoutCtx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	result := metrics.FetchError
+	defer func(start time.Time) {
+		metrics.ObserveCoinGeckoFetch(result, time.Since(start))
+	}(time.Now())
+
+	// the callers share the fetch, it is traced under the first caller but not canceled by it
+	timeoutCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), queryTimeout)
 	defer cancel()
 
 	// TODO: fixed endpoint and arguments
@@ -357,7 +446,7 @@ func (s *tickerService) cachePriceInUsd(priceCoinId string) error {
 
 	client := s.httpClient
 	if client == nil {
-		client = http.DefaultClient
+		client = defaultClient
 	}
 	response, err := client.Do(request)
 	if err != nil {
@@ -366,6 +455,7 @@ func (s *tickerService) cachePriceInUsd(priceCoinId string) error {
 	defer response.Body.Close()
 
 	if response.StatusCode != http.StatusOK {
+		result = metrics.FetchStatusError
 		code := response.StatusCode
 		return errors.New(strings.Join([]string{"Price endpoint returns http code [", http.StatusText(code), " ", strconv.Itoa(code), "]"}, ""))
 	}
@@ -379,17 +469,27 @@ func (s *tickerService) cachePriceInUsd(priceCoinId string) error {
 	decoder := json.NewDecoder(response.Body)
 	err = decoder.Decode(&decoded)
 	if err != nil {
+		result = metrics.FetchDecodeError
 		return err
 	}
+	result = metrics.FetchSuccess
 
 	s.mu.Lock()
 	s.cachedPrices = decoded.Prices
-	s.cacheExpiry = time.Now().Add(priceCacheTTL)
+	s.lastFetch = time.Now()
+	s.cacheExpiry = s.lastFetch.Add(priceCacheTTL)
 	s.mu.Unlock()
 
 	return nil
 }
 
+// LastPriceFetch implements TickerService
+func (s *tickerService) LastPriceFetch() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastFetch
+}
+
 // price returns the USD price of the price token at the given timestamp.
 // force=true returns the last seen price while force=false returns 0
 // (signalling the caller to trigger a cache refresh).
@@ -1,6 +1,7 @@
 package coingecko
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -34,7 +35,7 @@ func TestSingleflightDeduplicatesCoinGeckoRequests(t *testing.T) {
 		go func() {
 			defer wg.Done()
 			s.sfGroup.Do(priceTokenId, func() (any, error) { //nolint:errcheck
-				return nil, s.cachePriceInUsd(priceTokenId)
+				return nil, s.cachePriceInUsd(context.Background(), priceTokenId)
 			})
 		}()
 	}
@@ -60,13 +61,13 @@ func TestSingleflightSecondBatchHitsTTL(t *testing.T) {
 
 	// first call — populates cache and sets cacheExpiry
 	_, err, _ := s.sfGroup.Do(priceTokenId, func() (any, error) {
-		return nil, s.cachePriceInUsd(priceTokenId)
+		return nil, s.cachePriceInUsd(context.Background(), priceTokenId)
 	})
 	assert.NoError(t, err)
 
 	// second call within TTL — should be a cache hit, no new HTTP request
 	_, err, _ = s.sfGroup.Do(priceTokenId, func() (any, error) {
-		return nil, s.cachePriceInUsd(priceTokenId)
+		return nil, s.cachePriceInUsd(context.Background(), priceTokenId)
 	})
 	assert.NoError(t, err)
 
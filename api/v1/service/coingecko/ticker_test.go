@@ -1,6 +1,7 @@
 package coingecko
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"net/http"
@@ -9,7 +10,9 @@ import (
 	"testing"
 	"time"
 
+	cmath "cosmossdk.io/math"
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/dezswap/dezswap-api/api/v1/service/depth"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gorm.io/driver/postgres"
@@ -56,7 +59,7 @@ func TestQueryInactivePools_ByContractId(t *testing.T) {
 				AddRow("tokenA", "tokenB", "0", "0", "1.5", 6, 6, "100.0", "xpla1pool123", 1000000.0),
 		)
 
-	tickers, err := svc.inactivePool("xpla1pool123")
+	tickers, err := svc.inactivePool(context.Background(), "xpla1pool123")
 	require.NoError(t, err)
 	require.Len(t, tickers, 1)
 	require.Equal(t, "xpla1pool123", tickers[0].PoolId)
@@ -77,7 +80,7 @@ func TestQueryInactivePools_WithExclusion(t *testing.T) {
 				AddRow("tokenA", "tokenB", "0", "0", "2.0", 6, 6, "200.0", "xpla1inactive", 1000000.0),
 		)
 
-	tickers, err := svc.inactivePools([]string{"xpla1active1", "xpla1active2"})
+	tickers, err := svc.inactivePools(context.Background(), []string{"xpla1active1", "xpla1active2"})
 	require.NoError(t, err)
 	require.Len(t, tickers, 1)
 	require.Equal(t, "xpla1inactive", tickers[0].PoolId)
@@ -94,7 +97,7 @@ func TestQueryInactivePools_NoFilter(t *testing.T) {
 		WithArgs("test-chain").
 		WillReturnRows(sqlmock.NewRows(inactivePoolColumns))
 
-	tickers, err := svc.inactivePools(nil)
+	tickers, err := svc.inactivePools(context.Background(), nil)
 	require.NoError(t, err)
 	require.Empty(t, tickers)
 	require.NoError(t, mock.ExpectationsWereMet())
@@ -114,9 +117,13 @@ func TestCachePriceInUsdRefetchesAfterTTL(t *testing.T) {
 
 	s := &tickerService{httpClient: srv.Client(), endpoint: srv.URL + "/", apiKey: "test-key"}
 
+	assert.True(t, s.LastPriceFetch().IsZero())
+
 	// first call
-	assert.NoError(t, s.cachePriceInUsd(priceTokenId))
+	assert.NoError(t, s.cachePriceInUsd(context.Background(), priceTokenId))
 	assert.Equal(t, int32(1), callCount.Load())
+	firstFetch := s.LastPriceFetch()
+	assert.False(t, firstFetch.IsZero())
 
 	// simulate TTL expiry
 	s.mu.Lock()
@@ -124,8 +131,9 @@ func TestCachePriceInUsdRefetchesAfterTTL(t *testing.T) {
 	s.mu.Unlock()
 
 	// second call after TTL — should re-fetch
-	assert.NoError(t, s.cachePriceInUsd(priceTokenId))
+	assert.NoError(t, s.cachePriceInUsd(context.Background(), priceTokenId))
 	assert.Equal(t, int32(2), callCount.Load(), "call after TTL expiry should re-fetch")
+	assert.False(t, s.LastPriceFetch().Before(firstFetch))
 }
 
 // TestNoApiKeyReturnsOnePrice verifies that without an API key no HTTP request
@@ -139,13 +147,14 @@ func TestNoApiKeyReturnsOnePrice(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	s := NewTickerService("", nil, "").(*tickerService)
+	s := NewTickerService("", nil, "", nil).(*tickerService)
 	s.httpClient = srv.Client()
 	s.endpoint = srv.URL + "/"
 
-	assert.NoError(t, s.cachePriceInUsd(priceTokenId))
+	assert.NoError(t, s.cachePriceInUsd(context.Background(), priceTokenId))
 	assert.Equal(t, int32(0), callCount.Load(), "no HTTP request should be made without an API key")
 	assert.Equal(t, 1.0, s.price(3_000_000, true), "price should be 1.0 when no API key is set")
+	assert.True(t, s.LastPriceFetch().IsZero(), "prices are never fetched without an API key")
 	assert.Equal(t, 1.0, s.price(3_000_000, false), "price(ts, false) should return 1.0 — trigger block must be skipped")
 }
 
@@ -198,3 +207,42 @@ func TestPrice(t *testing.T) {
 		})
 	}
 }
+
+// TestPriceInUsd verifies that timestamps before the cached prices take the earliest one.
+func TestPriceInUsd(t *testing.T) {
+	s := &tickerService{
+		cachedPrices: [][priceInfoLength]float64{{1_000_000, 1.0}, {2_000_000, 2.0}},
+		cacheExpiry:  time.Now().Add(time.Hour),
+	}
+
+	price, err := s.PriceInUsd(context.Background(), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, price)
+
+	price, err = s.PriceInUsd(context.Background(), 2_500)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.0, price)
+}
+
+func TestSetDepth(t *testing.T) {
+	s := &tickerService{cachedPrices: [][priceInfoLength]float64{{0, 0.5}}}
+	ticker := &Ticker{BaseDecimals: 6, TargetDecimals: 18, Timestamp: 1_000}
+
+	s.setDepth(ticker, depth.Depth{
+		SpreadPercentage: cmath.LegacyMustNewDecFromStr("0.5991"),
+		Levels: []depth.Level{{
+			Percentage:   cmath.LegacyNewDec(2),
+			Asset0Amount: cmath.NewInt(9_852_000),
+			Asset1Amount: cmath.NewIntWithDecimal(39_801, 15),
+			ValueInPrice: cmath.LegacyMustNewDecFromStr("39.8"),
+		}},
+	})
+
+	assert.Equal(t, "0.599100000000000000", ticker.BidAskSpreadPercentage)
+	assert.Equal(t, []DepthLevel{{
+		Percentage:   "2.000000000000000000",
+		BaseAmount:   "9.852000000000000000",
+		TargetAmount: "39.801000000000000000",
+		UsdAmount:    "19.9",
+	}}, ticker.Depth)
+}
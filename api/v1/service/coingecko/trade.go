@@ -0,0 +1,130 @@
+package coingecko
+
+import (
+	"context"
+	"strings"
+
+	cmath "cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MaxTradesLimit caps the trades returned at once, a limit of 0 which is the full history is capped as well
+const MaxTradesLimit = 1000
+
+var ErrInvalidTickerId = errors.New("invalid ticker id")
+
+type TradeService interface {
+	// HistoricalTrades returns the latest swaps of the ticker first, both types when tradeType is empty.
+	// startTime and endTime are in milliseconds and ignored when 0.
+	HistoricalTrades(ctx context.Context, tickerId string, tradeType TradeType, limit int, startTime, endTime int64) ([]Trade, error)
+}
+
+type tradeService struct {
+	chainId string
+	*gorm.DB
+}
+
+type swapTx struct {
+	Id             uint64
+	Asset0Amount   string
+	Asset1Amount   string
+	Timestamp      float64
+	BaseDecimals   int
+	TargetDecimals int
+}
+
+func NewTradeService(chainId string, db *gorm.DB) TradeService {
+	return &tradeService{chainId, db}
+}
+
+// HistoricalTrades implements TradeService
+func (s *tradeService) HistoricalTrades(ctx context.Context, tickerId string, tradeType TradeType, limit int, startTime, endTime int64) ([]Trade, error) {
+	ctx, span := tracing.Start(ctx, "tradeService.HistoricalTrades")
+	defer span.End()
+	base, target, err := parseTickerId(tickerId)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > MaxTradesLimit {
+		limit = MaxTradesLimit
+	}
+
+	query := s.WithContext(ctx).Table("parsed_tx AS pt").
+		Joins("JOIN pair AS p ON p.chain_id = pt.chain_id AND p.contract = pt.contract").
+		Joins("JOIN tokens AS t0 ON t0.chain_id = p.chain_id AND t0.address = p.asset0").
+		Joins("JOIN tokens AS t1 ON t1.chain_id = p.chain_id AND t1.address = p.asset1").
+		Where("pt.chain_id = ? AND pt.type = 'swap' AND p.asset0 = ? AND p.asset1 = ?", s.chainId, base, target)
+	// the amounts are signed from the pool, the returned asset is negative
+	switch tradeType {
+	case TradeTypeBuy:
+		query = query.Where("pt.asset0_amount < 0")
+	case TradeTypeSell:
+		query = query.Where("pt.asset0_amount > 0")
+	}
+	if startTime > 0 {
+		query = query.Where("pt.timestamp >= ?", float64(startTime)/1_000)
+	}
+	if endTime > 0 {
+		query = query.Where("pt.timestamp <= ?", float64(endTime)/1_000)
+	}
+
+	txs := []swapTx{}
+	if err := query.Select("pt.id, pt.asset0_amount::text, pt.asset1_amount::text, pt.timestamp, t0.decimals base_decimals, t1.decimals target_decimals").
+		Order("pt.timestamp DESC, pt.id DESC").
+		Limit(limit).
+		Scan(&txs).Error; err != nil {
+		return nil, errors.Wrap(err, "tradeService.HistoricalTrades")
+	}
+
+	trades := make([]Trade, 0, len(txs))
+	for _, tx := range txs {
+		trade, err := swapToTrade(tx)
+		if err != nil {
+			return nil, errors.Wrap(err, "tradeService.HistoricalTrades")
+		}
+		trades = append(trades, trade)
+	}
+	return trades, nil
+}
+
+func swapToTrade(tx swapTx) (Trade, error) {
+	asset0Amount, ok := cmath.NewIntFromString(tx.Asset0Amount)
+	if !ok {
+		return Trade{}, errors.Errorf("invalid asset0 amount(%s) of tx(%d)", tx.Asset0Amount, tx.Id)
+	}
+	asset1Amount, ok := cmath.NewIntFromString(tx.Asset1Amount)
+	if !ok {
+		return Trade{}, errors.Errorf("invalid asset1 amount(%s) of tx(%d)", tx.Asset1Amount, tx.Id)
+	}
+
+	tradeType := TradeTypeSell
+	if asset0Amount.IsNegative() {
+		tradeType = TradeTypeBuy
+	}
+	baseVolume := cmath.LegacyNewDecFromIntWithPrec(asset0Amount.Abs(), int64(tx.BaseDecimals))
+	targetVolume := cmath.LegacyNewDecFromIntWithPrec(asset1Amount.Abs(), int64(tx.TargetDecimals))
+	price := cmath.LegacyZeroDec()
+	if !baseVolume.IsZero() {
+		price = targetVolume.Quo(baseVolume)
+	}
+
+	return Trade{
+		TradeId:        tx.Id,
+		Price:          price.String(),
+		BaseVolume:     baseVolume.String(),
+		TargetVolume:   targetVolume.String(),
+		TradeTimestamp: int64(tx.Timestamp * 1_000),
+		Type:           tradeType,
+	}, nil
+}
+
+// parseTickerId returns the base and the target of the ticker id in the form of base_target
+func parseTickerId(tickerId string) (string, string, error) {
+	tokens := strings.Split(tickerId, "_")
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" {
+		return "", "", ErrInvalidTickerId
+	}
+	return tokens[0], tokens[1], nil
+}
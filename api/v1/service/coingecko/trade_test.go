@@ -0,0 +1,44 @@
+package coingecko
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestHistoricalTrades(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	s := NewTradeService("test-chain", gormDB)
+
+	mock.ExpectQuery(`FROM parsed_tx AS pt JOIN pair AS p`).
+		WithArgs("test-chain", "tokenA", "tokenB", 1_700_000_000.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "asset0_amount", "asset1_amount", "timestamp", "base_decimals", "target_decimals"}).
+			AddRow(2, "-2000000", "5000000000000000000", 1_700_000_100.5, 6, 18).
+			AddRow(1, "1000000", "-2000000000000000000", 1_700_000_000.0, 6, 18))
+
+	trades, err := s.HistoricalTrades(context.Background(), "tokenA_tokenB", "", 0, 1_700_000_000_000, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Trade{
+		{TradeId: 2, Price: "2.500000000000000000", BaseVolume: "2.000000000000000000", TargetVolume: "5.000000000000000000", TradeTimestamp: 1_700_000_100_500, Type: TradeTypeBuy},
+		{TradeId: 1, Price: "2.000000000000000000", BaseVolume: "1.000000000000000000", TargetVolume: "2.000000000000000000", TradeTimestamp: 1_700_000_000_000, Type: TradeTypeSell},
+	}, trades)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHistoricalTrades_InvalidTickerId(t *testing.T) {
+	s := NewTradeService("test-chain", nil)
+
+	_, err := s.HistoricalTrades(context.Background(), "tokenA", "", 0, 0, 0)
+
+	assert.ErrorIs(t, err, ErrInvalidTickerId)
+}
@@ -15,3 +15,20 @@ type Ticker struct {
 	PoolId        string
 	Timestamp     float64
 }
+
+// Summary is the 24h figures of the ticker, the prices are of the last swap prices and the 24h ones are empty when the pair has no swap in 24h
+type Summary struct {
+	Ticker
+	LowestAsk             string
+	HighestBid            string
+	HighestPrice24h       string
+	LowestPrice24h        string
+	PriceChangePercent24h string
+}
+
+type Asset struct {
+	Address  string
+	Name     string
+	Symbol   string
+	Decimals int
+}
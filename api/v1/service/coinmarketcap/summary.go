@@ -0,0 +1,149 @@
+package coinmarketcap
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/dezswap/dezswap-api/api/v1/service"
+	"github.com/dezswap/dezswap-api/pkg"
+	"github.com/dezswap/dezswap-api/pkg/dezswap"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type SummaryService interface {
+	Summaries(ctx context.Context) ([]Summary, error)
+	Assets(ctx context.Context) ([]Asset, error)
+}
+
+type summaryService struct {
+	chainId string
+	*gorm.DB
+	tickers        service.Getter[Ticker]
+	commissionRate math.LegacyDec
+}
+
+type priceRange struct {
+	BaseAddress  string
+	QuoteAddress string
+	FirstPrice   string
+	LastPrice    string
+	HighestPrice string
+	LowestPrice  string
+}
+
+// NewSummaryService returns the summaries of which 24h volumes are of the tickers and prices are of the last swap prices
+func NewSummaryService(chainId string, db *gorm.DB, tickers service.Getter[Ticker]) SummaryService {
+	return &summaryService{chainId, db, tickers, math.LegacyMustNewDecFromStr(strconv.FormatFloat(dezswap.SWAP_FEE, 'f', -1, 64))}
+}
+
+// Summaries implements SummaryService
+func (s *summaryService) Summaries(ctx context.Context) ([]Summary, error) {
+	ctx, span := tracing.Start(ctx, "summaryService.Summaries")
+	defer span.End()
+	tickers, err := s.tickers.GetAll(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "summaryService.Summaries")
+	}
+
+	// the prices are of the last swaps of the 30m stats, pair_stats_recent has no swap prices.
+	// The last price of a pair without swaps in 24h is of its last swap before
+	query := `
+select p.asset0 base_address,
+       p.asset1 quote_address,
+       coalesce(r.first_price, '') first_price,
+       coalesce(r.last_price, l.last_price, '') last_price,
+       coalesce(r.highest_price, '') highest_price,
+       coalesce(r.lowest_price, '') lowest_price
+from pair p
+     left join lateral (select ((array_agg(ps.last_swap_price order by ps.timestamp asc))[1])::text first_price,
+                               ((array_agg(ps.last_swap_price order by ps.timestamp desc))[1])::text last_price,
+                               max(ps.last_swap_price)::text highest_price,
+                               min(ps.last_swap_price)::text lowest_price
+                        from pair_stats_30m ps
+                        where ps.pair_id = p.id
+                          and ps.timestamp >= extract(epoch from now()-interval'24h')
+                          and ps.last_swap_price > 0) r on true
+     left join lateral (select ps.last_swap_price::text last_price
+                        from pair_stats_30m ps
+                        where ps.pair_id = p.id
+                          and ps.last_swap_price > 0
+                        order by ps.timestamp desc
+                        limit 1) l on true
+where p.chain_id = ?
+`
+	ranges := []priceRange{}
+	if tx := s.WithContext(ctx).Raw(query, s.chainId).Scan(&ranges); tx.Error != nil {
+		return nil, errors.Wrap(tx.Error, "summaryService.Summaries")
+	}
+	rangeMap := make(map[string]priceRange, len(ranges))
+	for _, r := range ranges {
+		rangeMap[r.BaseAddress+"_"+r.QuoteAddress] = r
+	}
+
+	summaries := make([]Summary, len(tickers))
+	for i, t := range tickers {
+		summaries[i].Ticker = t
+		summaries[i].LastPrice = "0"
+		r, ok := rangeMap[t.BaseAddress+"_"+t.QuoteAddress]
+		if !ok || r.LastPrice == "" {
+			continue
+		}
+
+		// every price of the summary is of the last swap prices alike
+		prices := make([]math.LegacyDec, 4)
+		for j, p := range []string{r.LastPrice, r.FirstPrice, r.HighestPrice, r.LowestPrice} {
+			if p == "" {
+				continue
+			}
+			if prices[j], err = swapPrice(p, t.BaseDecimals, t.QuoteDecimals); err != nil {
+				return nil, errors.Wrap(err, "summaryService.Summaries")
+			}
+		}
+		last, first, highest, lowest := prices[0], prices[1], prices[2], prices[3]
+
+		summaries[i].LastPrice = last.String()
+		if last.IsPositive() {
+			// the marginal prices of the pool are apart from the last price by the commission
+			feeRatio := math.LegacyOneDec().Sub(s.commissionRate)
+			summaries[i].LowestAsk = last.Quo(feeRatio).String()
+			summaries[i].HighestBid = last.Mul(feeRatio).String()
+		}
+		if r.FirstPrice == "" {
+			continue
+		}
+		summaries[i].HighestPrice24h = highest.String()
+		summaries[i].LowestPrice24h = lowest.String()
+		summaries[i].PriceChangePercent24h = last.Sub(first).Quo(first).MulInt64(100).String()
+	}
+	return summaries, nil
+}
+
+// swapPrice is the quote per base of a last swap price, which is of the amounts without the decimals
+func swapPrice(lastSwapPrice string, baseDecimals, quoteDecimals int) (math.LegacyDec, error) {
+	price, err := pkg.NewDecFromStrWithTruncate(lastSwapPrice)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if baseDecimals >= quoteDecimals {
+		return price.Mul(math.LegacyNewDec(10).Power(uint64(baseDecimals - quoteDecimals))), nil
+	}
+	return price.Quo(math.LegacyNewDec(10).Power(uint64(quoteDecimals - baseDecimals))), nil
+}
+
+// Assets implements SummaryService
+func (s *summaryService) Assets(ctx context.Context) ([]Asset, error) {
+	ctx, span := tracing.Start(ctx, "summaryService.Assets")
+	defer span.End()
+	assets := []Asset{}
+	if tx := s.WithContext(ctx).Table("tokens t").
+		Where("t.chain_id = ? and exists (select 1 from pair p where p.chain_id = t.chain_id and (p.asset0 = t.address or p.asset1 = t.address))", s.chainId).
+		Select("t.address, t.name, t.symbol, t.decimals").
+		Order("t.address").
+		Scan(&assets); tx.Error != nil {
+		return nil, errors.Wrap(tx.Error, "summaryService.Assets")
+	}
+	return assets, nil
+}
@@ -0,0 +1,69 @@
+package coinmarketcap
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type tickerGetterMock struct {
+	mock.Mock
+}
+
+func (m *tickerGetterMock) Get(_ context.Context, key string) (*Ticker, error) {
+	args := m.Called(key)
+	ticker, _ := args.Get(0).(*Ticker)
+	return ticker, args.Error(1)
+}
+
+func (m *tickerGetterMock) GetAll(_ context.Context) ([]Ticker, error) {
+	args := m.Called()
+	tickers, _ := args.Get(0).([]Ticker)
+	return tickers, args.Error(1)
+}
+
+func TestSummaries(t *testing.T) {
+	sqlDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+
+	tickers := &tickerGetterMock{}
+	tickers.On("GetAll").Return([]Ticker{
+		// the last price of the ticker is of the volumes, the summary is not
+		{BaseAddress: "addrA", QuoteAddress: "addrB", LastPrice: "0.5", BaseVolume: "10", QuoteVolume: "20", BaseDecimals: 6, QuoteDecimals: 6},
+		{BaseAddress: "addrC", QuoteAddress: "addrD", LastPrice: "0", BaseDecimals: 6, QuoteDecimals: 18},
+		{BaseAddress: "addrE", QuoteAddress: "addrF", LastPrice: "0", BaseDecimals: 6, QuoteDecimals: 6},
+	}, nil).Once()
+	sqlMock.ExpectQuery(`from pair_stats_30m`).WithArgs("test-chain").
+		WillReturnRows(sqlmock.NewRows([]string{"base_address", "quote_address", "first_price", "last_price", "highest_price", "lowest_price"}).
+			AddRow("addrA", "addrB", "1.6", "2", "2.4", "1.5").
+			AddRow("addrC", "addrD", "", "3000000000000", "", ""))
+	s := NewSummaryService("test-chain", gormDB, tickers)
+
+	summaries, err := s.Summaries(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, summaries, 3)
+	require.Equal(t, "2.000000000000000000", summaries[0].LastPrice)
+	require.Equal(t, "2.006018054162487462", summaries[0].LowestAsk)
+	require.Equal(t, "1.994000000000000000", summaries[0].HighestBid)
+	require.Equal(t, "2.400000000000000000", summaries[0].HighestPrice24h)
+	require.Equal(t, "1.500000000000000000", summaries[0].LowestPrice24h)
+	require.Equal(t, "25.000000000000000000", summaries[0].PriceChangePercent24h)
+	// a pair without swaps in 24h has the last swap price before by the decimals, without the 24h prices
+	require.Equal(t, "3.000000000000000000", summaries[1].LastPrice)
+	require.Equal(t, "3.009027081243731194", summaries[1].LowestAsk)
+	require.Empty(t, summaries[1].HighestPrice24h)
+	require.Empty(t, summaries[1].PriceChangePercent24h)
+	// a pair never swapped has no prices
+	require.Equal(t, "0", summaries[2].LastPrice)
+	require.Empty(t, summaries[2].LowestAsk)
+	require.NoError(t, sqlMock.ExpectationsWereMet())
+}
@@ -1,7 +1,9 @@
 package coinmarketcap
 
 import (
+	"context"
 	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
 	"github.com/dezswap/dezswap-api/api/v1/service"
 	"github.com/dezswap/dezswap-api/pkg"
 	"github.com/pkg/errors"
@@ -18,7 +20,11 @@ func NewTickerService(chainId string, db *gorm.DB) service.Getter[Ticker] {
 	return &tickerService{chainId, db}
 }
 
-func (s tickerService) Get(key string) (*Ticker, error) {
+func (s tickerService) Get(ctx context.Context, key string) (*Ticker, error) {
+	ctx, span := tracing.Start(ctx, "tickerService.Get")
+	defer span.End()
+	// the receiver is a copy, the statements of the method are under the span
+	s.DB = s.WithContext(ctx)
 	tickers := []Ticker{}
 
 	tokens := strings.Split(key, "_")
@@ -121,7 +127,10 @@ where p.chain_id = ? and p.asset0 = ? and p.asset1 = ?
 	return &ticker, nil
 }
 
-func (s tickerService) GetAll() ([]Ticker, error) {
+func (s tickerService) GetAll(ctx context.Context) ([]Ticker, error) {
+	ctx, span := tracing.Start(ctx, "tickerService.GetAll")
+	defer span.End()
+	s.DB = s.WithContext(ctx)
 	query := `
 select t0.address base_address,
        t0.name base_name,
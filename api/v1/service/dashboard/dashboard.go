@@ -1,9 +1,11 @@
 package dashboard
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/dezswap/dezswap-api/api/tracing"
 	"github.com/dezswap/dezswap-api/pkg/db/aggregator"
 	"github.com/dezswap/dezswap-api/pkg/db/parser"
 	"github.com/dezswap/dezswap-api/pkg/dezswap"
@@ -50,8 +52,16 @@ func NewDashboardService(chainId string, db *gorm.DB) Dashboard {
 	return &dashboard{chainId, db}
 }
 
+// withContext is the dashboard of which the statements are under the span of ctx
+func (d *dashboard) withContext(ctx context.Context) *dashboard {
+	return &dashboard{d.chainId, d.WithContext(ctx)}
+}
+
 // Aprs implements Dashboard.
-func (d *dashboard) Aprs(duration Duration) (Aprs, error) {
+func (d *dashboard) Aprs(ctx context.Context, duration Duration) (Aprs, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Aprs")
+	defer span.End()
+	d = d.withContext(ctx)
 	truncBy := chartCriteriaByDuration[duration].TruncBy
 	intervalAgo := chartCriteriaByDuration[duration].Ago
 
@@ -137,7 +147,10 @@ func (d *dashboard) Aprs(duration Duration) (Aprs, error) {
 }
 
 // AprsOf implements Dashboard.
-func (d *dashboard) AprsOf(pool Addr, duration Duration) ([]Apr, error) {
+func (d *dashboard) AprsOf(ctx context.Context, pool Addr, duration Duration) ([]Apr, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.AprsOf")
+	defer span.End()
+	d = d.withContext(ctx)
 	truncBy := chartCriteriaByDuration[duration].TruncBy
 	intervalAgo := chartCriteriaByDuration[duration].Ago
 
@@ -214,7 +227,10 @@ func (d *dashboard) AprsOf(pool Addr, duration Duration) ([]Apr, error) {
 }
 
 // Pools implements Dashboard.
-func (d *dashboard) Pools(tokens ...Addr) (Pools, error) {
+func (d *dashboard) Pools(ctx context.Context, tokens ...Addr) (Pools, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Pools")
+	defer span.End()
+	d = d.withContext(ctx)
 	current, mins := time.Now().Truncate(time.Hour), time.Now().Minute()
 	if mins < 30 {
 		current = current.Add(time.Minute * -30)
@@ -295,15 +311,17 @@ func (d *dashboard) Pools(tokens ...Addr) (Pools, error) {
 }
 
 // PoolDetail implements Dashboard.
-func (d *dashboard) PoolDetail(addr Addr) (PoolDetail, error) {
+func (d *dashboard) PoolDetail(ctx context.Context, addr Addr) (PoolDetail, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.PoolDetail")
+	defer span.End()
 	detail := PoolDetail{}
 	var err error
 
-	detail.Recent, err = d.RecentOf(addr)
+	detail.Recent, err = d.RecentOf(ctx, addr)
 	if err != nil {
 		return detail, errors.Wrap(err, "dashboard.PoolDetail")
 	}
-	detail.Txs, err = d.Txs(TX_TYPE_ALL, addr)
+	detail.Txs, err = d.Txs(ctx, TX_TYPE_ALL, addr)
 	if err != nil {
 		return detail, errors.Wrap(err, "dashboard.PoolDetail")
 	}
@@ -315,7 +333,10 @@ func (d *dashboard) PoolDetail(addr Addr) (PoolDetail, error) {
 // /	   currently subQuery seems to be broken in gorm. result from the raw query and gorm is different
 //
 // Recent implements Dashboard.
-func (d *dashboard) Recent() (Recent, error) {
+func (d *dashboard) Recent(ctx context.Context) (Recent, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Recent")
+	defer span.End()
+	d = d.withContext(ctx)
 	current, mins := time.Now().Truncate(time.Hour), time.Now().Minute()
 	if mins < 30 {
 		current = current.Add(time.Minute * -30)
@@ -387,7 +408,10 @@ func (d *dashboard) Recent() (Recent, error) {
 // TODO(): try to use gorm to implement this and RecentOf when the bug is fixed
 // /	   currently subquery seems to be broken in gorm. result from the raw query and gorm is different
 // Recent implements Dashboard.
-func (d *dashboard) RecentOf(pairContractAddr Addr) (Recent, error) {
+func (d *dashboard) RecentOf(ctx context.Context, pairContractAddr Addr) (Recent, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.RecentOf")
+	defer span.End()
+	d = d.withContext(ctx)
 	current, mins := time.Now().Truncate(time.Hour), time.Now().Minute()
 	if mins < 30 {
 		current = current.Add(time.Minute * -30)
@@ -462,7 +486,10 @@ func (d *dashboard) RecentOf(pairContractAddr Addr) (Recent, error) {
 }
 
 // Statistic implements Dashboard.
-func (d *dashboard) Statistic(addr ...Addr) (st Statistic, err error) {
+func (d *dashboard) Statistic(ctx context.Context, addr ...Addr) (st Statistic, err error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Statistic")
+	defer span.End()
+	d = d.withContext(ctx)
 	subDau := d.dau(addr...)
 	subTxCnts := d.txCounts(addr...)
 	subFees := d.fees(addr...)
@@ -499,7 +526,10 @@ func (d *dashboard) Statistic(addr ...Addr) (st Statistic, err error) {
 }
 
 // Tokens implements Dashboard.
-func (d *dashboard) Tokens() (Tokens, error) {
+func (d *dashboard) Tokens(ctx context.Context) (Tokens, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Tokens")
+	defer span.End()
+	d = d.withContext(ctx)
 	var tokens []Token
 	var err error
 	if tokens, err = d.tokenPrice(); err != nil {
@@ -543,7 +573,9 @@ func (d *dashboard) Tokens() (Tokens, error) {
 func (d *dashboard) tokenPrice(addr ...Addr) (Tokens, error) {
 	query := `
 select t.address as addr, coalesce(p.price, 0) price,
-       floor((coalesce(p.price, 0)-coalesce(p24h.price, 0))/coalesce(p.price, 1)*10000)/100 as price_change
+       floor((coalesce(p.price, 0)-coalesce(p24h.price, 0))/coalesce(p.price, 1)*10000)/100 as price_change,
+       coalesce(p.price * s.circulating_supply / power(10, t.decimals), 0) as market_cap,
+       coalesce(p.price * s.total_supply / power(10, t.decimals), 0) as fdv
 from tokens t
     left join (
         select token_id, price
@@ -567,16 +599,21 @@ from tokens t
         union
         select distinct price_token_id, 1
         from price) p24h on t.id = p24h.token_id
+    left join (
+        select distinct on (address) address, total_supply, circulating_supply
+        from token_supplies
+        where chain_id = ?
+        order by address, height desc) s on t.address = s.address
 where t.chain_id = ?
 `
 	var tokens []Token
 	var tx *gorm.DB
 	if len(addr) > 0 {
 		query += ` and t.address in ?`
-		tx = d.Raw(query, d.chainId, d.chainId, addr)
+		tx = d.Raw(query, d.chainId, d.chainId, d.chainId, addr)
 	} else {
 		query += ` and t.symbol != 'uLP' order by t.id`
-		tx = d.Raw(query, d.chainId, d.chainId)
+		tx = d.Raw(query, d.chainId, d.chainId, d.chainId)
 	}
 
 	if err := tx.Find(&tokens).Error; err != nil {
@@ -750,7 +787,10 @@ func tokenStatsToTokens(stats []tokenStat) Tokens {
 	return tokens
 }
 
-func (d *dashboard) Token(addr Addr) (Token, error) {
+func (d *dashboard) Token(ctx context.Context, addr Addr) (Token, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Token")
+	defer span.End()
+	d = d.withContext(ctx)
 	var token Token
 	if tokens, err := d.tokenPrice(addr); err != nil {
 		return Token{}, errors.Wrap(err, "dashboard.Token")
@@ -791,7 +831,10 @@ func (d *dashboard) Token(addr Addr) (Token, error) {
 	return token, nil
 }
 
-func (d *dashboard) TokenVolumes(addr Addr, itv Duration) (TokenChart, error) {
+func (d *dashboard) TokenVolumes(ctx context.Context, addr Addr, itv Duration) (TokenChart, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.TokenVolumes")
+	defer span.End()
+	d = d.withContext(ctx)
 	query := `
 select cast(extract(epoch from make_date(year_utc, month_utc, 1)::timestamp + INTERVAL '1 month - 1 day') as BIGINT) as timestamp, -- last day of month
        coalesce(sum(volume), 0) as value
@@ -874,7 +917,10 @@ order by eow2
 	return chart, nil
 }
 
-func (d *dashboard) TokenTvls(addr Addr, itv Duration) (TokenChart, error) {
+func (d *dashboard) TokenTvls(ctx context.Context, addr Addr, itv Duration) (TokenChart, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.TokenTvls")
+	defer span.End()
+	d = d.withContext(ctx)
 	query := `
 select cast(extract(epoch from make_date(year_utc, month_utc, 1)::timestamp + INTERVAL '1 month - 1 day') as BIGINT) as timestamp, -- last day of month
        sum(tvl) as value
@@ -965,7 +1011,10 @@ order by eow2
 	return chart, nil
 }
 
-func (d *dashboard) TokenPrices(addr Addr, itv Duration) (TokenChart, error) {
+func (d *dashboard) TokenPrices(ctx context.Context, addr Addr, itv Duration) (TokenChart, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.TokenPrices")
+	defer span.End()
+	d = d.withContext(ctx)
 	query := `
 select distinct cast(extract(epoch from make_date(year_utc, month_utc, 1)::timestamp + INTERVAL '1 month - 1 day') as BIGINT) as timestamp, -- last day of month
                 first_value(price) over (partition by year_utc, month_utc order by height desc) as value
@@ -1048,7 +1097,10 @@ from (select p.height, eow2, p.price
 }
 
 // Tvls implements Dashboard.
-func (d *dashboard) Tvls(duration Duration) (Tvls, error) {
+func (d *dashboard) Tvls(ctx context.Context, duration Duration) (Tvls, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Tvls")
+	defer span.End()
+	d = d.withContext(ctx)
 	truncBy := chartCriteriaByDuration[duration].TruncBy
 	intervalAgo := chartCriteriaByDuration[duration].Ago
 
@@ -1106,8 +1158,49 @@ func (d *dashboard) Tvls(duration Duration) (Tvls, error) {
 	return tvls, nil
 }
 
+// DailyTvls implements Dashboard.
+func (d *dashboard) DailyTvls(ctx context.Context) (Tvls, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.DailyTvls")
+	defer span.End()
+	d = d.withContext(ctx)
+	// the tvl of a day is the sum of the last liquidity of every pair at its end,
+	// summing up the changes of the pairs carries the liquidity over the days without stats
+	query := `
+with daily as (
+    select distinct on (pair_id, day) pair_id,
+           cast(ceil(timestamp / 86400) as int8) - 1 as day,
+           liquidity0_in_price + liquidity1_in_price as liquidity
+    from pair_stats_30m
+    where chain_id = ?
+    order by pair_id, day, timestamp desc
+), changes as (
+    select day, liquidity - coalesce(lag(liquidity) over (partition by pair_id order by day), 0) as change
+    from daily
+), daily_changes as (
+    select day, sum(change) as change
+    from changes
+    group by day
+), days as (
+    select generate_series((select min(day) from daily), cast(floor(extract(epoch from now()) / 86400) as int8)) as day
+)
+select sum(coalesce(daily_changes.change, 0)) over (order by days.day) as tvl,
+       to_timestamp(days.day * 86400) at time zone 'UTC' as timestamp
+from days
+    left join daily_changes on daily_changes.day = days.day
+order by days.day
+`
+	tvls := Tvls{}
+	if err := d.DB.Raw(query, d.chainId).Scan(&tvls).Error; err != nil {
+		return nil, errors.Wrap(err, "dashboard.DailyTvls")
+	}
+	return tvls, nil
+}
+
 // TvlsOf implements Dashboard.
-func (d *dashboard) TvlsOf(addr Addr, duration Duration) ([]Tvl, error) {
+func (d *dashboard) TvlsOf(ctx context.Context, addr Addr, duration Duration) ([]Tvl, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.TvlsOf")
+	defer span.End()
+	d = d.withContext(ctx)
 	truncBy := chartCriteriaByDuration[duration].TruncBy
 	intervalAgo := chartCriteriaByDuration[duration].Ago
 
@@ -1155,7 +1248,10 @@ func (d *dashboard) TvlsOf(addr Addr, duration Duration) ([]Tvl, error) {
 }
 
 // Txs implements Dashboard.
-func (d *dashboard) Txs(txType TxType, addr ...Addr) (Txs, error) {
+func (d *dashboard) Txs(ctx context.Context, txType TxType, addr ...Addr) (Txs, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Txs")
+	defer span.End()
+	d = d.withContext(ctx)
 	m := parser.ParsedTx{}
 	subQuery := d.DB.Model(&m).Where("chain_id = ? AND type != 'transfer'", d.chainId).Order("timestamp DESC").Limit(100)
 	if txType != TX_TYPE_ALL {
@@ -1205,7 +1301,10 @@ func (d *dashboard) Txs(txType TxType, addr ...Addr) (Txs, error) {
 }
 
 // TxsOfToken implements Dashboard.
-func (d *dashboard) TxsOfToken(txType TxType, tokenAddrs ...Addr) (Txs, error) {
+func (d *dashboard) TxsOfToken(ctx context.Context, txType TxType, tokenAddrs ...Addr) (Txs, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.TxsOfToken")
+	defer span.End()
+	d = d.withContext(ctx)
 	m := parser.ParsedTx{}
 	subQuery := d.DB.Model(&m).Where("chain_id = ? AND type != 'transfer'", d.chainId).Order("timestamp DESC").Limit(100)
 	if txType != TX_TYPE_ALL {
@@ -1255,7 +1354,10 @@ func (d *dashboard) TxsOfToken(txType TxType, tokenAddrs ...Addr) (Txs, error) {
 }
 
 // Volumes implements Dashboard.
-func (d *dashboard) Volumes(duration Duration) (Volumes, error) {
+func (d *dashboard) Volumes(ctx context.Context, duration Duration) (Volumes, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Volumes")
+	defer span.End()
+	d = d.withContext(ctx)
 	truncBy := chartCriteriaByDuration[duration].TruncBy
 	intervalAgo := chartCriteriaByDuration[duration].Ago
 	query := fmt.Sprintf(`
@@ -1285,7 +1387,10 @@ order by ds.timestamp
 }
 
 // VolumesOf implements Dashboard.
-func (d *dashboard) VolumesOf(addr Addr, duration Duration) (Volumes, error) {
+func (d *dashboard) VolumesOf(ctx context.Context, addr Addr, duration Duration) (Volumes, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.VolumesOf")
+	defer span.End()
+	d = d.withContext(ctx)
 	truncBy := chartCriteriaByDuration[duration].TruncBy
 	intervalAgo := chartCriteriaByDuration[duration].Ago
 	query := fmt.Sprintf(`
@@ -1316,8 +1421,39 @@ order by ds.timestamp
 	return volumes, nil
 }
 
+// DailyVolumes implements Dashboard.
+func (d *dashboard) DailyVolumes(ctx context.Context) (Volumes, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.DailyVolumes")
+	defer span.End()
+	d = d.withContext(ctx)
+	// a day is of the stats in (its start, its end] like the buckets of Volumes
+	query := `
+with daily as (
+    select cast(ceil(timestamp / 86400) as int8) - 1 as day, sum(volume0_in_price) as volume
+    from pair_stats_30m
+    where chain_id = ?
+    group by 1
+), days as (
+    select generate_series((select min(day) from daily), cast(floor(extract(epoch from now()) / 86400) as int8)) as day
+)
+select coalesce(daily.volume, 0) as volume,
+       to_timestamp(days.day * 86400) at time zone 'UTC' as timestamp
+from days
+    left join daily on daily.day = days.day
+order by days.day
+`
+	volumes := Volumes{}
+	if err := d.DB.Raw(query, d.chainId).Scan(&volumes).Error; err != nil {
+		return nil, errors.Wrap(err, "dashboard.DailyVolumes")
+	}
+	return volumes, nil
+}
+
 // Fees implements Dashboard.
-func (d *dashboard) Fees(duration Duration) ([]Fee, error) {
+func (d *dashboard) Fees(ctx context.Context, duration Duration) ([]Fee, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.Fees")
+	defer span.End()
+	d = d.withContext(ctx)
 	truncBy := chartCriteriaByDuration[duration].TruncBy
 	intervalAgo := chartCriteriaByDuration[duration].Ago
 	query := fmt.Sprintf(`
@@ -1347,7 +1483,10 @@ order by ds.timestamp
 }
 
 // FeesOf implements Dashboard.
-func (d *dashboard) FeesOf(addr Addr, duration Duration) ([]Fee, error) {
+func (d *dashboard) FeesOf(ctx context.Context, addr Addr, duration Duration) ([]Fee, error) {
+	ctx, span := tracing.Start(ctx, "dashboard.FeesOf")
+	defer span.End()
+	d = d.withContext(ctx)
 	truncBy := chartCriteriaByDuration[duration].TruncBy
 	intervalAgo := chartCriteriaByDuration[duration].Ago
 	query := fmt.Sprintf(`
@@ -1,6 +1,7 @@
 package dashboard
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"testing"
@@ -235,7 +236,7 @@ func TestRecentOf_NoDivisionByZero_WithPrevZeros(t *testing.T) {
 	defer CleanupDB(t, db)
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	recent, err := d.RecentOf(Addr(testPairContractAddr1))
+	recent, err := d.RecentOf(context.Background(), Addr(testPairContractAddr1))
 	require.NoError(t, err)
 
 	assert.True(t, recent.PoolExists)
@@ -255,7 +256,7 @@ func TestRecent_NoDivisionByZero_WithPrevZeros(t *testing.T) {
 	generateStatsForPairWithValues(t, db, testPairID, testChainID, time.Now().Truncate(time.Hour).Add(-30*time.Minute), "100", "0", "1000", "1000")
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	recent, err := d.Recent()
+	recent, err := d.Recent(context.Background())
 	require.NoError(t, err)
 
 	assert.Equal(t, float32(0), recent.VolumeChangeRate)
@@ -272,7 +273,7 @@ func TestTokens_NoTransaction48h(t *testing.T) {
 	generateStats(t, db, time.Now().Truncate(time.Hour).Add(-48*time.Hour))
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	tokens, err := d.Tokens()
+	tokens, err := d.Tokens(context.Background())
 	require.NoError(t, err)
 
 	assert.NotEmpty(t, tokens)
@@ -292,7 +293,7 @@ func TestTestTokenVolumes(t *testing.T) {
 	addr := Addr(testTokenAddr)
 
 	t.Run("Month interval", func(t *testing.T) {
-		chart, err := d.TokenVolumes(addr, Month)
+		chart, err := d.TokenVolumes(context.Background(), addr, Month)
 		require.NoError(t, err)
 		require.NotNil(t, chart)
 		require.True(t, len(chart) > 0)
@@ -302,7 +303,7 @@ func TestTestTokenVolumes(t *testing.T) {
 	})
 
 	t.Run("Quarter interval", func(t *testing.T) {
-		chart, err := d.TokenVolumes(addr, Quarter)
+		chart, err := d.TokenVolumes(context.Background(), addr, Quarter)
 		require.NoError(t, err)
 		require.True(t, len(chart) > 0)
 
@@ -311,7 +312,7 @@ func TestTestTokenVolumes(t *testing.T) {
 	})
 
 	t.Run("Year interval", func(t *testing.T) {
-		chart, err := d.TokenVolumes(addr, Year)
+		chart, err := d.TokenVolumes(context.Background(), addr, Year)
 		require.NoError(t, err)
 		require.True(t, len(chart) > 0)
 
@@ -320,7 +321,7 @@ func TestTestTokenVolumes(t *testing.T) {
 	})
 
 	t.Run("All interval", func(t *testing.T) {
-		chart, err := d.TokenVolumes(addr, All)
+		chart, err := d.TokenVolumes(context.Background(), addr, All)
 		require.NoError(t, err)
 		require.True(t, len(chart) > 0)
 
@@ -338,7 +339,7 @@ func TestTestTokenTvls(t *testing.T) {
 	addr := Addr(testTokenAddr)
 
 	t.Run("Month interval", func(t *testing.T) {
-		chart, err := d.TokenTvls(addr, Month)
+		chart, err := d.TokenTvls(context.Background(), addr, Month)
 		require.NoError(t, err)
 		require.NotNil(t, chart)
 		require.True(t, len(chart) > 0)
@@ -348,7 +349,7 @@ func TestTestTokenTvls(t *testing.T) {
 	})
 
 	t.Run("Quarter interval", func(t *testing.T) {
-		chart, err := d.TokenTvls(addr, Quarter)
+		chart, err := d.TokenTvls(context.Background(), addr, Quarter)
 		require.NoError(t, err)
 		require.True(t, len(chart) > 0)
 
@@ -357,7 +358,7 @@ func TestTestTokenTvls(t *testing.T) {
 	})
 
 	t.Run("Year interval", func(t *testing.T) {
-		chart, err := d.TokenTvls(addr, Year)
+		chart, err := d.TokenTvls(context.Background(), addr, Year)
 		require.NoError(t, err)
 		require.True(t, len(chart) > 0)
 
@@ -366,7 +367,7 @@ func TestTestTokenTvls(t *testing.T) {
 	})
 
 	t.Run("Year interval", func(t *testing.T) {
-		chart, err := d.TokenTvls(addr, All)
+		chart, err := d.TokenTvls(context.Background(), addr, All)
 		require.NoError(t, err)
 		require.True(t, len(chart) > 0)
 
@@ -375,7 +376,7 @@ func TestTestTokenTvls(t *testing.T) {
 	})
 
 	t.Run("Asset1 uses asset1 liquidity", func(t *testing.T) {
-		chart, err := d.TokenTvls(addr, Month)
+		chart, err := d.TokenTvls(context.Background(), addr, Month)
 		require.NoError(t, err)
 		require.NotEmpty(t, chart)
 
@@ -383,6 +384,42 @@ func TestTestTokenTvls(t *testing.T) {
 	})
 }
 
+func TestDailyVolumesAndTvls_DayBuckets(t *testing.T) {
+	db := SetupDB(t)
+	defer CleanupDB(t, db)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	// no stats the day before yesterday, the liquidity of yesterday carries over
+	generateStatsForPairWithValues(t, db, testPairID, testChainID, today.AddDate(0, 0, -3).Add(time.Hour), "10", "0", "100", "100")
+	generateStatsForPairWithValues(t, db, testPairID, testChainID, today.AddDate(0, 0, -3).Add(2*time.Hour), "5", "0", "150", "150")
+	generateStatsForPairWithValues(t, db, testPairID, testChainID, today.AddDate(0, 0, -1).Add(time.Hour), "20", "0", "50", "50")
+
+	d := &dashboard{DB: db, chainId: testChainID}
+
+	volumes, err := d.DailyVolumes(context.Background())
+	require.NoError(t, err)
+	require.Len(t, volumes, 4)
+	for i, volume := range volumes {
+		assert.Equal(t, today.AddDate(0, 0, i-3), volume.Timestamp.UTC(), "the buckets are a day apart")
+	}
+	for i, expected := range []float64{15, 0, 20, 0} {
+		volume, err := strconv.ParseFloat(volumes[i].Volume, 64)
+		require.NoError(t, err)
+		assert.Equal(t, expected, volume)
+	}
+
+	tvls, err := d.DailyTvls(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tvls, 4)
+	for i, tvl := range tvls {
+		assert.Equal(t, today.AddDate(0, 0, i-3), tvl.Timestamp.UTC(), "the buckets are a day apart")
+	}
+	for i, expected := range []float64{300, 300, 100, 100} {
+		tvl, err := strconv.ParseFloat(tvls[i].Tvl, 64)
+		require.NoError(t, err)
+		assert.Equal(t, expected, tvl)
+	}
+}
+
 func TestAprsOf_UsesOnlyTargetPoolVolume(t *testing.T) {
 	db := SetupDB(t)
 	defer CleanupDB(t, db)
@@ -399,7 +436,7 @@ INSERT INTO pair (chain_id, contract, asset0, asset1, lp) VALUES (?, ?, 'axpla',
 	generateStatsForPairWithValues(t, db, otherPairID, testChainID, ts, "1000000", "0", "1000", "1000")
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	aprs, err := d.AprsOf(Addr(testPairContractAddr2), Month)
+	aprs, err := d.AprsOf(context.Background(), Addr(testPairContractAddr2), Month)
 	require.NoError(t, err)
 	require.NotEmpty(t, aprs)
 
@@ -431,7 +468,7 @@ INSERT INTO pair (chain_id, contract, asset0, asset1, lp) VALUES (?, 'otherpair'
 	}()
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	aprs, err := d.Aprs(Month)
+	aprs, err := d.Aprs(context.Background(), Month)
 	require.NoError(t, err)
 	require.NotEmpty(t, aprs)
 
@@ -447,7 +484,7 @@ func TestPools_NewPairAppearsWithoutStats(t *testing.T) {
 
 	d := &dashboard{DB: db, chainId: testChainID}
 
-	pools, err := d.Pools()
+	pools, err := d.Pools(context.Background())
 	require.NoError(t, err)
 	require.NotNil(t, pools)
 
@@ -466,7 +503,7 @@ func TestPools_PairWithoutStats_HasZeroValues(t *testing.T) {
 
 	d := &dashboard{DB: db, chainId: testChainID}
 
-	pools, err := d.Pools()
+	pools, err := d.Pools(context.Background())
 	require.NoError(t, err)
 	require.NotNil(t, pools)
 
@@ -490,7 +527,7 @@ func TestPools_PairWithStats_HasCorrectValues(t *testing.T) {
 	generateStats(t, db, time.Now().Truncate(time.Hour).Add(-30*time.Minute))
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	pools, err := d.Pools()
+	pools, err := d.Pools(context.Background())
 	require.NoError(t, err)
 	require.NotNil(t, pools)
 
@@ -526,7 +563,7 @@ func TestTxs_SwapWithTransfer_TransferHidden(t *testing.T) {
 	generateParsedTxWithType(t, db, ts, "transfer", hash, testPairContractAddr2, "axpla", "3000", testTokenAddr, "4000")
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	txs, err := d.Txs(TX_TYPE_ALL)
+	txs, err := d.Txs(context.Background(), TX_TYPE_ALL)
 	require.NoError(t, err)
 	require.Len(t, txs, 1, "only the swap row should appear; transfer row should be hidden")
 
@@ -559,7 +596,7 @@ VALUES(1, ?, ?, 9, ?, 1, ?)
 `, testChainID, testTokenID2, testTokenID1, txID+1).Error)
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	txs, err := d.Txs(TX_TYPE_ALL)
+	txs, err := d.Txs(context.Background(), TX_TYPE_ALL)
 	require.NoError(t, err)
 	require.Len(t, txs, 1)
 
@@ -579,7 +616,7 @@ func TestTxs_TransferOnly_ExcludedRegardlessOfAssets(t *testing.T) {
 	generateParsedTxWithType(t, db, ts, "transfer", hash, testPairContractAddr2, testTokenAddr, "3000", "axpla", "4000")
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	txs, err := d.Txs(TX_TYPE_ALL)
+	txs, err := d.Txs(context.Background(), TX_TYPE_ALL)
 	require.NoError(t, err)
 	assert.Empty(t, txs, "transfer-only hashes should be excluded from TX_TYPE_ALL results")
 }
@@ -595,7 +632,7 @@ func TestTxs_TimestampDescOrder(t *testing.T) {
 	generateParsedTxWithType(t, db, now, "swap", "hash_new", testPairContractAddr2, "axpla", "500", testTokenAddr, "600")
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	txs, err := d.Txs(TX_TYPE_ALL)
+	txs, err := d.Txs(context.Background(), TX_TYPE_ALL)
 	require.NoError(t, err)
 	require.Len(t, txs, 3)
 
@@ -617,7 +654,7 @@ func TestTxsOfToken_TimestampDescOrder(t *testing.T) {
 	generateParsedTxWithType(t, db, now, "swap", "token_hash_new", testPairContractAddr2, "axpla", "500", testTokenAddr, "600")
 
 	d := &dashboard{DB: db, chainId: testChainID}
-	txs, err := d.TxsOfToken(TX_TYPE_ALL, Addr(testTokenAddr))
+	txs, err := d.TxsOfToken(context.Background(), TX_TYPE_ALL, Addr(testTokenAddr))
 	require.NoError(t, err)
 	require.Len(t, txs, 3)
 
@@ -75,6 +75,8 @@ type Token struct {
 	Addr           Addr
 	Price          string
 	PriceChange    float32
+	MarketCap      string
+	Fdv            string
 	Volume         string
 	VolumeChange   string
 	Volume7d       string
@@ -1,33 +1,39 @@
 package dashboard
 
+import "context"
+
 type Dashboard interface {
-	Recent() (Recent, error)
-	RecentOf(addr Addr) (Recent, error)
+	Recent(ctx context.Context) (Recent, error)
+	RecentOf(ctx context.Context, addr Addr) (Recent, error)
+
+	Statistic(ctx context.Context, addr ...Addr) (Statistic, error)
 
-	Statistic(...Addr) (Statistic, error)
+	Pools(ctx context.Context, tokens ...Addr) (Pools, error)
+	PoolDetail(ctx context.Context, addr Addr) (PoolDetail, error)
 
-	Pools(tokens ...Addr) (Pools, error)
-	PoolDetail(Addr) (PoolDetail, error)
+	Tokens(ctx context.Context) (Tokens, error)
+	Token(ctx context.Context, addr Addr) (Token, error)
 
-	Tokens() (Tokens, error)
-	Token(addr Addr) (Token, error)
+	TokenVolumes(ctx context.Context, addr Addr, itv Duration) (TokenChart, error)
+	TokenTvls(ctx context.Context, addr Addr, itv Duration) (TokenChart, error)
+	TokenPrices(ctx context.Context, addr Addr, itv Duration) (TokenChart, error)
 
-	TokenVolumes(addr Addr, itv Duration) (TokenChart, error)
-	TokenTvls(addr Addr, itv Duration) (TokenChart, error)
-	TokenPrices(addr Addr, itv Duration) (TokenChart, error)
+	Txs(ctx context.Context, txType TxType, addr ...Addr) (Txs, error)
+	TxsOfToken(ctx context.Context, txType TxType, tokenAddrs ...Addr) (Txs, error)
 
-	Txs(txType TxType, addr ...Addr) (Txs, error)
-	TxsOfToken(txType TxType, tokenAddrs ...Addr) (Txs, error)
+	Volumes(ctx context.Context, itv Duration) (Volumes, error)
+	VolumesOf(ctx context.Context, addr Addr, itv Duration) (Volumes, error)
 
-	Volumes(Duration) (Volumes, error)
-	VolumesOf(Addr, Duration) (Volumes, error)
+	Fees(ctx context.Context, itv Duration) (Fees, error)
+	FeesOf(ctx context.Context, addr Addr, itv Duration) (Fees, error)
 
-	Fees(Duration) (Fees, error)
-	FeesOf(Addr, Duration) (Fees, error)
+	Tvls(ctx context.Context, itv Duration) (Tvls, error)
+	TvlsOf(ctx context.Context, addr Addr, itv Duration) (Tvls, error)
 
-	Tvls(Duration) (Tvls, error)
-	TvlsOf(Addr, Duration) (Tvls, error)
+	// DailyVolumes and DailyTvls are of the whole history by the UTC day, the days without stats included
+	DailyVolumes(ctx context.Context) (Volumes, error)
+	DailyTvls(ctx context.Context) (Tvls, error)
 
-	Aprs(Duration) (Aprs, error)
-	AprsOf(Addr, Duration) (Aprs, error)
+	Aprs(ctx context.Context, itv Duration) (Aprs, error)
+	AprsOf(ctx context.Context, addr Addr, itv Duration) (Aprs, error)
 }
@@ -0,0 +1,183 @@
+package depth
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/dezswap/dezswap-api/pkg/dezswap"
+	"github.com/pkg/errors"
+)
+
+var ErrPoolNotFound = errors.New("pool not found")
+
+type Pool struct {
+	Address      string
+	Asset0       string
+	Asset0Amount math.Int
+	Asset1       string
+	Asset1Amount math.Int
+	// Liquidity1InPrice is the latest value of the asset1 reserve in the price token
+	Liquidity1InPrice math.LegacyDec
+	Height            uint64
+}
+
+type Repo interface {
+	// Pools returns the latest pools of the pairs, every pool when no pair is given
+	Pools(ctx context.Context, pairs ...string) ([]Pool, error)
+}
+
+// Level is the amounts which move the price of asset0 in asset1 by the percentage along the constant product curve
+type Level struct {
+	// Percentage is positive for the ask side, where asset1 is offered for asset0, and negative for the bid side
+	Percentage math.LegacyDec
+	// Asset0Amount is returned from the pool on the ask side and offered on the bid side
+	Asset0Amount math.Int
+	// Asset1Amount is offered on the ask side and returned from the pool on the bid side
+	Asset1Amount math.Int
+	// ValueInPrice is the value of Asset1Amount in the price token at the current reserves
+	ValueInPrice math.LegacyDec
+}
+
+type Depth struct {
+	Pair   string
+	Asset0 string
+	Asset1 string
+	// SpreadPercentage is the gap between the marginal ask and bid prices made by the commission, relative to the ask
+	SpreadPercentage math.LegacyDec
+	// Levels are ordered by the percentage, bid side first
+	Levels []Level
+	Height uint64
+}
+
+type Service interface {
+	// Depth returns the depth of the pair at the latest reserves
+	Depth(ctx context.Context, pair string) (*Depth, error)
+	// Depths returns the depths of every pool by the pair
+	Depths(ctx context.Context) (map[string]Depth, error)
+}
+
+type depthImpl struct {
+	Repo
+	percentages    []math.LegacyDec
+	commissionRate math.LegacyDec
+}
+
+// New returns a depth Service of the percentages, each percentage must be in (0, 100)
+func New(repo Repo, percentages []math.LegacyDec) Service {
+	sorted := make([]math.LegacyDec, len(percentages))
+	copy(sorted, percentages)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+	return &depthImpl{repo, sorted, math.LegacyMustNewDecFromStr(strconv.FormatFloat(dezswap.SWAP_FEE, 'f', -1, 64))}
+}
+
+// Depth implements Service
+func (s *depthImpl) Depth(ctx context.Context, pair string) (*Depth, error) {
+	ctx, span := tracing.Start(ctx, "depth.Depth")
+	defer span.End()
+	pools, err := s.Pools(ctx, pair)
+	if err != nil {
+		return nil, errors.Wrap(err, "depth.Depth")
+	}
+	if len(pools) == 0 {
+		return nil, ErrPoolNotFound
+	}
+
+	depth, err := s.depth(pools[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "depth.Depth")
+	}
+	return depth, nil
+}
+
+// Depths implements Service
+func (s *depthImpl) Depths(ctx context.Context) (map[string]Depth, error) {
+	ctx, span := tracing.Start(ctx, "depth.Depths")
+	defer span.End()
+	pools, err := s.Pools(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "depth.Depths")
+	}
+
+	depths := make(map[string]Depth, len(pools))
+	for _, pool := range pools {
+		depth, err := s.depth(pool)
+		if err != nil {
+			return nil, errors.Wrap(err, "depth.Depths")
+		}
+		depths[pool.Address] = *depth
+	}
+	return depths, nil
+}
+
+func (s *depthImpl) depth(pool Pool) (*Depth, error) {
+	levels := make([]Level, 0, len(s.percentages)*2)
+	for i := len(s.percentages) - 1; i >= 0; i-- {
+		level, err := computeBidLevel(pool, s.percentages[i])
+		if err != nil {
+			return nil, err
+		}
+		levels = append(levels, level)
+	}
+	for _, percentage := range s.percentages {
+		level, err := computeAskLevel(pool, percentage)
+		if err != nil {
+			return nil, err
+		}
+		levels = append(levels, level)
+	}
+
+	return &Depth{
+		Pair:             pool.Address,
+		Asset0:           pool.Asset0,
+		Asset1:           pool.Asset1,
+		SpreadPercentage: spreadPercentage(s.commissionRate),
+		Levels:           levels,
+		Height:           pool.Height,
+	}, nil
+}
+
+// computeAskLevel returns the amounts raising the price by the percentage,
+// the price is y/x so the reserves become x/sqrt(1+d) and y*sqrt(1+d) keeping the product.
+// The commission is not included.
+func computeAskLevel(pool Pool, percentage math.LegacyDec) (Level, error) {
+	root, err := math.LegacyOneDec().Add(percentage.QuoInt64(100)).ApproxSqrt()
+	if err != nil {
+		return Level{}, err
+	}
+	ratio1 := root.Sub(math.LegacyOneDec())
+
+	return Level{
+		Percentage:   percentage,
+		Asset0Amount: math.LegacyOneDec().Sub(math.LegacyOneDec().Quo(root)).MulInt(pool.Asset0Amount).TruncateInt(),
+		Asset1Amount: ratio1.MulInt(pool.Asset1Amount).TruncateInt(),
+		ValueInPrice: ratio1.Mul(pool.Liquidity1InPrice),
+	}, nil
+}
+
+// computeBidLevel returns the amounts lowering the price by the percentage,
+// the reserves become x/sqrt(1-d) and y*sqrt(1-d) keeping the product.
+// The commission is not included.
+func computeBidLevel(pool Pool, percentage math.LegacyDec) (Level, error) {
+	root, err := math.LegacyOneDec().Sub(percentage.QuoInt64(100)).ApproxSqrt()
+	if err != nil {
+		return Level{}, err
+	}
+	ratio1 := math.LegacyOneDec().Sub(root)
+
+	return Level{
+		Percentage:   percentage.Neg(),
+		Asset0Amount: math.LegacyOneDec().Quo(root).Sub(math.LegacyOneDec()).MulInt(pool.Asset0Amount).TruncateInt(),
+		Asset1Amount: ratio1.MulInt(pool.Asset1Amount).TruncateInt(),
+		ValueInPrice: ratio1.Mul(pool.Liquidity1InPrice),
+	}, nil
+}
+
+// spreadPercentage returns (ask - bid) / ask of the marginal prices, p/(1-f) to buy and p*(1-f) to sell
+func spreadPercentage(commissionRate math.LegacyDec) math.LegacyDec {
+	bidRatio := math.LegacyOneDec().Sub(commissionRate)
+	return math.LegacyOneDec().Sub(bidRatio.Mul(bidRatio)).MulInt64(100)
+}
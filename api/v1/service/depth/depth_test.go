@@ -0,0 +1,71 @@
+package depth
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type repoMock struct {
+	mock.Mock
+}
+
+func (m *repoMock) Pools(_ context.Context, pairs ...string) ([]Pool, error) {
+	args := m.Called(pairs)
+	pools, _ := args.Get(0).([]Pool)
+	return pools, args.Error(1)
+}
+
+func TestDepth_Depth(t *testing.T) {
+	pool := Pool{
+		Address:           "pair",
+		Asset0:            "asset0",
+		Asset0Amount:      math.NewInt(1_000_000),
+		Asset1:            "asset1",
+		Asset1Amount:      math.NewInt(4_000_000),
+		Liquidity1InPrice: math.LegacyNewDec(4),
+		Height:            100,
+	}
+	repo := &repoMock{}
+	repo.On("Pools", []string{"pair"}).Return([]Pool{pool}, nil).Once()
+	s := New(repo, []math.LegacyDec{math.LegacyNewDec(5), math.LegacyNewDec(2)})
+
+	actual, err := s.Depth(context.Background(), "pair")
+
+	require.NoError(t, err)
+	require.Equal(t, "0.599100000000000000", actual.SpreadPercentage.String())
+	require.Equal(t, uint64(100), actual.Height)
+
+	expected := []struct {
+		percentage   string
+		asset0Amount int64
+		asset1Amount int64
+	}{
+		{"-5.000000000000000000", 25978, 101282},
+		{"-2.000000000000000000", 10152, 40202},
+		{"2.000000000000000000", 9852, 39801},
+		{"5.000000000000000000", 24099, 98780},
+	}
+	require.Len(t, actual.Levels, len(expected))
+	for i, e := range expected {
+		level := actual.Levels[i]
+		require.Equal(t, e.percentage, level.Percentage.String())
+		require.Equal(t, math.NewInt(e.asset0Amount), level.Asset0Amount)
+		require.Equal(t, math.NewInt(e.asset1Amount), level.Asset1Amount)
+		// the price token value follows the asset1 amount at the current reserves
+		require.True(t, level.ValueInPrice.Sub(math.LegacyNewDecFromInt(level.Asset1Amount).QuoInt64(1_000_000)).Abs().LT(math.LegacyNewDecWithPrec(1, 6)))
+	}
+}
+
+func TestDepth_DepthNotFound(t *testing.T) {
+	repo := &repoMock{}
+	repo.On("Pools", []string{"pair"}).Return([]Pool{}, nil).Once()
+	s := New(repo, []math.LegacyDec{math.LegacyNewDec(2)})
+
+	_, err := s.Depth(context.Background(), "pair")
+
+	require.ErrorIs(t, err, ErrPoolNotFound)
+}
@@ -0,0 +1,292 @@
+package dexscreener
+
+import (
+	"context"
+	"sync"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/dezswap/dezswap-api/api/v1/service"
+	"github.com/dezswap/dezswap-api/pkg"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// Service serves the adapter of DEX Screener, the blocks are bounded by the synced height of the parser
+// so that every event up to the latest block is served
+type Service interface {
+	// LatestBlock is the synced block timed by the nodes, the latest block of a tx without the nodes
+	LatestBlock(ctx context.Context) (*Block, error)
+	// Asset returns nil when the token is unknown
+	Asset(ctx context.Context, id string) (*Asset, error)
+	// Pair returns nil when the pair is unknown
+	Pair(ctx context.Context, id string) (*Pair, error)
+	// Events returns the swaps, joins and exits between the blocks inclusive in the order of the block,
+	// it fails while the reserves of an event are not indexed yet
+	Events(ctx context.Context, fromBlock, toBlock uint64) ([]Event, error)
+}
+
+type dexScreenerService struct {
+	chainId string
+	*gorm.DB
+	supplies service.Getter[service.TokenSupply]
+	clients  []pkg.GrpcClient
+
+	// the time of the synced block is queried once a height
+	mu    sync.Mutex
+	block Block
+}
+
+type eventRow struct {
+	Height         uint64
+	Timestamp      float64
+	Hash           string
+	Type           string
+	Sender         string
+	Contract       string
+	Asset0Amount   string
+	Asset1Amount   string
+	Reserve0       *string
+	Reserve1       *string
+	Asset0Decimals int
+	Asset1Decimals int
+}
+
+// NewService returns a Service which times the blocks by the clients in order until one succeeds, clients are optional
+func NewService(chainId string, db *gorm.DB, supplies service.Getter[service.TokenSupply], clients []pkg.GrpcClient) Service {
+	return &dexScreenerService{chainId: chainId, DB: db, supplies: supplies, clients: clients}
+}
+
+// LatestBlock implements Service
+func (s *dexScreenerService) LatestBlock(ctx context.Context) (*Block, error) {
+	ctx, span := tracing.Start(ctx, "dexScreenerService.LatestBlock")
+	defer span.End()
+	if len(s.clients) == 0 {
+		return s.latestTxBlock(ctx)
+	}
+
+	heights := []uint64{}
+	if err := s.WithContext(ctx).Table("synced_height").
+		Where("chain_id = ?", s.chainId).
+		Order("height desc").
+		Limit(1).
+		Pluck("height", &heights).Error; err != nil {
+		return nil, errors.Wrap(err, "dexScreenerService.LatestBlock")
+	}
+	if len(heights) == 0 {
+		return nil, errors.New("dexScreenerService.LatestBlock: no synced height")
+	}
+
+	s.mu.Lock()
+	block := s.block
+	s.mu.Unlock()
+	if block.BlockNumber == heights[0] {
+		return &block, nil
+	}
+
+	var lastErr error = errors.New("grpc client is not configured")
+	for _, client := range s.clients {
+		blockTime, err := client.BlockTime(heights[0])
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		block = Block{BlockNumber: heights[0], BlockTimestamp: blockTime.Unix()}
+		s.mu.Lock()
+		s.block = block
+		s.mu.Unlock()
+		return &block, nil
+	}
+	return nil, errors.Wrap(lastErr, "dexScreenerService.LatestBlock")
+}
+
+// latestTxBlock is the latest synced block of a tx, of which the time is of the tx
+func (s *dexScreenerService) latestTxBlock(ctx context.Context) (*Block, error) {
+	query := `
+select pt.height block_number,
+       cast(pt.timestamp as bigint) block_timestamp
+from parsed_tx pt
+where pt.chain_id = ?
+  and pt.height <= (select max(height) from synced_height where chain_id = ?)
+order by pt.height desc
+limit 1
+`
+	blocks := []Block{}
+	if err := s.WithContext(ctx).Raw(query, s.chainId, s.chainId).Scan(&blocks).Error; err != nil {
+		return nil, errors.Wrap(err, "dexScreenerService.latestTxBlock")
+	}
+	if len(blocks) == 0 {
+		return nil, errors.New("dexScreenerService.latestTxBlock: no synced tx")
+	}
+	return &blocks[0], nil
+}
+
+// Asset implements Service
+func (s *dexScreenerService) Asset(ctx context.Context, id string) (*Asset, error) {
+	ctx, span := tracing.Start(ctx, "dexScreenerService.Asset")
+	defer span.End()
+	assets := []Asset{}
+	if err := s.WithContext(ctx).Table("tokens").
+		Where("chain_id = ? and address = ?", s.chainId, id).
+		Select("address id, name, symbol").
+		Limit(1).
+		Scan(&assets).Error; err != nil {
+		return nil, errors.Wrap(err, "dexScreenerService.Asset")
+	}
+	if len(assets) == 0 {
+		return nil, nil
+	}
+
+	asset := assets[0]
+	supply, err := s.supplies.Get(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "dexScreenerService.Asset")
+	}
+	if supply != nil {
+		asset.TotalSupply = supply.TotalSupply
+		asset.CirculatingSupply = supply.CirculatingSupply
+	}
+	return &asset, nil
+}
+
+// Pair implements Service
+func (s *dexScreenerService) Pair(ctx context.Context, id string) (*Pair, error) {
+	ctx, span := tracing.Start(ctx, "dexScreenerService.Pair")
+	defer span.End()
+	query := `
+select p.contract id,
+       p.asset0 asset0_id,
+       p.asset1 asset1_id,
+       coalesce(c.height, 0) created_at_block_number,
+       coalesce(cast(c.timestamp as bigint), 0) created_at_block_timestamp,
+       coalesce(c.hash, '') created_at_txn_id,
+       coalesce(c.sender, '') creator
+from pair p
+    left join lateral (select height, timestamp, hash, sender
+                       from parsed_tx
+                       where chain_id = p.chain_id and contract = p.contract and type = 'create_pair'
+                       order by id
+                       limit 1) c on true
+where p.chain_id = ? and p.contract = ?
+`
+	pairs := []Pair{}
+	if err := s.WithContext(ctx).Raw(query, s.chainId, id).Scan(&pairs).Error; err != nil {
+		return nil, errors.Wrap(err, "dexScreenerService.Pair")
+	}
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+	return &pairs[0], nil
+}
+
+// Events implements Service
+func (s *dexScreenerService) Events(ctx context.Context, fromBlock, toBlock uint64) ([]Event, error) {
+	ctx, span := tracing.Start(ctx, "dexScreenerService.Events")
+	defer span.End()
+	query := `
+select pt.height, pt.timestamp, pt.hash, pt.type, pt.sender, pt.contract,
+       pt.asset0_amount::text asset0_amount,
+       pt.asset1_amount::text asset1_amount,
+       pi.asset0_amount::text reserve0,
+       pi.asset1_amount::text reserve1,
+       t0.decimals asset0_decimals,
+       t1.decimals asset1_decimals
+from parsed_tx pt
+    join pair p on p.chain_id = pt.chain_id and p.contract = pt.contract
+    join tokens t0 on t0.chain_id = p.chain_id and t0.address = p.asset0
+    join tokens t1 on t1.chain_id = p.chain_id and t1.address = p.asset1
+    left join lateral (select asset0_amount, asset1_amount
+                       from pool_info
+                       where chain_id = pt.chain_id and contract = pt.contract and height <= pt.height
+                       order by height desc
+                       limit 1) pi on true
+where pt.chain_id = ?
+  and pt.height between ? and ?
+  and pt.type in ('swap', 'provide', 'initial_provide', 'withdraw')
+order by pt.height, pt.id
+`
+	rows := []eventRow{}
+	if err := s.WithContext(ctx).Raw(query, s.chainId, fromBlock, toBlock).Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "dexScreenerService.Events")
+	}
+
+	events := make([]Event, 0, len(rows))
+	// the indexes are not stored, they are derived from the order of the txs in the block and the events in the tx
+	var txnIndex, eventIndex int
+	for i, row := range rows {
+		if i == 0 || row.Height != rows[i-1].Height {
+			txnIndex, eventIndex = 0, 0
+		} else if row.Hash != rows[i-1].Hash {
+			txnIndex, eventIndex = txnIndex+1, 0
+		} else {
+			eventIndex++
+		}
+
+		event, err := rowToEvent(row)
+		if err != nil {
+			return nil, errors.Wrap(err, "dexScreenerService.Events")
+		}
+		event.TxnIndex = txnIndex
+		event.EventIndex = eventIndex
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func rowToEvent(row eventRow) (Event, error) {
+	amount0, ok := math.NewIntFromString(row.Asset0Amount)
+	if !ok {
+		return Event{}, errors.Errorf("invalid asset0 amount(%s) of tx(%s)", row.Asset0Amount, row.Hash)
+	}
+	amount1, ok := math.NewIntFromString(row.Asset1Amount)
+	if !ok {
+		return Event{}, errors.Errorf("invalid asset1 amount(%s) of tx(%s)", row.Asset1Amount, row.Hash)
+	}
+	// the pool of the pair is not indexed up to the tx yet
+	if row.Reserve0 == nil || row.Reserve1 == nil {
+		return Event{}, errors.Errorf("no reserves of pair(%s) at height(%d)", row.Contract, row.Height)
+	}
+	reserve0, ok := math.NewIntFromString(*row.Reserve0)
+	if !ok {
+		return Event{}, errors.Errorf("invalid reserve0(%s) of pair(%s)", *row.Reserve0, row.Contract)
+	}
+	reserve1, ok := math.NewIntFromString(*row.Reserve1)
+	if !ok {
+		return Event{}, errors.Errorf("invalid reserve1(%s) of pair(%s)", *row.Reserve1, row.Contract)
+	}
+
+	event := Event{
+		Block:    Block{BlockNumber: row.Height, BlockTimestamp: int64(row.Timestamp)},
+		TxnId:    row.Hash,
+		Maker:    row.Sender,
+		PairId:   row.Contract,
+		Reserve0: math.LegacyNewDecFromIntWithPrec(reserve0, int64(row.Asset0Decimals)),
+		Reserve1: math.LegacyNewDecFromIntWithPrec(reserve1, int64(row.Asset1Decimals)),
+	}
+	value0 := math.LegacyNewDecFromIntWithPrec(amount0.Abs(), int64(row.Asset0Decimals))
+	value1 := math.LegacyNewDecFromIntWithPrec(amount1.Abs(), int64(row.Asset1Decimals))
+
+	switch row.Type {
+	case "swap":
+		// the amounts are signed from the pool, the offered asset is positive
+		event.EventType = EventTypeSwap
+		event.Asset0In, event.Asset1In = math.LegacyZeroDec(), math.LegacyZeroDec()
+		event.Asset0Out, event.Asset1Out = math.LegacyZeroDec(), math.LegacyZeroDec()
+		if amount0.IsPositive() {
+			event.Asset0In, event.Asset1Out = value0, value1
+		} else {
+			event.Asset1In, event.Asset0Out = value1, value0
+		}
+		event.PriceNative = math.LegacyZeroDec()
+		if !value0.IsZero() {
+			event.PriceNative = value1.Quo(value0)
+		}
+	case "withdraw":
+		event.EventType = EventTypeExit
+		event.Amount0, event.Amount1 = value0, value1
+	default:
+		event.EventType = EventTypeJoin
+		event.Amount0, event.Amount1 = value0, value1
+	}
+	return event, nil
+}
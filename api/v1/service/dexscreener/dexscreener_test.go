@@ -0,0 +1,143 @@
+package dexscreener
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/dezswap/dezswap-api/pkg"
+	xpla_mock "github.com/dezswap/dezswap-api/pkg/xpla/mock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var eventColumns = []string{"height", "timestamp", "hash", "type", "sender", "contract", "asset0_amount", "asset1_amount", "reserve0", "reserve1", "asset0_decimals", "asset1_decimals"}
+
+func TestService_Events(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	s := NewService("test-chain", gormDB, nil, nil)
+
+	mock.ExpectQuery(`from parsed_tx pt`).WithArgs("test-chain", 100, 101).
+		WillReturnRows(sqlmock.NewRows(eventColumns).
+			AddRow(100, 1_700_000_000.0, "tx1", "swap", "maker", "pair", "1000000", "-2000000000000000000", "10000000", "20000000000000000000", 6, 18).
+			AddRow(100, 1_700_000_000.0, "tx1", "provide", "maker", "pair", "1000000", "2000000000000000000", "10000000", "20000000000000000000", 6, 18).
+			AddRow(100, 1_700_000_000.0, "tx2", "swap", "taker", "pair", "-1000000", "3000000000000000000", "10000000", "20000000000000000000", 6, 18).
+			AddRow(101, 1_700_000_006.0, "tx3", "withdraw", "maker", "pair", "-1000000", "-2000000000000000000", "0", "0", 6, 18))
+
+	events, err := s.Events(context.Background(), 100, 101)
+
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+
+	indexes := [][2]int{}
+	for _, e := range events {
+		indexes = append(indexes, [2]int{e.TxnIndex, e.EventIndex})
+	}
+	require.Equal(t, [][2]int{{0, 0}, {0, 1}, {1, 0}, {0, 0}}, indexes)
+
+	sell := events[0]
+	require.Equal(t, EventTypeSwap, sell.EventType)
+	require.Equal(t, "1.000000000000000000", sell.Asset0In.String())
+	require.Equal(t, "2.000000000000000000", sell.Asset1Out.String())
+	require.True(t, sell.Asset1In.IsZero())
+	require.Equal(t, "2.000000000000000000", sell.PriceNative.String())
+	require.Equal(t, "10.000000000000000000", sell.Reserve0.String())
+	require.Equal(t, "20.000000000000000000", sell.Reserve1.String())
+
+	require.Equal(t, EventTypeJoin, events[1].EventType)
+	require.Equal(t, "1.000000000000000000", events[1].Amount0.String())
+
+	buy := events[2]
+	require.Equal(t, "3.000000000000000000", buy.Asset1In.String())
+	require.Equal(t, "1.000000000000000000", buy.Asset0Out.String())
+	require.Equal(t, "3.000000000000000000", buy.PriceNative.String())
+
+	exit := events[3]
+	require.Equal(t, EventTypeExit, exit.EventType)
+	require.Equal(t, "2.000000000000000000", exit.Amount1.String())
+	require.Equal(t, int64(1_700_000_006), exit.Block.BlockTimestamp)
+	require.True(t, exit.Reserve0.IsZero())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestService_Events_NoReserves(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	s := NewService("test-chain", gormDB, nil, nil)
+
+	// the pool of the pair is not indexed up to the tx
+	mock.ExpectQuery(`from parsed_tx pt`).WithArgs("test-chain", 100, 100).
+		WillReturnRows(sqlmock.NewRows(eventColumns).
+			AddRow(100, 1_700_000_000.0, "tx1", "swap", "maker", "pair", "1000000", "-2000000000000000000", nil, nil, 6, 18))
+
+	_, err = s.Events(context.Background(), 100, 100)
+
+	require.ErrorContains(t, err, "no reserves of pair(pair) at height(100)")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestService_LatestBlock(t *testing.T) {
+	newService := func(t *testing.T, clients ...pkg.GrpcClient) (Service, sqlmock.Sqlmock) {
+		sqlDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() { sqlDB.Close() })
+		gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+		require.NoError(t, err)
+		return NewService("test-chain", gormDB, nil, clients), mock
+	}
+
+	t.Run("timed by the nodes", func(t *testing.T) {
+		failing, node := xpla_mock.NewGrpcClientMock(), xpla_mock.NewGrpcClientMock()
+		failing.On("BlockTime", uint64(120)).Return(time.Time{}, errors.New("unavailable")).Once()
+		node.On("BlockTime", uint64(120)).Return(time.Unix(1_700_000_120, 0), nil).Once()
+		s, mock := newService(t, failing, node)
+		for range 2 {
+			mock.ExpectQuery(`FROM "synced_height"`).WithArgs("test-chain").
+				WillReturnRows(sqlmock.NewRows([]string{"height"}).AddRow(120))
+		}
+
+		for range 2 {
+			block, err := s.LatestBlock(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, Block{BlockNumber: 120, BlockTimestamp: 1_700_000_120}, *block)
+		}
+		// the time of a height is queried once
+		node.AssertExpectations(t)
+		failing.AssertExpectations(t)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("every node fails", func(t *testing.T) {
+		node := xpla_mock.NewGrpcClientMock()
+		node.On("BlockTime", uint64(120)).Return(time.Time{}, errors.New("unavailable"))
+		s, mock := newService(t, node)
+		mock.ExpectQuery(`FROM "synced_height"`).WithArgs("test-chain").
+			WillReturnRows(sqlmock.NewRows([]string{"height"}).AddRow(120))
+
+		_, err := s.LatestBlock(context.Background())
+
+		require.ErrorContains(t, err, "unavailable")
+	})
+
+	t.Run("latest tx block without the nodes", func(t *testing.T) {
+		s, mock := newService(t)
+		mock.ExpectQuery(`from parsed_tx pt`).WithArgs("test-chain", "test-chain").
+			WillReturnRows(sqlmock.NewRows([]string{"block_number", "block_timestamp"}).AddRow(118, 1_700_000_108))
+
+		block, err := s.LatestBlock(context.Background())
+
+		require.NoError(t, err)
+		require.Equal(t, Block{BlockNumber: 118, BlockTimestamp: 1_700_000_108}, *block)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,59 @@
+package dexscreener
+
+import "cosmossdk.io/math"
+
+type EventType string
+
+const (
+	EventTypeSwap EventType = "swap"
+	EventTypeJoin EventType = "join"
+	EventTypeExit EventType = "exit"
+)
+
+type Block struct {
+	BlockNumber uint64
+	// BlockTimestamp is in seconds
+	BlockTimestamp int64
+}
+
+type Asset struct {
+	Id                string
+	Name              string
+	Symbol            string
+	TotalSupply       string
+	CirculatingSupply string
+}
+
+type Pair struct {
+	Id       string
+	Asset0Id string
+	Asset1Id string
+	// the creation is unknown when the creating tx is not parsed
+	CreatedAtBlockNumber    uint64
+	CreatedAtBlockTimestamp int64
+	CreatedAtTxnId          string
+	Creator                 string
+}
+
+// Event is a swap, join or exit of a pair, the amounts have the decimals applied
+type Event struct {
+	Block      Block
+	EventType  EventType
+	TxnId      string
+	TxnIndex   int
+	EventIndex int
+	Maker      string
+	PairId     string
+	// Asset0In, Asset1In, Asset0Out, Asset1Out and PriceNative are of the swaps only
+	Asset0In    math.LegacyDec
+	Asset1In    math.LegacyDec
+	Asset0Out   math.LegacyDec
+	Asset1Out   math.LegacyDec
+	PriceNative math.LegacyDec
+	// Amount0 and Amount1 are of the joins and the exits only
+	Amount0 math.LegacyDec
+	Amount1 math.LegacyDec
+	// Reserve0 and Reserve1 are the reserves of the pair at the end of the block
+	Reserve0 math.LegacyDec
+	Reserve1 math.LegacyDec
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"time"
+
 	"github.com/dezswap/dezswap-api/pkg/db/indexer"
 )
 
@@ -34,3 +36,29 @@ const (
 )
 
 type PairStats []PairStat
+
+// TokenSupply is the latest supply of a token normalized by its decimals
+type TokenSupply struct {
+	Address           string
+	TotalSupply       string
+	CirculatingSupply string
+}
+
+// IndexerStatus is the progress of the parser and of the indexer of the latest pools
+type IndexerStatus struct {
+	// SyncedHeight is the last height synced by the parser
+	SyncedHeight uint64
+	// SyncedAt is when the synced height was seen first at its height, zero when there is none
+	SyncedAt time.Time
+	// PoolHeight is the newest height of the latest pools
+	PoolHeight uint64
+	// PoolsBehindSince is the block time of the oldest pool change synced above the pool height, zero when the pools are up to date
+	PoolsBehindSince time.Time
+}
+
+// RouteStatus is the state of the route table of the aggregator
+type RouteStatus struct {
+	Count int64
+	// ModifiedAt is when a route was modified last, zero when there is none
+	ModifiedAt time.Time
+}
@@ -1,11 +1,22 @@
 package service
 
+import (
+	"context"
+	"time"
+)
+
 type Getter[T any] interface {
-	Get(key string) (*T, error)
-	GetAll() ([]T, error)
+	Get(ctx context.Context, key string) (*T, error)
+	GetAll(ctx context.Context) ([]T, error)
 }
 
 type StatusService interface {
-	CheckDB() error
-	CheckCache() error
+	// CheckDB returns the round trip time of a statement to the DB
+	CheckDB() (time.Duration, error)
+	// CheckCache returns the round trip time of a ping to the cache
+	CheckCache() (time.Duration, error)
+	Indexer() (*IndexerStatus, error)
+	Routes() (*RouteStatus, error)
+	// LastPriceFetch is when the USD prices were fetched last, zero when they never were
+	LastPriceFetch() time.Time
 }
@@ -1,13 +1,16 @@
 package notice
 
 import (
+	"context"
+
+	"github.com/dezswap/dezswap-api/api/tracing"
 	models "github.com/dezswap/dezswap-api/pkg/db/api"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
 type Notice interface {
-	Notices(chain string, startTs int64, cond PaginationCond) ([]NoticeItem, error)
+	Notices(ctx context.Context, chain string, startTs int64, cond PaginationCond) ([]NoticeItem, error)
 }
 
 type notice struct {
@@ -21,10 +24,12 @@ func NewService(db *gorm.DB) Notice {
 }
 
 // Notices implements Notice.
-func (n *notice) Notices(chain string, startTs int64, cond PaginationCond) ([]NoticeItem, error) {
+func (n *notice) Notices(ctx context.Context, chain string, startTs int64, cond PaginationCond) ([]NoticeItem, error) {
+	ctx, span := tracing.Start(ctx, "notice.Notices")
+	defer span.End()
 	cond.Trim()
 
-	query := n.DB.Model(&models.Notice{}).Select(
+	query := n.DB.WithContext(ctx).Model(&models.Notice{}).Select(
 		"id, title, description, date AT TIME ZONE 'UTC' as date, chain").Where(
 		"created_at >= to_timestamp(?) at time zone 'UTC'", startTs)
 	if chain != "" {
@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+
+	"github.com/dezswap/dezswap-api/api/tracing"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
@@ -17,10 +20,12 @@ func NewPairService(chainId string, db *gorm.DB) Getter[Pair] {
 }
 
 // Get implements Getter
-func (s *pairService) Get(key string) (*Pair, error) {
+func (s *pairService) Get(ctx context.Context, key string) (*Pair, error) {
+	ctx, span := tracing.Start(ctx, "pairService.Get")
+	defer span.End()
 	pair := &Pair{}
 	// pairs := []map[string]interface{}{}
-	if err := s.Table("pair as P").Joins(
+	if err := s.WithContext(ctx).Table("pair as P").Joins(
 		"INNER JOIN tokens AS T0 on T0.address = P.asset0 and T0.chain_id = P.chain_id",
 	).Joins(
 		"INNER JOIN tokens AS T1 on T1.address = P.asset1 and T1.chain_id = P.chain_id",
@@ -46,10 +51,12 @@ func (s *pairService) Get(key string) (*Pair, error) {
 }
 
 // GetAll implements Getter
-func (s *pairService) GetAll() ([]Pair, error) {
+func (s *pairService) GetAll(ctx context.Context) ([]Pair, error) {
+	ctx, span := tracing.Start(ctx, "pairService.GetAll")
+	defer span.End()
 	pairs := []Pair{}
 	// pairs := []map[string]interface{}{}
-	if err := s.Table("pair as P").Joins(
+	if err := s.WithContext(ctx).Table("pair as P").Joins(
 		"INNER JOIN tokens AS T0 on T0.address = P.asset0 and T0.chain_id = P.chain_id",
 	).Joins(
 		"INNER JOIN tokens AS T1 on T1.address = P.asset1 and T1.chain_id = P.chain_id",
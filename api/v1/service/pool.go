@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+
+	"github.com/dezswap/dezswap-api/api/tracing"
 	"github.com/dezswap/dezswap-api/pkg/db/indexer"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -18,9 +21,11 @@ func NewPoolService(chainId string, db *gorm.DB) Getter[Pool] {
 }
 
 // Get implements Getter
-func (s *poolService) Get(key string) (*Pool, error) {
+func (s *poolService) Get(ctx context.Context, key string) (*Pool, error) {
+	ctx, span := tracing.Start(ctx, "poolService.Get")
+	defer span.End()
 	pool := &indexer.LatestPool{}
-	if err := s.Model(&indexer.LatestPool{}).Where("chain_id = ? and address = ?", s.chainId, key).Omit("id,created_at,updated_at,deleted_at").Find(pool).Error; err != nil {
+	if err := s.WithContext(ctx).Model(&indexer.LatestPool{}).Where("chain_id = ? and address = ?", s.chainId, key).Omit("id,created_at,updated_at,deleted_at").Find(pool).Error; err != nil {
 		return nil, errors.Wrap(err, "PoolService.Get")
 	}
 	if pool.Address != key {
@@ -30,9 +35,11 @@ func (s *poolService) Get(key string) (*Pool, error) {
 }
 
 // GetAll implements Getter
-func (s *poolService) GetAll() ([]Pool, error) {
+func (s *poolService) GetAll(ctx context.Context) ([]Pool, error) {
+	ctx, span := tracing.Start(ctx, "poolService.GetAll")
+	defer span.End()
 	pools := []indexer.LatestPool{}
-	if err := s.Model(&indexer.LatestPool{}).Where("chain_id = ?", s.chainId).Omit("id,created_at,updated_at,deleted_at").Order("id").Find(&pools).Error; err != nil {
+	if err := s.WithContext(ctx).Model(&indexer.LatestPool{}).Where("chain_id = ?", s.chainId).Omit("id,created_at,updated_at,deleted_at").Order("id").Find(&pools).Error; err != nil {
 		return nil, errors.Wrap(err, "PoolService.GetAll")
 	}
 	return pools, nil
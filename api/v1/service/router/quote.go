@@ -0,0 +1,96 @@
+package router
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
+	ss "github.com/dezswap/dezswap-api/api/v1/service/simulate"
+	"github.com/pkg/errors"
+)
+
+type Quote struct {
+	HopCount int
+	ss.RouteSimulation
+}
+
+type Quoter interface {
+	// Quotes simulates the amount over every route from the offer asset to the ask asset and returns them by the return amount descending.
+	// Routes which can't be swapped on the current reserves are left out.
+	Quotes(ctx context.Context, from, to string, hopCount int, amount math.Int) ([]Quote, error)
+	// ReverseQuotes simulates the offer amount required to receive askAmount over every route and returns them by the offer amount ascending.
+	// It returns ss.ErrInsufficientReserves when no route has enough reserves to satisfy askAmount.
+	ReverseQuotes(ctx context.Context, from, to string, hopCount int, askAmount math.Int) ([]Quote, error)
+}
+
+type quoterImpl struct {
+	Router
+	ss.Simulator
+}
+
+func NewQuoter(router Router, simulator ss.Simulator) Quoter {
+	return &quoterImpl{router, simulator}
+}
+
+// Quotes implements Quoter
+func (q *quoterImpl) Quotes(ctx context.Context, from, to string, hopCount int, amount math.Int) ([]Quote, error) {
+	ctx, span := tracing.Start(ctx, "quoter.Quotes")
+	defer span.End()
+	routes, err := q.Routes(from, to, hopCount)
+	if err != nil {
+		return nil, errors.Wrap(err, "quoter.Quotes")
+	}
+
+	quotes := make([]Quote, 0, len(routes))
+	for _, r := range routes {
+		simulation, err := q.SwapRoute(ctx, r.Route, amount)
+		if err != nil {
+			if errors.Is(err, ss.ErrPoolNotFound) || errors.Is(err, ss.ErrInsufficientLiquidity) {
+				continue
+			}
+			return nil, errors.Wrap(err, "quoter.Quotes")
+		}
+		quotes = append(quotes, Quote{r.HopCount, *simulation})
+	}
+
+	sort.SliceStable(quotes, func(i, j int) bool {
+		return quotes[i].ReturnAmount.GT(quotes[j].ReturnAmount)
+	})
+	return quotes, nil
+}
+
+// ReverseQuotes implements Quoter
+func (q *quoterImpl) ReverseQuotes(ctx context.Context, from, to string, hopCount int, askAmount math.Int) ([]Quote, error) {
+	ctx, span := tracing.Start(ctx, "quoter.ReverseQuotes")
+	defer span.End()
+	routes, err := q.Routes(from, to, hopCount)
+	if err != nil {
+		return nil, errors.Wrap(err, "quoter.ReverseQuotes")
+	}
+
+	quotes := make([]Quote, 0, len(routes))
+	insufficient := false
+	for _, r := range routes {
+		simulation, err := q.ReverseSwapRoute(ctx, r.Route, askAmount)
+		if err != nil {
+			if errors.Is(err, ss.ErrInsufficientReserves) || errors.Is(err, ss.ErrInsufficientLiquidity) {
+				insufficient = true
+				continue
+			}
+			if errors.Is(err, ss.ErrPoolNotFound) {
+				continue
+			}
+			return nil, errors.Wrap(err, "quoter.ReverseQuotes")
+		}
+		quotes = append(quotes, Quote{r.HopCount, *simulation})
+	}
+	if len(quotes) == 0 && insufficient {
+		return nil, ss.ErrInsufficientReserves
+	}
+
+	sort.SliceStable(quotes, func(i, j int) bool {
+		return quotes[i].OfferAmount.LT(quotes[j].OfferAmount)
+	})
+	return quotes, nil
+}
@@ -0,0 +1,127 @@
+package router
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	ss "github.com/dezswap/dezswap-api/api/v1/service/simulate"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type routerMock struct {
+	mock.Mock
+}
+
+func (m *routerMock) RoutesOfToken(addr string, hopCount int, reverse bool) ([]Route, error) {
+	args := m.Called(addr, hopCount, reverse)
+	return args.Get(0).([]Route), args.Error(1)
+}
+
+func (m *routerMock) Routes(from, to string, hopCount int) ([]Route, error) {
+	args := m.Called(from, to, hopCount)
+	return args.Get(0).([]Route), args.Error(1)
+}
+
+type simulatorMock struct {
+	mock.Mock
+}
+
+func (m *simulatorMock) Swap(_ context.Context, offer, ask string, amount math.Int) (*ss.SwapSimulation, error) {
+	args := m.Called(offer, ask, amount)
+	simulation, _ := args.Get(0).(*ss.SwapSimulation)
+	return simulation, args.Error(1)
+}
+
+func (m *simulatorMock) SwapRoute(_ context.Context, route []string, amount math.Int) (*ss.RouteSimulation, error) {
+	args := m.Called(route, amount)
+	simulation, _ := args.Get(0).(*ss.RouteSimulation)
+	return simulation, args.Error(1)
+}
+
+func (m *simulatorMock) ReverseSwap(_ context.Context, offer, ask string, askAmount math.Int) (*ss.SwapSimulation, error) {
+	args := m.Called(offer, ask, askAmount)
+	simulation, _ := args.Get(0).(*ss.SwapSimulation)
+	return simulation, args.Error(1)
+}
+
+func (m *simulatorMock) ReverseSwapRoute(_ context.Context, route []string, askAmount math.Int) (*ss.RouteSimulation, error) {
+	args := m.Called(route, askAmount)
+	simulation, _ := args.Get(0).(*ss.RouteSimulation)
+	return simulation, args.Error(1)
+}
+
+func (m *simulatorMock) Provide(_ context.Context, pair string, deposit0, deposit1 math.Int) (*ss.ProvideSimulation, error) {
+	args := m.Called(pair, deposit0, deposit1)
+	simulation, _ := args.Get(0).(*ss.ProvideSimulation)
+	return simulation, args.Error(1)
+}
+
+func (m *simulatorMock) Withdraw(_ context.Context, pair string, lpAmount math.Int) (*ss.WithdrawSimulation, error) {
+	args := m.Called(pair, lpAmount)
+	simulation, _ := args.Get(0).(*ss.WithdrawSimulation)
+	return simulation, args.Error(1)
+}
+
+func TestQuoter_Quotes(t *testing.T) {
+	router := &routerMock{}
+	simulator := &simulatorMock{}
+	q := NewQuoter(router, simulator)
+	amount := math.NewInt(100)
+
+	direct := []string{"a", "c"}
+	viaB := []string{"a", "b", "c"}
+	viaD := []string{"a", "d", "c"}
+	router.On("Routes", "a", "c", 2).Return([]Route{
+		{To: "c", HopCount: 1, Route: direct},
+		{To: "c", HopCount: 2, Route: viaB},
+		{To: "c", HopCount: 2, Route: viaD},
+	}, nil).Once()
+	simulator.On("SwapRoute", direct, amount).Return(&ss.RouteSimulation{Route: direct, ReturnAmount: math.NewInt(90)}, nil).Once()
+	simulator.On("SwapRoute", viaB, amount).Return(&ss.RouteSimulation{Route: viaB, ReturnAmount: math.NewInt(95)}, nil).Once()
+	simulator.On("SwapRoute", viaD, amount).Return(nil, ss.ErrInsufficientLiquidity).Once()
+
+	quotes, err := q.Quotes(context.Background(), "a", "c", 2, amount)
+
+	require.NoError(t, err)
+	require.Len(t, quotes, 2)
+	require.Equal(t, viaB, quotes[0].Route)
+	require.Equal(t, 2, quotes[0].HopCount)
+	require.Equal(t, direct, quotes[1].Route)
+	router.AssertExpectations(t)
+	simulator.AssertExpectations(t)
+}
+
+func TestQuoter_ReverseQuotes(t *testing.T) {
+	router := &routerMock{}
+	simulator := &simulatorMock{}
+	q := NewQuoter(router, simulator)
+	askAmount := math.NewInt(100)
+
+	direct := []string{"a", "c"}
+	viaB := []string{"a", "b", "c"}
+	routes := []Route{
+		{To: "c", HopCount: 1, Route: direct},
+		{To: "c", HopCount: 2, Route: viaB},
+	}
+	router.On("Routes", "a", "c", 2).Return(routes, nil).Twice()
+	simulator.On("ReverseSwapRoute", direct, askAmount).Return(&ss.RouteSimulation{Route: direct, OfferAmount: math.NewInt(120)}, nil).Once()
+	simulator.On("ReverseSwapRoute", viaB, askAmount).Return(&ss.RouteSimulation{Route: viaB, OfferAmount: math.NewInt(110)}, nil).Once()
+
+	quotes, err := q.ReverseQuotes(context.Background(), "a", "c", 2, askAmount)
+
+	require.NoError(t, err)
+	require.Len(t, quotes, 2)
+	require.Equal(t, viaB, quotes[0].Route)
+	require.Equal(t, direct, quotes[1].Route)
+
+	simulator.On("ReverseSwapRoute", direct, askAmount).Return(nil, ss.ErrInsufficientReserves).Once()
+	simulator.On("ReverseSwapRoute", viaB, askAmount).Return(nil, ss.ErrPoolNotFound).Once()
+
+	_, err = q.ReverseQuotes(context.Background(), "a", "c", 2, askAmount)
+
+	require.ErrorIs(t, err, ss.ErrInsufficientReserves)
+	router.AssertExpectations(t)
+	simulator.AssertExpectations(t)
+}
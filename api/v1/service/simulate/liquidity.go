@@ -0,0 +1,139 @@
+package simulate
+
+import (
+	"context"
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/dezswap/dezswap-api/pkg/dezswap"
+	"github.com/pkg/errors"
+)
+
+type ProvideSimulation struct {
+	Pair string
+	Lp   string
+	// LpAmount is the amount of lp tokens minted to the provider
+	LpAmount math.Int
+	// Share is the ratio of the minted lp tokens to the total share after the provision
+	Share         math.LegacyDec
+	Asset0        string
+	Asset0Deposit math.Int
+	Asset0Refund  math.Int
+	Asset1        string
+	Asset1Deposit math.Int
+	Asset1Refund  math.Int
+	Height        uint64
+}
+
+type WithdrawSimulation struct {
+	Pair         string
+	Lp           string
+	LpAmount     math.Int
+	Asset0       string
+	Asset0Amount math.Int
+	Asset1       string
+	Asset1Amount math.Int
+	Height       uint64
+}
+
+// Provide implements Simulator
+func (s *simulatorImpl) Provide(ctx context.Context, pair string, deposit0, deposit1 math.Int) (*ProvideSimulation, error) {
+	ctx, span := tracing.Start(ctx, "simulator.Provide")
+	defer span.End()
+	pool, err := s.PoolOf(ctx, pair)
+	if err != nil {
+		return nil, errors.Wrap(err, "simulator.Provide")
+	}
+	if pool == nil {
+		return nil, ErrPoolNotFound
+	}
+
+	share, refund0, refund1, err := computeProvide(pool.Asset0Amount, pool.Asset1Amount, pool.LpAmount, deposit0, deposit1)
+	if err != nil {
+		return nil, err
+	}
+
+	totalShare := pool.LpAmount.Add(share)
+	if pool.LpAmount.IsZero() {
+		totalShare = totalShare.AddRaw(dezswap.MINIMUM_LIQUIDITY_AMOUNT)
+	}
+
+	return &ProvideSimulation{
+		Pair:          pool.Address,
+		Lp:            pool.Lp,
+		LpAmount:      share,
+		Share:         math.LegacyNewDecFromInt(share).QuoInt(totalShare),
+		Asset0:        pool.Asset0,
+		Asset0Deposit: deposit0.Sub(refund0),
+		Asset0Refund:  refund0,
+		Asset1:        pool.Asset1,
+		Asset1Deposit: deposit1.Sub(refund1),
+		Asset1Refund:  refund1,
+		Height:        pool.Height,
+	}, nil
+}
+
+// Withdraw implements Simulator
+func (s *simulatorImpl) Withdraw(ctx context.Context, pair string, lpAmount math.Int) (*WithdrawSimulation, error) {
+	ctx, span := tracing.Start(ctx, "simulator.Withdraw")
+	defer span.End()
+	pool, err := s.PoolOf(ctx, pair)
+	if err != nil {
+		return nil, errors.Wrap(err, "simulator.Withdraw")
+	}
+	if pool == nil {
+		return nil, ErrPoolNotFound
+	}
+	if lpAmount.GT(pool.LpAmount) {
+		return nil, ErrInvalidShare
+	}
+
+	return &WithdrawSimulation{
+		Pair:         pool.Address,
+		Lp:           pool.Lp,
+		LpAmount:     lpAmount,
+		Asset0:       pool.Asset0,
+		Asset0Amount: pool.Asset0Amount.Mul(lpAmount).Quo(pool.LpAmount),
+		Asset1:       pool.Asset1,
+		Asset1Amount: pool.Asset1Amount.Mul(lpAmount).Quo(pool.LpAmount),
+		Height:       pool.Height,
+	}, nil
+}
+
+// computeProvide follows provide_liquidity of the pair contract.
+// The first provision mints sqrt(deposit0 * deposit1) with the minimum liquidity locked in the pair,
+// the others mint the smaller share of the deposits and refund the excess of the other side.
+func computeProvide(pool0, pool1, totalShare, deposit0, deposit1 math.Int) (math.Int, math.Int, math.Int, error) {
+	if !deposit0.IsPositive() || !deposit1.IsPositive() {
+		return math.Int{}, math.Int{}, math.Int{}, ErrInvalidDeposit
+	}
+
+	if totalShare.IsZero() {
+		share := math.NewIntFromBigInt(new(big.Int).Sqrt(deposit0.Mul(deposit1).BigInt()))
+		minted := share.SubRaw(dezswap.MINIMUM_LIQUIDITY_AMOUNT)
+		if !minted.IsPositive() {
+			return math.Int{}, math.Int{}, math.Int{}, ErrInvalidDeposit
+		}
+		return minted, math.ZeroInt(), math.ZeroInt(), nil
+	}
+	if pool0.IsZero() || pool1.IsZero() {
+		return math.Int{}, math.Int{}, math.Int{}, ErrInsufficientLiquidity
+	}
+
+	share := math.MinInt(deposit0.Mul(totalShare).Quo(pool0), deposit1.Mul(totalShare).Quo(pool1))
+	if share.IsZero() {
+		return math.Int{}, math.Int{}, math.Int{}, ErrInvalidDeposit
+	}
+
+	return share, deposit0.Sub(desiredDeposit(pool0, totalShare, share)), deposit1.Sub(desiredDeposit(pool1, totalShare, share)), nil
+}
+
+// desiredDeposit is the deposit required for the share, rounded up in favor of the pool
+func desiredDeposit(pool, totalShare, share math.Int) math.Int {
+	desired := pool.Mul(share).Quo(totalShare)
+	if !desired.Mul(totalShare).Quo(share).Equal(pool) {
+		desired = desired.AddRaw(1)
+	}
+	return desired
+}
@@ -0,0 +1,74 @@
+package simulate
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulator_Provide(t *testing.T) {
+	tcs := []struct {
+		name            string
+		pool            *Pool
+		deposit0        int64
+		deposit1        int64
+		expectedLp      int64
+		expectedShare   string
+		expectedRefund0 int64
+		expectedRefund1 int64
+	}{
+		{
+			"imbalanced deposits are refunded",
+			&Pool{Address: "pair", Lp: "lp", Asset0: "asset0", Asset0Amount: math.NewInt(1_000_000), Asset1: "asset1", Asset1Amount: math.NewInt(2_000_000), LpAmount: math.NewInt(1_414_213)},
+			10_000, 30_000, 14142, "0.009900899986347931", 0, 10_000,
+		},
+		{
+			"first provision locks the minimum liquidity",
+			&Pool{Address: "pair", Lp: "lp", Asset0: "asset0", Asset0Amount: math.ZeroInt(), Asset1: "asset1", Asset1Amount: math.ZeroInt(), LpAmount: math.ZeroInt()},
+			1_000_000, 4_000_000, 1_999_000, "0.999500000000000000", 0, 0,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &poolRepoMock{}
+			repo.On("PoolOf", "pair").Return(tc.pool, nil).Once()
+			s := New(repo)
+
+			actual, err := s.Provide(context.Background(), "pair", math.NewInt(tc.deposit0), math.NewInt(tc.deposit1))
+
+			require.NoError(t, err)
+			require.Equal(t, "lp", actual.Lp)
+			require.Equal(t, math.NewInt(tc.expectedLp), actual.LpAmount)
+			require.Equal(t, math.LegacyMustNewDecFromStr(tc.expectedShare), actual.Share)
+			require.Equal(t, math.NewInt(tc.expectedRefund0).String(), actual.Asset0Refund.String())
+			require.Equal(t, math.NewInt(tc.expectedRefund1).String(), actual.Asset1Refund.String())
+			require.Equal(t, math.NewInt(tc.deposit0-tc.expectedRefund0), actual.Asset0Deposit)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSimulator_Withdraw(t *testing.T) {
+	repo := &poolRepoMock{}
+	repo.On("PoolOf", "pair").Return(&Pool{
+		Address: "pair", Lp: "lp", Asset0: "asset0", Asset0Amount: math.NewInt(1_000_000), Asset1: "asset1", Asset1Amount: math.NewInt(2_000_000), LpAmount: math.NewInt(1_414_213),
+	}, nil).Twice()
+	repo.On("PoolOf", "unknown").Return(nil, nil).Once()
+	s := New(repo)
+
+	actual, err := s.Withdraw(context.Background(), "pair", math.NewInt(141_421))
+
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(99_999), actual.Asset0Amount)
+	require.Equal(t, math.NewInt(199_999), actual.Asset1Amount)
+
+	_, err = s.Withdraw(context.Background(), "pair", math.NewInt(1_414_214))
+	require.ErrorIs(t, err, ErrInvalidShare)
+
+	_, err = s.Withdraw(context.Background(), "unknown", math.NewInt(1))
+	require.ErrorIs(t, err, ErrPoolNotFound)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,196 @@
+package simulate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/dezswap/dezswap-api/pkg"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/dezswap/dezswap-api/pkg/dezswap"
+	"github.com/pkg/errors"
+)
+
+var ErrNodeQueryFailed = errors.New("failed to query the node")
+
+// ErrSimulationRejected is a failure of the pair contract to the simulation, which every node fails alike
+var ErrSimulationRejected = errors.New("the pair rejected the simulation")
+
+// nodeSimulationCacheTtl is long enough since a simulation never changes at the same height
+const nodeSimulationCacheTtl = 10 * time.Minute
+
+// NodeSimulator queries the simulations to the pair contract instead of computing them off-chain
+type NodeSimulator interface {
+	// Swap queries the simulation of the pair at the height, the latest height of the node when height is 0
+	Swap(ctx context.Context, offer, ask string, amount math.Int, height uint64) (*SwapSimulation, error)
+	// ReverseSwap queries the reverse simulation of the pair at the height, the latest height of the node when height is 0
+	ReverseSwap(ctx context.Context, offer, ask string, askAmount math.Int, height uint64) (*SwapSimulation, error)
+}
+
+type nodeSimulatorImpl struct {
+	PoolRepo
+	pkg.NetworkMetadata
+	clients []pkg.GrpcClient
+	cache   cache.Cache
+
+	// the latest height is queried once a block
+	mu           sync.Mutex
+	height       uint64
+	heightExpiry time.Time
+}
+
+// NewNodeSimulator returns a NodeSimulator which queries the clients in order until one succeeds, cache is optional
+func NewNodeSimulator(repo PoolRepo, networkMetadata pkg.NetworkMetadata, clients []pkg.GrpcClient, cache cache.Cache) NodeSimulator {
+	return &nodeSimulatorImpl{PoolRepo: repo, NetworkMetadata: networkMetadata, clients: clients, cache: cache}
+}
+
+// Swap implements NodeSimulator
+func (s *nodeSimulatorImpl) Swap(ctx context.Context, offer, ask string, amount math.Int, height uint64) (*SwapSimulation, error) {
+	ctx, span := tracing.Start(ctx, "nodeSimulator.Swap")
+	defer span.End()
+	pair, height, err := s.prepare(ctx, offer, ask, height)
+	if err != nil {
+		return nil, errors.Wrap(err, "nodeSimulator.Swap")
+	}
+
+	query, err := dezswap.QuerySimulation(dezswap.ToAssetInfoRes(offer, amount.String(), s.NetworkMetadata))
+	if err != nil {
+		return nil, errors.Wrap(err, "nodeSimulator.Swap")
+	}
+	res := dezswap.SimulationRes{}
+	if err := s.query(pair, query, height, &res); err != nil {
+		return nil, err
+	}
+
+	returnAmount, ok := math.NewIntFromString(res.ReturnAmount)
+	if !ok {
+		return nil, errors.Errorf("nodeSimulator.Swap: invalid return amount(%s)", res.ReturnAmount)
+	}
+	return s.toSimulation(pair, offer, amount, ask, returnAmount, res.SpreadAmount, res.CommissionAmount, height)
+}
+
+// ReverseSwap implements NodeSimulator
+func (s *nodeSimulatorImpl) ReverseSwap(ctx context.Context, offer, ask string, askAmount math.Int, height uint64) (*SwapSimulation, error) {
+	ctx, span := tracing.Start(ctx, "nodeSimulator.ReverseSwap")
+	defer span.End()
+	pair, height, err := s.prepare(ctx, offer, ask, height)
+	if err != nil {
+		return nil, errors.Wrap(err, "nodeSimulator.ReverseSwap")
+	}
+
+	query, err := dezswap.QueryReverseSimulation(dezswap.ToAssetInfoRes(ask, askAmount.String(), s.NetworkMetadata))
+	if err != nil {
+		return nil, errors.Wrap(err, "nodeSimulator.ReverseSwap")
+	}
+	res := dezswap.ReverseSimulationRes{}
+	if err := s.query(pair, query, height, &res); err != nil {
+		return nil, err
+	}
+
+	offerAmount, ok := math.NewIntFromString(res.OfferAmount)
+	if !ok {
+		return nil, errors.Errorf("nodeSimulator.ReverseSwap: invalid offer amount(%s)", res.OfferAmount)
+	}
+	return s.toSimulation(pair, offer, offerAmount, ask, askAmount, res.SpreadAmount, res.CommissionAmount, height)
+}
+
+// prepare returns the pair of the assets and the height to query, the latest height is pinned to cache the result per block
+func (s *nodeSimulatorImpl) prepare(ctx context.Context, offer, ask string, height uint64) (string, uint64, error) {
+	pool, err := s.Pool(ctx, offer, ask)
+	if err != nil {
+		return "", 0, err
+	}
+	if pool == nil {
+		return "", 0, ErrPoolNotFound
+	}
+
+	if height == 0 {
+		height, err = s.latestHeight()
+		if err != nil {
+			return "", 0, err
+		}
+	}
+	return pool.Address, height, nil
+}
+
+// latestHeight is the latest height of the nodes, queried once a block
+func (s *nodeSimulatorImpl) latestHeight() (uint64, error) {
+	s.mu.Lock()
+	height, expiry := s.height, s.heightExpiry
+	s.mu.Unlock()
+	if height != 0 && time.Now().Before(expiry) {
+		return height, nil
+	}
+
+	var lastErr error = errors.New("grpc client is not configured")
+	for _, client := range s.clients {
+		height, err := client.SyncedHeight()
+		if err == nil {
+			s.mu.Lock()
+			s.height = height
+			s.heightExpiry = time.Now().Add(time.Duration(s.BlockSecond) * time.Second)
+			s.mu.Unlock()
+			return height, nil
+		}
+		lastErr = err
+	}
+	return 0, errors.Wrap(ErrNodeQueryFailed, lastErr.Error())
+}
+
+// query queries the pair at the resolved height, which keys the cache as well
+func (s *nodeSimulatorImpl) query(pair string, query []byte, height uint64, dest interface{}) error {
+	key := fmt.Sprintf("simulate:%s:%s:%d", pair, query, height)
+	if s.cache != nil {
+		if err := s.cache.Get(key, dest); err == nil {
+			return nil
+		}
+	}
+
+	var lastErr error = errors.New("grpc client is not configured")
+	for _, client := range s.clients {
+		res, err := client.QueryContract(pair, query, height)
+		// the other nodes fail the contract alike
+		if errors.Is(err, pkg.ErrContractQuery) {
+			return errors.Wrap(ErrSimulationRejected, err.Error())
+		}
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if err := json.Unmarshal(res, dest); err != nil {
+			return errors.Wrap(err, "nodeSimulator.query")
+		}
+		if s.cache != nil {
+			// a failure of caching must not fail the query
+			_ = s.cache.Set(key, dest, nodeSimulationCacheTtl)
+		}
+		return nil
+	}
+	return errors.Wrap(ErrNodeQueryFailed, lastErr.Error())
+}
+
+func (s *nodeSimulatorImpl) toSimulation(pair, offer string, offerAmount math.Int, ask string, returnAmount math.Int, spread, commission string, height uint64) (*SwapSimulation, error) {
+	spreadAmount, ok := math.NewIntFromString(spread)
+	if !ok {
+		return nil, errors.Errorf("nodeSimulator: invalid spread amount(%s)", spread)
+	}
+	commissionAmount, ok := math.NewIntFromString(commission)
+	if !ok {
+		return nil, errors.Errorf("nodeSimulator: invalid commission amount(%s)", commission)
+	}
+
+	return &SwapSimulation{
+		Pair:             pair,
+		OfferAsset:       offer,
+		OfferAmount:      offerAmount,
+		AskAsset:         ask,
+		ReturnAmount:     returnAmount,
+		SpreadAmount:     spreadAmount,
+		CommissionAmount: commissionAmount,
+		Height:           height,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package simulate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/pkg"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/dezswap/dezswap-api/pkg/cache/memory"
+	xpla_mock "github.com/dezswap/dezswap-api/pkg/xpla/mock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeSimulator_Swap(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := &poolRepoMock{}
+	repo.On("Pool", "asset0", "asset1").Return(&Pool{Address: "pair", Asset0: "asset0", Asset1: "asset1"}, nil)
+	failing := xpla_mock.NewGrpcClientMock()
+	failing.On("SyncedHeight").Return(uint64(0), errors.New("unavailable")).Once()
+	failing.On("QueryContract", "pair", mock.Anything, uint64(100)).Return([]byte(nil), errors.New("unavailable")).Once()
+	healthy := xpla_mock.NewGrpcClientMock()
+	healthy.On("SyncedHeight").Return(uint64(100), nil).Once()
+	healthy.On("QueryContract", "pair", []byte(`{"simulation":{"offer_asset":{"info":{"native_token":{"denom":"asset0"}},"amount":"10000"}}}`), uint64(100)).
+		Return([]byte(`{"return_amount":"19742","spread_amount":"199","commission_amount":"59"}`), nil).Once()
+	s := NewNodeSimulator(repo, networkMetadata(t), []pkg.GrpcClient{failing, healthy}, memory.NewMemoryCache(ctx, cache.NewByteCodec()))
+
+	actual, err := s.Swap(context.Background(), "asset0", "asset1", math.NewInt(10_000), 0)
+
+	require.NoError(t, err)
+	require.Equal(t, "pair", actual.Pair)
+	require.Equal(t, uint64(100), actual.Height)
+	require.Equal(t, math.NewInt(19742), actual.ReturnAmount)
+	require.Equal(t, math.NewInt(199), actual.SpreadAmount)
+	require.Equal(t, math.NewInt(59), actual.CommissionAmount)
+
+	// the same block is served from the cache without querying the height again
+	cached, err := s.Swap(context.Background(), "asset0", "asset1", math.NewInt(10_000), 0)
+
+	require.NoError(t, err)
+	require.Equal(t, actual.ReturnAmount, cached.ReturnAmount)
+	// the result of the latest height is cached at the resolved height
+	cached, err = s.Swap(context.Background(), "asset0", "asset1", math.NewInt(10_000), 100)
+
+	require.NoError(t, err)
+	require.Equal(t, actual.ReturnAmount, cached.ReturnAmount)
+	failing.AssertExpectations(t)
+	healthy.AssertExpectations(t)
+
+	// the next block queries the height and the simulation at the new height
+	s.(*nodeSimulatorImpl).heightExpiry = time.Now()
+	healthy.On("SyncedHeight").Return(uint64(101), nil).Once()
+	healthy.On("QueryContract", "pair", mock.Anything, uint64(101)).
+		Return([]byte(`{"return_amount":"19000","spread_amount":"199","commission_amount":"59"}`), nil).Once()
+	failing.On("SyncedHeight").Return(uint64(0), errors.New("unavailable")).Once()
+	failing.On("QueryContract", "pair", mock.Anything, uint64(101)).Return([]byte(nil), errors.New("unavailable")).Once()
+
+	next, err := s.Swap(context.Background(), "asset0", "asset1", math.NewInt(10_000), 0)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), next.Height)
+	require.Equal(t, math.NewInt(19000), next.ReturnAmount)
+	failing.AssertExpectations(t)
+	healthy.AssertExpectations(t)
+}
+
+func TestNodeSimulator_ContractError(t *testing.T) {
+	repo := &poolRepoMock{}
+	repo.On("Pool", "asset0", "asset1").Return(&Pool{Address: "pair", Asset0: "asset0", Asset1: "asset1"}, nil)
+	rejecting := xpla_mock.NewGrpcClientMock()
+	rejecting.On("QueryContract", "pair", mock.Anything, uint64(50)).
+		Return([]byte(nil), errors.Wrap(pkg.ErrContractQuery, "Generic error: Operation exceeds max spread limit")).Once()
+	// a deterministic failure of the contract is not retried on the other nodes
+	other := xpla_mock.NewGrpcClientMock()
+	s := NewNodeSimulator(repo, networkMetadata(t), []pkg.GrpcClient{rejecting, other}, nil)
+
+	_, err := s.Swap(context.Background(), "asset0", "asset1", math.NewInt(10_000), 50)
+
+	require.ErrorIs(t, err, ErrSimulationRejected)
+	require.Contains(t, err.Error(), "max spread limit")
+	rejecting.AssertExpectations(t)
+	other.AssertNotCalled(t, "QueryContract", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestNodeSimulator_ReverseSwap(t *testing.T) {
+	repo := &poolRepoMock{}
+	repo.On("Pool", "asset0", "asset1").Return(&Pool{Address: "pair", Asset0: "asset0", Asset1: "asset1"}, nil)
+	repo.On("Pool", "asset0", "unknown").Return(nil, nil)
+	client := xpla_mock.NewGrpcClientMock()
+	client.On("QueryContract", "pair", []byte(`{"reverse_simulation":{"ask_asset":{"info":{"native_token":{"denom":"asset1"}},"amount":"19742"}}}`), uint64(50)).
+		Return([]byte(`{"offer_amount":"9999","spread_amount":"197","commission_amount":"59"}`), nil).Once()
+	client.On("QueryContract", "pair", mock.Anything, uint64(51)).Return([]byte(nil), errors.New("unavailable")).Once()
+	s := NewNodeSimulator(repo, networkMetadata(t), []pkg.GrpcClient{client}, nil)
+
+	actual, err := s.ReverseSwap(context.Background(), "asset0", "asset1", math.NewInt(19742), 50)
+
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(9999), actual.OfferAmount)
+	require.Equal(t, math.NewInt(19742), actual.ReturnAmount)
+	require.Equal(t, uint64(50), actual.Height)
+
+	_, err = s.ReverseSwap(context.Background(), "asset0", "asset1", math.NewInt(19742), 51)
+	require.ErrorIs(t, err, ErrNodeQueryFailed)
+
+	_, err = s.ReverseSwap(context.Background(), "asset0", "unknown", math.NewInt(1), 50)
+	require.ErrorIs(t, err, ErrPoolNotFound)
+	client.AssertExpectations(t)
+}
+
+func networkMetadata(t *testing.T) pkg.NetworkMetadata {
+	networkMetadata, err := pkg.GetNetworkMetadata("dimension_37-1")
+	require.NoError(t, err)
+	return networkMetadata
+}
@@ -0,0 +1,257 @@
+package simulate
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/dezswap/dezswap-api/pkg/dezswap"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrPoolNotFound          = errors.New("pool not found")
+	ErrInsufficientLiquidity = errors.New("insufficient liquidity")
+	ErrInsufficientReserves  = errors.New("ask amount exceeds the reserves of the pool")
+	ErrInvalidDeposit        = errors.New("invalid deposit amounts")
+	ErrInvalidShare          = errors.New("lp amount exceeds the total share of the pool")
+)
+
+// Pool is the reserves of a pair at the height
+type Pool struct {
+	Address      string
+	Asset0       string
+	Asset0Amount math.Int
+	Asset1       string
+	Asset1Amount math.Int
+	Lp           string
+	LpAmount     math.Int
+	Height       uint64
+}
+
+type SwapSimulation struct {
+	Pair             string
+	OfferAsset       string
+	OfferAmount      math.Int
+	AskAsset         string
+	ReturnAmount     math.Int
+	SpreadAmount     math.Int
+	CommissionAmount math.Int
+	Height           uint64
+}
+
+// RouteSimulation is the result of swaps along the route, each hop takes the return of the previous one
+type RouteSimulation struct {
+	Route        []string
+	OfferAmount  math.Int
+	ReturnAmount math.Int
+	// PriceImpact is the loss against the spot prices of the pools, the commission is not included
+	PriceImpact math.LegacyDec
+	// FeeRate is the commission rate compounded over the hops
+	FeeRate math.LegacyDec
+	Hops    []SwapSimulation
+}
+
+type Simulator interface {
+	// Swap simulates a swap of amount offer asset to ask asset on the latest reserves of the pair
+	Swap(ctx context.Context, offer, ask string, amount math.Int) (*SwapSimulation, error)
+	// SwapRoute simulates swaps along the route of assets hop by hop
+	SwapRoute(ctx context.Context, route []string, amount math.Int) (*RouteSimulation, error)
+	// ReverseSwap simulates the offer amount required to receive askAmount of ask asset
+	ReverseSwap(ctx context.Context, offer, ask string, askAmount math.Int) (*SwapSimulation, error)
+	// ReverseSwapRoute simulates the offer amount required to receive askAmount of the last asset of the route
+	ReverseSwapRoute(ctx context.Context, route []string, askAmount math.Int) (*RouteSimulation, error)
+	// Provide simulates a liquidity provision of the deposits in the asset order of the pair
+	Provide(ctx context.Context, pair string, deposit0, deposit1 math.Int) (*ProvideSimulation, error)
+	// Withdraw simulates a liquidity withdrawal of lpAmount from the pair
+	Withdraw(ctx context.Context, pair string, lpAmount math.Int) (*WithdrawSimulation, error)
+}
+
+type PoolRepo interface {
+	// Pool returns the latest reserves of the pair of the assets in any order, nil if the pair does not exist
+	Pool(ctx context.Context, assetA, assetB string) (*Pool, error)
+	// PoolOf returns the latest reserves of the pair, nil if the pair does not exist
+	PoolOf(ctx context.Context, pair string) (*Pool, error)
+}
+
+type simulatorImpl struct {
+	PoolRepo
+	commissionRate math.LegacyDec
+}
+
+func New(repo PoolRepo) Simulator {
+	return &simulatorImpl{repo, math.LegacyMustNewDecFromStr(strconv.FormatFloat(dezswap.SWAP_FEE, 'f', -1, 64))}
+}
+
+// Swap implements Simulator
+func (s *simulatorImpl) Swap(ctx context.Context, offer, ask string, amount math.Int) (*SwapSimulation, error) {
+	ctx, span := tracing.Start(ctx, "simulatorImpl.Swap")
+	defer span.End()
+	simulation, _, err := s.swap(ctx, offer, ask, amount)
+	if err != nil {
+		return nil, err
+	}
+	return simulation, nil
+}
+
+// SwapRoute implements Simulator
+func (s *simulatorImpl) SwapRoute(ctx context.Context, route []string, amount math.Int) (*RouteSimulation, error) {
+	ctx, span := tracing.Start(ctx, "simulatorImpl.SwapRoute")
+	defer span.End()
+	if len(route) < 2 {
+		return nil, errors.Errorf("invalid route(%v)", route)
+	}
+
+	hops := make([]SwapSimulation, 0, len(route)-1)
+	offerAmount := amount
+	spotRatio := math.LegacyOneDec()
+	feeRatio := math.LegacyOneDec()
+	for i := 0; i < len(route)-1; i++ {
+		hop, ratio, err := s.swap(ctx, route[i], route[i+1], offerAmount)
+		if err != nil {
+			return nil, err
+		}
+		hops = append(hops, *hop)
+		spotRatio = spotRatio.Mul(ratio)
+		feeRatio = feeRatio.Mul(math.LegacyOneDec().Sub(s.commissionRate))
+		offerAmount = hop.ReturnAmount
+	}
+
+	priceImpact := math.LegacyOneDec().Sub(spotRatio)
+	if priceImpact.IsNegative() {
+		priceImpact = math.LegacyZeroDec()
+	}
+
+	return &RouteSimulation{
+		Route:        route,
+		OfferAmount:  amount,
+		ReturnAmount: offerAmount,
+		PriceImpact:  priceImpact,
+		FeeRate:      math.LegacyOneDec().Sub(feeRatio),
+		Hops:         hops,
+	}, nil
+}
+
+// ReverseSwap implements Simulator
+func (s *simulatorImpl) ReverseSwap(ctx context.Context, offer, ask string, askAmount math.Int) (*SwapSimulation, error) {
+	ctx, span := tracing.Start(ctx, "simulatorImpl.ReverseSwap")
+	defer span.End()
+	simulation, _, err := s.reverseSwap(ctx, offer, ask, askAmount)
+	if err != nil {
+		return nil, err
+	}
+	return simulation, nil
+}
+
+// ReverseSwapRoute implements Simulator
+func (s *simulatorImpl) ReverseSwapRoute(ctx context.Context, route []string, askAmount math.Int) (*RouteSimulation, error) {
+	ctx, span := tracing.Start(ctx, "simulatorImpl.ReverseSwapRoute")
+	defer span.End()
+	if len(route) < 2 {
+		return nil, errors.Errorf("invalid route(%v)", route)
+	}
+
+	hops := make([]SwapSimulation, len(route)-1)
+	amount := askAmount
+	spotRatio := math.LegacyOneDec()
+	feeRatio := math.LegacyOneDec()
+	// from the last hop, the required offer of a hop is what the previous hop has to return
+	for i := len(route) - 2; i >= 0; i-- {
+		hop, ratio, err := s.reverseSwap(ctx, route[i], route[i+1], amount)
+		if err != nil {
+			return nil, err
+		}
+		hops[i] = *hop
+		spotRatio = spotRatio.Mul(ratio)
+		feeRatio = feeRatio.Mul(math.LegacyOneDec().Sub(s.commissionRate))
+		amount = hop.OfferAmount
+	}
+
+	priceImpact := math.LegacyOneDec().Sub(spotRatio)
+	if priceImpact.IsNegative() {
+		priceImpact = math.LegacyZeroDec()
+	}
+
+	return &RouteSimulation{
+		Route:        route,
+		OfferAmount:  amount,
+		ReturnAmount: askAmount,
+		PriceImpact:  priceImpact,
+		FeeRate:      math.LegacyOneDec().Sub(feeRatio),
+		Hops:         hops,
+	}, nil
+}
+
+// reverseSwap is the counterpart of swap which returns the simulation for the ask amount
+func (s *simulatorImpl) reverseSwap(ctx context.Context, offer, ask string, askAmount math.Int) (*SwapSimulation, math.LegacyDec, error) {
+	pool, err := s.Pool(ctx, offer, ask)
+	if err != nil {
+		return nil, math.LegacyDec{}, errors.Wrap(err, "simulator.reverseSwap")
+	}
+	if pool == nil {
+		return nil, math.LegacyDec{}, ErrPoolNotFound
+	}
+
+	offerPool, askPool := pool.Asset0Amount, pool.Asset1Amount
+	if pool.Asset0 != offer {
+		offerPool, askPool = askPool, offerPool
+	}
+
+	offerAmount, spread, commission, err := computeOfferAmount(offerPool, askPool, askAmount, s.commissionRate)
+	if err != nil {
+		return nil, math.LegacyDec{}, err
+	}
+
+	return &SwapSimulation{
+		Pair:             pool.Address,
+		OfferAsset:       offer,
+		OfferAmount:      offerAmount,
+		AskAsset:         ask,
+		ReturnAmount:     askAmount,
+		SpreadAmount:     spread,
+		CommissionAmount: commission,
+		Height:           pool.Height,
+	}, spotRatio(offerAmount, askAmount.Add(commission), offerPool, askPool), nil
+}
+
+// spotRatio is the ratio of the return before commission to the return at the spot price of the pool
+func spotRatio(offerAmount, returnBeforeCommission, offerPool, askPool math.Int) math.LegacyDec {
+	spotReturn := math.LegacyNewDecFromInt(offerAmount.Mul(askPool)).QuoInt(offerPool)
+	if !spotReturn.IsPositive() {
+		return math.LegacyOneDec()
+	}
+	return math.LegacyNewDecFromInt(returnBeforeCommission).Quo(spotReturn)
+}
+
+// swap returns the simulation and the ratio of the return before commission to the return at the spot price
+func (s *simulatorImpl) swap(ctx context.Context, offer, ask string, amount math.Int) (*SwapSimulation, math.LegacyDec, error) {
+	pool, err := s.Pool(ctx, offer, ask)
+	if err != nil {
+		return nil, math.LegacyDec{}, errors.Wrap(err, "simulator.swap")
+	}
+	if pool == nil {
+		return nil, math.LegacyDec{}, ErrPoolNotFound
+	}
+
+	offerPool, askPool := pool.Asset0Amount, pool.Asset1Amount
+	if pool.Asset0 != offer {
+		offerPool, askPool = askPool, offerPool
+	}
+
+	ret, spread, commission, err := computeSwap(offerPool, askPool, amount, s.commissionRate)
+	if err != nil {
+		return nil, math.LegacyDec{}, err
+	}
+
+	return &SwapSimulation{
+		Pair:             pool.Address,
+		OfferAsset:       offer,
+		OfferAmount:      amount,
+		AskAsset:         ask,
+		ReturnAmount:     ret,
+		SpreadAmount:     spread,
+		CommissionAmount: commission,
+		Height:           pool.Height,
+	}, spotRatio(amount, ret.Add(commission), offerPool, askPool), nil
+}
@@ -0,0 +1,209 @@
+package simulate
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type poolRepoMock struct {
+	mock.Mock
+}
+
+func (m *poolRepoMock) Pool(_ context.Context, assetA, assetB string) (*Pool, error) {
+	args := m.Called(assetA, assetB)
+	pool, _ := args.Get(0).(*Pool)
+	return pool, args.Error(1)
+}
+
+func (m *poolRepoMock) PoolOf(_ context.Context, pair string) (*Pool, error) {
+	args := m.Called(pair)
+	pool, _ := args.Get(0).(*Pool)
+	return pool, args.Error(1)
+}
+
+func TestSimulator_Swap(t *testing.T) {
+	pool := &Pool{
+		Address:      "pair",
+		Asset0:       "asset0",
+		Asset0Amount: math.NewInt(1_000_000),
+		Asset1:       "asset1",
+		Asset1Amount: math.NewInt(2_000_000),
+		Height:       100,
+	}
+
+	tcs := []struct {
+		name               string
+		offer              string
+		ask                string
+		expectedReturn     int64
+		expectedSpread     int64
+		expectedCommission int64
+	}{
+		{"asset0 to asset1", "asset0", "asset1", 19742, 199, 59},
+		{"asset1 to asset0", "asset1", "asset0", 4961, 25, 14},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &poolRepoMock{}
+			repo.On("Pool", tc.offer, tc.ask).Return(pool, nil).Once()
+			s := New(repo)
+
+			actual, err := s.Swap(context.Background(), tc.offer, tc.ask, math.NewInt(10_000))
+
+			require.NoError(t, err)
+			require.Equal(t, "pair", actual.Pair)
+			require.Equal(t, uint64(100), actual.Height)
+			require.Equal(t, math.NewInt(tc.expectedReturn), actual.ReturnAmount)
+			require.Equal(t, math.NewInt(tc.expectedSpread), actual.SpreadAmount)
+			require.Equal(t, math.NewInt(tc.expectedCommission), actual.CommissionAmount)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSimulator_Swap_Errors(t *testing.T) {
+	repo := &poolRepoMock{}
+	repo.On("Pool", "asset0", "unknown").Return(nil, nil).Once()
+	repo.On("Pool", "asset0", "asset1").Return(&Pool{
+		Asset0: "asset0", Asset0Amount: math.ZeroInt(), Asset1: "asset1", Asset1Amount: math.ZeroInt(),
+	}, nil).Once()
+	s := New(repo)
+
+	_, err := s.Swap(context.Background(), "asset0", "unknown", math.NewInt(1))
+	require.ErrorIs(t, err, ErrPoolNotFound)
+
+	_, err = s.Swap(context.Background(), "asset0", "asset1", math.NewInt(1))
+	require.ErrorIs(t, err, ErrInsufficientLiquidity)
+}
+
+func TestSimulator_SwapRoute(t *testing.T) {
+	repo := &poolRepoMock{}
+	repo.On("Pool", "asset0", "asset1").Return(&Pool{
+		Address: "pair0", Asset0: "asset0", Asset0Amount: math.NewInt(1_000_000), Asset1: "asset1", Asset1Amount: math.NewInt(2_000_000), Height: 100,
+	}, nil).Once()
+	repo.On("Pool", "asset1", "asset2").Return(&Pool{
+		Address: "pair1", Asset0: "asset2", Asset0Amount: math.NewInt(1_000_000), Asset1: "asset1", Asset1Amount: math.NewInt(4_000_000), Height: 100,
+	}, nil).Once()
+	s := New(repo)
+
+	actual, err := s.SwapRoute(context.Background(), []string{"asset0", "asset1", "asset2"}, math.NewInt(10_000))
+
+	require.NoError(t, err)
+	require.Len(t, actual.Hops, 2)
+	require.Equal(t, "pair0", actual.Hops[0].Pair)
+	require.Equal(t, math.NewInt(19742), actual.Hops[0].ReturnAmount)
+	require.Equal(t, "pair1", actual.Hops[1].Pair)
+	require.Equal(t, math.NewInt(19742), actual.Hops[1].OfferAmount)
+	require.Equal(t, math.NewInt(4897), actual.Hops[1].ReturnAmount)
+	require.Equal(t, math.NewInt(14), actual.Hops[1].CommissionAmount)
+	require.Equal(t, math.NewInt(4897), actual.ReturnAmount)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.005991"), actual.FeeRate)
+	require.True(t, actual.PriceImpact.IsPositive())
+	require.True(t, actual.PriceImpact.LT(math.LegacyMustNewDecFromStr("0.02")))
+	repo.AssertExpectations(t)
+}
+
+func TestSimulator_ReverseSwap(t *testing.T) {
+	pool := &Pool{
+		Address:      "pair",
+		Asset0:       "asset0",
+		Asset0Amount: math.NewInt(1_000_000),
+		Asset1:       "asset1",
+		Asset1Amount: math.NewInt(2_000_000),
+		Height:       100,
+	}
+
+	tcs := []struct {
+		name               string
+		offer              string
+		ask                string
+		askAmount          int64
+		expectedOffer      int64
+		expectedSpread     int64
+		expectedCommission int64
+	}{
+		{"asset0 to asset1", "asset0", "asset1", 19742, 10001, 200, 59},
+		{"asset1 to asset0", "asset1", "asset0", 4961, 10002, 25, 14},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &poolRepoMock{}
+			repo.On("Pool", tc.offer, tc.ask).Return(pool, nil).Once()
+			s := New(repo)
+
+			actual, err := s.ReverseSwap(context.Background(), tc.offer, tc.ask, math.NewInt(tc.askAmount))
+
+			require.NoError(t, err)
+			require.Equal(t, "pair", actual.Pair)
+			require.Equal(t, math.NewInt(tc.askAmount), actual.ReturnAmount)
+			require.Equal(t, math.NewInt(tc.expectedOffer), actual.OfferAmount)
+			require.Equal(t, math.NewInt(tc.expectedSpread), actual.SpreadAmount)
+			require.Equal(t, math.NewInt(tc.expectedCommission), actual.CommissionAmount)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSimulator_ReverseSwap_Errors(t *testing.T) {
+	repo := &poolRepoMock{}
+	repo.On("Pool", "asset0", "asset1").Return(&Pool{
+		Asset0: "asset0", Asset0Amount: math.NewInt(1_000_000), Asset1: "asset1", Asset1Amount: math.NewInt(2_000_000),
+	}, nil).Once()
+	s := New(repo)
+
+	_, err := s.ReverseSwap(context.Background(), "asset0", "asset1", math.NewInt(2_000_000))
+	require.ErrorIs(t, err, ErrInsufficientReserves)
+}
+
+func TestSimulator_ReverseSwapRoute(t *testing.T) {
+	repo := &poolRepoMock{}
+	repo.On("Pool", "asset0", "asset1").Return(&Pool{
+		Address: "pair0", Asset0: "asset0", Asset0Amount: math.NewInt(1_000_000), Asset1: "asset1", Asset1Amount: math.NewInt(2_000_000), Height: 100,
+	}, nil).Once()
+	repo.On("Pool", "asset1", "asset2").Return(&Pool{
+		Address: "pair1", Asset0: "asset2", Asset0Amount: math.NewInt(1_000_000), Asset1: "asset1", Asset1Amount: math.NewInt(4_000_000), Height: 100,
+	}, nil).Once()
+	s := New(repo)
+
+	actual, err := s.ReverseSwapRoute(context.Background(), []string{"asset0", "asset1", "asset2"}, math.NewInt(4897))
+
+	require.NoError(t, err)
+	require.Len(t, actual.Hops, 2)
+	require.Equal(t, "pair0", actual.Hops[0].Pair)
+	require.Equal(t, "pair1", actual.Hops[1].Pair)
+	require.Equal(t, actual.Hops[0].ReturnAmount, actual.Hops[1].OfferAmount)
+	require.Equal(t, math.NewInt(4897), actual.Hops[1].ReturnAmount)
+	require.Equal(t, math.NewInt(4897), actual.ReturnAmount)
+	require.Equal(t, actual.Hops[0].OfferAmount, actual.OfferAmount)
+	// the quoted offer swapped through the route returns at least the ask amount
+	commissionRate := s.(*simulatorImpl).commissionRate
+	hop0, _, _, err := computeSwap(math.NewInt(1_000_000), math.NewInt(2_000_000), actual.OfferAmount, commissionRate)
+	require.NoError(t, err)
+	require.True(t, hop0.GTE(actual.Hops[1].OfferAmount))
+	hop1, _, _, err := computeSwap(math.NewInt(4_000_000), math.NewInt(1_000_000), hop0, commissionRate)
+	require.NoError(t, err)
+	require.True(t, hop1.GTE(math.NewInt(4897)))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.005991"), actual.FeeRate)
+	repo.AssertExpectations(t)
+}
+
+func TestComputeOfferAmount_RoundTrip(t *testing.T) {
+	offerPool, askPool := math.NewInt(1_000_000), math.NewInt(2_000_000)
+	for _, rate := range []string{"0", "0.003", "0.01", "0.3"} {
+		commissionRate := math.LegacyMustNewDecFromStr(rate)
+		for _, askAmount := range []int64{1, 2, 3, 7, 100, 19742, 19743, 333_333, 1_000_000} {
+			offerAmount, _, _, err := computeOfferAmount(offerPool, askPool, math.NewInt(askAmount), commissionRate)
+			require.NoError(t, err, "rate(%s) ask(%d)", rate, askAmount)
+
+			returnAmount, _, _, err := computeSwap(offerPool, askPool, offerAmount, commissionRate)
+			require.NoError(t, err)
+			require.True(t, returnAmount.GTE(math.NewInt(askAmount)), "rate(%s) ask(%d) offer(%s) return(%s)", rate, askAmount, offerAmount, returnAmount)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package simulate
+
+import (
+	"cosmossdk.io/math"
+)
+
+// computeSwap follows compute_swap of the pair contract so that the result matches the on-chain simulation.
+//
+//	return = ask_pool - offer_pool * ask_pool / (offer_pool + offer_amount)
+//	spread = offer_amount * ask_pool / offer_pool - return
+//	commission = return * commission_rate, which is deducted from the return
+func computeSwap(offerPool, askPool, offerAmount math.Int, commissionRate math.LegacyDec) (math.Int, math.Int, math.Int, error) {
+	if offerPool.IsZero() || askPool.IsZero() {
+		return math.Int{}, math.Int{}, math.Int{}, ErrInsufficientLiquidity
+	}
+
+	cp := offerPool.Mul(askPool)
+	returnAmount := math.LegacyNewDecFromInt(askPool).
+		Sub(math.LegacyNewDecFromInt(cp).QuoTruncate(math.LegacyNewDecFromInt(offerPool.Add(offerAmount)))).
+		TruncateInt()
+
+	spreadAmount := math.LegacyNewDecFromInt(askPool).QuoTruncate(math.LegacyNewDecFromInt(offerPool)).MulInt(offerAmount).TruncateInt().Sub(returnAmount)
+	if spreadAmount.IsNegative() {
+		spreadAmount = math.ZeroInt()
+	}
+
+	commissionAmount := commissionRate.MulInt(returnAmount).TruncateInt()
+	return returnAmount.Sub(commissionAmount), spreadAmount, commissionAmount, nil
+}
+
+// computeOfferAmount follows compute_offer_amount of the pair contract, the counterpart of computeSwap.
+// The divisions are rounded up so that computeSwap of the offer returns at least the ask amount.
+//
+//	before_commission = ask_amount / (1 - commission_rate)
+//	offer = offer_pool * ask_pool / (ask_pool - before_commission) - offer_pool
+//	spread = offer * ask_pool / offer_pool - before_commission
+//	commission = before_commission * commission_rate
+func computeOfferAmount(offerPool, askPool, askAmount math.Int, commissionRate math.LegacyDec) (math.Int, math.Int, math.Int, error) {
+	if offerPool.IsZero() || askPool.IsZero() {
+		return math.Int{}, math.Int{}, math.Int{}, ErrInsufficientLiquidity
+	}
+
+	beforeCommission := math.LegacyNewDecFromInt(askAmount).QuoRoundUp(math.LegacyOneDec().Sub(commissionRate)).Ceil().TruncateInt()
+	if askPool.LTE(beforeCommission) {
+		return math.Int{}, math.Int{}, math.Int{}, ErrInsufficientReserves
+	}
+
+	remaining := askPool.Sub(beforeCommission)
+	offerAmount := offerPool.Mul(askPool).Add(remaining).SubRaw(1).Quo(remaining).Sub(offerPool)
+
+	spreadAmount := math.LegacyNewDecFromInt(askPool).QuoTruncate(math.LegacyNewDecFromInt(offerPool)).MulInt(offerAmount).TruncateInt().Sub(beforeCommission)
+	if spreadAmount.IsNegative() {
+		spreadAmount = math.ZeroInt()
+	}
+
+	commissionAmount := commissionRate.MulInt(beforeCommission).TruncateInt()
+	return offerAmount, spreadAmount, commissionAmount, nil
+}
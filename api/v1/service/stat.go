@@ -1,9 +1,11 @@
 package service
 
 import (
+	"context"
 	"time"
 
 	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
 	"github.com/dezswap/dezswap-api/pkg"
 
 	"github.com/dezswap/dezswap-api/pkg/db"
@@ -43,8 +45,16 @@ func NewStatService(chainId string, db *gorm.DB) Getter[PairStats] {
 	return &statService{chainId, db}
 }
 
+// withContext is the service of which the statements are under the span of ctx
+func (s *statService) withContext(ctx context.Context) *statService {
+	return &statService{s.chainId, s.WithContext(ctx)}
+}
+
 // Get implements Getter
-func (s *statService) Get(key string) (*PairStats, error) {
+func (s *statService) Get(ctx context.Context, key string) (*PairStats, error) {
+	ctx, span := tracing.Start(ctx, "statService.Get")
+	defer span.End()
+	s = s.withContext(ctx)
 	pairStatMap := make(map[string][countOfStatType]math.LegacyDec)
 
 	switch key {
@@ -88,7 +98,10 @@ func (s *statService) Get(key string) (*PairStats, error) {
 }
 
 // GetAll implements Getter
-func (s *statService) GetAll() ([]PairStats, error) {
+func (s *statService) GetAll(ctx context.Context) ([]PairStats, error) {
+	ctx, span := tracing.Start(ctx, "statService.GetAll")
+	defer span.End()
+	s = s.withContext(ctx)
 	pairStatsByPeriod := make([]PairStats, CountOfPeriodType)
 	pairStatMap := make(map[string][countOfStatType]math.LegacyDec)
 
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -51,7 +52,7 @@ func TestStatService_GetAll_Empty(t *testing.T) {
 			}),
 		)
 
-	actual, err := service.GetAll()
+	actual, err := service.GetAll(context.Background())
 	require.NoError(t, err)
 	require.Len(t, actual, int(CountOfPeriodType))
 
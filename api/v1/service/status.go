@@ -1,29 +1,141 @@
 package service
 
 import (
+	"time"
+
 	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
+// PriceFetcher tells when the USD prices were fetched last
+type PriceFetcher interface {
+	LastPriceFetch() time.Time
+}
+
 type statusService struct {
+	chainId string
 	*gorm.DB
 	cache.Cache
+	prices PriceFetcher
+	now    func() time.Time
 }
 
-func NewStatusService(db *gorm.DB, cache cache.Cache) StatusService {
-	return &statusService{db, cache}
+// NewStatusService returns the status service, prices is optional
+func NewStatusService(chainId string, db *gorm.DB, cache cache.Cache, prices PriceFetcher) StatusService {
+	return &statusService{chainId, db, cache, prices, time.Now}
 }
 
-func (s *statusService) CheckDB() error {
+// syncedHeightSeen is when the synced height was seen first at its height
+type syncedHeightSeen struct {
+	Height uint64
+	SeenAt time.Time
+}
+
+func (s *statusService) CheckDB() (time.Duration, error) {
+	start := time.Now()
 	if err := s.Exec("SELECT 1").Error; err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return time.Since(start), nil
 }
 
-func (s *statusService) CheckCache() error {
+func (s *statusService) CheckCache() (time.Duration, error) {
+	start := time.Now()
 	if err := s.Ping(); err != nil {
-		return err
+		return 0, err
+	}
+	return time.Since(start), nil
+}
+
+// Indexer implements StatusService
+func (s *statusService) Indexer() (*IndexerStatus, error) {
+	// the indexer writes the changed pools at the height of the node, a pool change of a tx above the height is not in the latest pools yet
+	query := `
+select coalesce(sh.height, 0) synced_height,
+       coalesce(lp.height, 0) pool_height,
+       (select min(timestamp)
+        from parsed_tx
+        where chain_id = ?
+          and type in ('swap', 'provide', 'withdraw')
+          and height > coalesce(lp.height, 0)
+          and height <= coalesce(sh.height, 0)) pools_behind_since
+from (select 1) one
+    left join (select height from synced_height where chain_id = ? limit 1) sh on true
+    left join (select max(height) height from latest_pools where chain_id = ?) lp on true
+`
+	row := struct {
+		SyncedHeight     uint64
+		PoolHeight       uint64
+		PoolsBehindSince *float64
+	}{}
+	if err := s.Raw(query, s.chainId, s.chainId, s.chainId).Scan(&row).Error; err != nil {
+		return nil, errors.Wrap(err, "statusService.Indexer")
+	}
+
+	status := &IndexerStatus{SyncedHeight: row.SyncedHeight, PoolHeight: row.PoolHeight}
+	if row.PoolsBehindSince != nil {
+		status.PoolsBehindSince = unixTime(*row.PoolsBehindSince)
+	}
+	if row.SyncedHeight == 0 || s.Cache == nil {
+		return status, nil
+	}
+	syncedAt, err := s.syncedAt(row.SyncedHeight)
+	if err != nil {
+		return nil, errors.Wrap(err, "statusService.Indexer")
+	}
+	status.SyncedAt = syncedAt
+	return status, nil
+}
+
+// syncedAt is when the height was seen first, the parser advances the synced height every block with or without txs
+func (s *statusService) syncedAt(height uint64) (time.Time, error) {
+	key := "status:synced_height:" + s.chainId
+	// decoded into the zero value, the zero fields are not encoded
+	seen := syncedHeightSeen{}
+	if err := s.Cache.Get(key, &seen); err != nil && !errors.Is(err, cache.ErrCacheMiss) {
+		return time.Time{}, err
+	}
+	if seen.Height == height {
+		return seen.SeenAt, nil
+	}
+
+	seen = syncedHeightSeen{Height: height, SeenAt: s.now().UTC()}
+	if err := s.Cache.Set(key, seen, cache.CacheLifeTimeNeverExpired); err != nil {
+		return time.Time{}, err
+	}
+	return seen.SeenAt, nil
+}
+
+// Routes implements StatusService
+func (s *statusService) Routes() (*RouteStatus, error) {
+	row := struct {
+		Count      int64
+		ModifiedAt *float64
+	}{}
+	if err := s.Table("route").
+		Select("count(*) count, max(modified_at) modified_at").
+		Where("chain_id = ?", s.chainId).
+		Scan(&row).Error; err != nil {
+		return nil, errors.Wrap(err, "statusService.Routes")
 	}
-	return nil
+
+	status := &RouteStatus{Count: row.Count}
+	if row.ModifiedAt != nil {
+		status.ModifiedAt = unixTime(*row.ModifiedAt)
+	}
+	return status, nil
+}
+
+// LastPriceFetch implements StatusService
+func (s *statusService) LastPriceFetch() time.Time {
+	if s.prices == nil {
+		return time.Time{}
+	}
+	return s.prices.LastPriceFetch()
+}
+
+// unixTime is the time of the timestamp in seconds
+func unixTime(timestamp float64) time.Time {
+	return time.UnixMilli(int64(timestamp * 1_000)).UTC()
 }
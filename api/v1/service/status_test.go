@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/dezswap/dezswap-api/pkg/cache/memory"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+type priceFetcherMock time.Time
+
+func (m priceFetcherMock) LastPriceFetch() time.Time {
+	return time.Time(m)
+}
+
+func TestStatusService(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	fetched := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	service := NewStatusService("test-chain", gormDB, memory.NewMemoryCache(ctx, cache.NewByteCodec()), priceFetcherMock(fetched)).(*statusService)
+	now := time.Date(2026, 10, 19, 1, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	mock.ExpectQuery(`from synced_height`).
+		WithArgs("test-chain", "test-chain", "test-chain").
+		WillReturnRows(sqlmock.NewRows([]string{"synced_height", "pool_height", "pools_behind_since"}).
+			AddRow(100, 95, 1_760_832_000.5))
+	mock.ExpectQuery(`FROM "route" WHERE chain_id = \$1`).
+		WithArgs("test-chain").
+		WillReturnRows(sqlmock.NewRows([]string{"count", "modified_at"}).AddRow(3, 1_760_832_000))
+	// the synced height is aged from when it was seen first
+	mock.ExpectQuery(`from synced_height`).
+		WillReturnRows(sqlmock.NewRows([]string{"synced_height", "pool_height", "pools_behind_since"}).
+			AddRow(100, 105, nil))
+	mock.ExpectQuery(`from synced_height`).
+		WillReturnRows(sqlmock.NewRows([]string{"synced_height", "pool_height", "pools_behind_since"}).
+			AddRow(101, 105, nil))
+	// no data of the chain
+	mock.ExpectQuery(`from synced_height`).
+		WillReturnRows(sqlmock.NewRows([]string{"synced_height", "pool_height", "pools_behind_since"}).
+			AddRow(0, 0, nil))
+	mock.ExpectQuery(`FROM "route"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "modified_at"}).AddRow(0, nil))
+
+	indexer, err := service.Indexer()
+	require.NoError(t, err)
+	require.Equal(t, &IndexerStatus{
+		SyncedHeight:     100,
+		SyncedAt:         now,
+		PoolHeight:       95,
+		PoolsBehindSince: time.UnixMilli(1_760_832_000_500).UTC(),
+	}, indexer)
+
+	routes, err := service.Routes()
+	require.NoError(t, err)
+	require.Equal(t, &RouteStatus{Count: 3, ModifiedAt: time.Unix(1_760_832_000, 0).UTC()}, routes)
+
+	seenAt := now
+	now = now.Add(time.Minute)
+	indexer, err = service.Indexer()
+	require.NoError(t, err)
+	require.Equal(t, &IndexerStatus{SyncedHeight: 100, SyncedAt: seenAt, PoolHeight: 105}, indexer)
+	indexer, err = service.Indexer()
+	require.NoError(t, err)
+	require.Equal(t, &IndexerStatus{SyncedHeight: 101, SyncedAt: now, PoolHeight: 105}, indexer)
+
+	indexer, err = service.Indexer()
+	require.NoError(t, err)
+	require.Equal(t, &IndexerStatus{}, indexer)
+
+	routes, err = service.Routes()
+	require.NoError(t, err)
+	require.Equal(t, &RouteStatus{}, routes)
+
+	require.Equal(t, fetched, service.LastPriceFetch())
+	require.True(t, NewStatusService("test-chain", gormDB, nil, nil).LastPriceFetch().IsZero())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,69 @@
+package stream
+
+type Topic string
+
+const (
+	TopicTx    Topic = "tx"
+	TopicPool  Topic = "pool"
+	TopicPrice Topic = "price"
+)
+
+// Filter selects the events of the topics and of the pairs or the tokens, every event matches an empty filter
+type Filter struct {
+	Topics []Topic
+	Pairs  []string
+	Tokens []string
+}
+
+// Cursor is the position of the indexed data up to which the events are published
+type Cursor struct {
+	TxId       uint64
+	TxHeight   uint64
+	PoolHeight uint64
+}
+
+type Tx struct {
+	Id           uint64
+	Height       uint64
+	Timestamp    float64
+	Hash         string
+	Type         string
+	Sender       string
+	Pair         string
+	Asset0       string
+	Asset0Amount string
+	Asset1       string
+	Asset1Amount string
+	// decimals of the assets to derive the prices, 0 when the token is unknown
+	Asset0Decimals int
+	Asset1Decimals int
+}
+
+// Pool is the reserves of the pair at the height
+type Pool struct {
+	Pair         string
+	Asset0       string
+	Asset0Amount string
+	Asset1       string
+	Asset1Amount string
+	Height       uint64
+}
+
+// Price is the price of asset0 in asset1 made by a swap
+type Price struct {
+	Pair      string
+	Asset0    string
+	Asset1    string
+	Price     string
+	Height    uint64
+	Timestamp float64
+}
+
+// Event has one of Tx, Pool and Price by the topic
+type Event struct {
+	Topic  Topic
+	Height uint64
+	Tx     *Tx
+	Pool   *Pool
+	Price  *Price
+}
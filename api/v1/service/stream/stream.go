@@ -0,0 +1,322 @@
+package stream
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/pkg/errors"
+)
+
+const (
+	txPageSize = 500
+	// replayWindow is the number of blocks replayed at once
+	replayWindow = 100
+)
+
+var (
+	ErrLagged             = errors.New("subscriber lagged behind")
+	ErrReplayTooFar       = errors.New("replay height is too far behind")
+	ErrTooManySubscribers = errors.New("too many subscribers")
+)
+
+type Repo interface {
+	Cursor() (Cursor, error)
+	// TxsAfter returns the txs of the id greater than afterId in the order of the id up to limit
+	TxsAfter(afterId uint64, limit int) ([]Tx, error)
+	// TxsBetween returns the txs of the heights inclusive and the id not greater than untilId in the order of the id
+	TxsBetween(fromHeight, toHeight, untilId uint64) ([]Tx, error)
+	// PoolsAfter returns the latest pools updated after the height in the order of the height
+	PoolsAfter(height uint64) ([]Pool, error)
+	// PoolsBetween returns the pools of the heights inclusive in the order of the height
+	PoolsBetween(fromHeight, toHeight uint64) ([]Pool, error)
+}
+
+type Service interface {
+	// Subscribe sends the events matching the filter until ctx is done or send fails, replaying from the height first when fromHeight is positive.
+	// It returns ErrLagged when the subscriber does not keep up with the live events.
+	Subscribe(ctx context.Context, filter Filter, fromHeight uint64, send func(Event) error) error
+}
+
+type streamImpl struct {
+	repo            Repo
+	interval        time.Duration
+	bufferSize      int
+	maxReplayBlocks uint64
+	maxSubscribers  int
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+	cursor      Cursor
+	// cancel stops the polling, which runs only while there are subscribers
+	cancel context.CancelFunc
+}
+
+type subscriber struct {
+	filter Filter
+	events chan Event
+	done   chan struct{}
+	err    error
+}
+
+// New returns a stream Service polling the repo every interval while anyone subscribes
+func New(repo Repo, interval time.Duration, bufferSize, maxReplayBlocks, maxSubscribers int) Service {
+	return &streamImpl{
+		repo:            repo,
+		interval:        interval,
+		bufferSize:      bufferSize,
+		maxReplayBlocks: uint64(maxReplayBlocks),
+		maxSubscribers:  maxSubscribers,
+		subscribers:     map[*subscriber]struct{}{},
+	}
+}
+
+// Subscribe implements Service
+func (s *streamImpl) Subscribe(ctx context.Context, filter Filter, fromHeight uint64, send func(Event) error) error {
+	sub, cursor, err := s.register(filter)
+	if err != nil {
+		return err
+	}
+	defer s.unregister(sub)
+
+	// the live events after the cursor are buffered while replaying
+	if fromHeight > 0 {
+		if err := s.replay(filter, fromHeight, cursor, send); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.done:
+			return sub.err
+		case event := <-sub.events:
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *streamImpl) register(filter Filter) (*subscriber, Cursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.subscribers) >= s.maxSubscribers {
+		return nil, Cursor{}, ErrTooManySubscribers
+	}
+	if s.cancel == nil {
+		cursor, err := s.repo.Cursor()
+		if err != nil {
+			return nil, Cursor{}, errors.Wrap(err, "stream.Subscribe")
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		s.cursor, s.cancel = cursor, cancel
+		go s.poll(ctx)
+	}
+
+	sub := &subscriber{filter: filter, events: make(chan Event, s.bufferSize), done: make(chan struct{})}
+	s.subscribers[sub] = struct{}{}
+	return sub, s.cursor, nil
+}
+
+func (s *streamImpl) unregister(sub *subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.subscribers, sub)
+	if len(s.subscribers) == 0 && s.cancel != nil {
+		s.cancel()
+		s.cancel = nil
+	}
+}
+
+func (s *streamImpl) replay(filter Filter, fromHeight uint64, cursor Cursor, send func(Event) error) error {
+	toHeight := max(cursor.TxHeight, cursor.PoolHeight)
+	if fromHeight > toHeight {
+		return nil
+	}
+	if toHeight-fromHeight > s.maxReplayBlocks {
+		return ErrReplayTooFar
+	}
+
+	for from := fromHeight; from <= toHeight; from += replayWindow {
+		to := min(from+replayWindow-1, toHeight)
+		txs, err := s.repo.TxsBetween(from, to, cursor.TxId)
+		if err != nil {
+			return errors.Wrap(err, "stream.replay")
+		}
+		pools := []Pool{}
+		if from <= cursor.PoolHeight {
+			if pools, err = s.repo.PoolsBetween(from, min(to, cursor.PoolHeight)); err != nil {
+				return errors.Wrap(err, "stream.replay")
+			}
+		}
+
+		// the pools are the reserves at the end of the block, after its txs
+		events := toEvents(txs, pools)
+		sort.SliceStable(events, func(i, j int) bool { return events[i].Height < events[j].Height })
+		for _, event := range events {
+			if !filter.match(event) {
+				continue
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *streamImpl) poll(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.publish(ctx); err != nil {
+			s.closeAll(ctx, errors.Wrap(err, "stream.poll"))
+			return
+		}
+	}
+}
+
+// publish fetches the txs and the pools after the cursor and sends them to the subscribers
+func (s *streamImpl) publish(ctx context.Context) error {
+	s.mu.Lock()
+	cursor := s.cursor
+	s.mu.Unlock()
+
+	txs := []Tx{}
+	for {
+		page, err := s.repo.TxsAfter(cursor.TxId, txPageSize)
+		if err != nil {
+			return err
+		}
+		for _, tx := range page {
+			cursor.TxId, cursor.TxHeight = tx.Id, max(cursor.TxHeight, tx.Height)
+		}
+		txs = append(txs, page...)
+		if len(page) < txPageSize {
+			break
+		}
+	}
+	pools, err := s.repo.PoolsAfter(cursor.PoolHeight)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		cursor.PoolHeight = max(cursor.PoolHeight, pool.Height)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// the subscribers left while fetching, a new polling may have started from its own cursor
+	if ctx.Err() != nil {
+		return nil
+	}
+	for _, event := range toEvents(txs, pools) {
+		for sub := range s.subscribers {
+			if !sub.filter.match(event) {
+				continue
+			}
+			select {
+			case sub.events <- event:
+			default:
+				// a slow subscriber must not hold the others back, it resumes by replaying
+				s.close(sub, ErrLagged)
+			}
+		}
+	}
+	s.cursor = cursor
+	return nil
+}
+
+func (s *streamImpl) closeAll(ctx context.Context, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ctx.Err() != nil {
+		return
+	}
+	for sub := range s.subscribers {
+		s.close(sub, err)
+	}
+	s.cancel()
+	s.cancel = nil
+}
+
+// close must be called with the lock held
+func (s *streamImpl) close(sub *subscriber, err error) {
+	sub.err = err
+	close(sub.done)
+	delete(s.subscribers, sub)
+}
+
+func toEvents(txs []Tx, pools []Pool) []Event {
+	events := make([]Event, 0, len(txs)+len(pools))
+	for i := range txs {
+		tx := &txs[i]
+		events = append(events, Event{Topic: TopicTx, Height: tx.Height, Tx: tx})
+		if price, ok := swapPrice(*tx); ok {
+			events = append(events, Event{Topic: TopicPrice, Height: tx.Height, Price: &price})
+		}
+	}
+	for i := range pools {
+		events = append(events, Event{Topic: TopicPool, Height: pools[i].Height, Pool: &pools[i]})
+	}
+	return events
+}
+
+// swapPrice returns the price of asset0 in asset1 of the swap, the amounts are signed from the pool
+func swapPrice(tx Tx) (Price, bool) {
+	if tx.Type != "swap" {
+		return Price{}, false
+	}
+	amount0, ok0 := math.NewIntFromString(tx.Asset0Amount)
+	amount1, ok1 := math.NewIntFromString(tx.Asset1Amount)
+	if !ok0 || !ok1 || amount0.IsZero() {
+		return Price{}, false
+	}
+
+	value0 := math.LegacyNewDecFromIntWithPrec(amount0.Abs(), int64(tx.Asset0Decimals))
+	value1 := math.LegacyNewDecFromIntWithPrec(amount1.Abs(), int64(tx.Asset1Decimals))
+	return Price{
+		Pair:      tx.Pair,
+		Asset0:    tx.Asset0,
+		Asset1:    tx.Asset1,
+		Price:     value1.Quo(value0).String(),
+		Height:    tx.Height,
+		Timestamp: tx.Timestamp,
+	}, true
+}
+
+func (f Filter) match(event Event) bool {
+	if len(f.Topics) > 0 && !slices.Contains(f.Topics, event.Topic) {
+		return false
+	}
+	if len(f.Pairs) == 0 && len(f.Tokens) == 0 {
+		return true
+	}
+
+	var pair, asset0, asset1 string
+	switch {
+	case event.Tx != nil:
+		pair, asset0, asset1 = event.Tx.Pair, event.Tx.Asset0, event.Tx.Asset1
+	case event.Pool != nil:
+		pair, asset0, asset1 = event.Pool.Pair, event.Pool.Asset0, event.Pool.Asset1
+	case event.Price != nil:
+		pair, asset0, asset1 = event.Price.Pair, event.Price.Asset0, event.Price.Asset1
+	}
+	return slices.Contains(f.Pairs, pair) || slices.Contains(f.Tokens, asset0) || slices.Contains(f.Tokens, asset1)
+}
@@ -0,0 +1,173 @@
+package stream
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// repoFake serves the txs and the pools appended by the test
+type repoFake struct {
+	mu    sync.Mutex
+	txs   []Tx
+	pools []Pool
+}
+
+func (r *repoFake) add(txs []Tx, pools []Pool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.txs = append(r.txs, txs...)
+	r.pools = append(r.pools, pools...)
+}
+
+func (r *repoFake) Cursor() (Cursor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cursor := Cursor{}
+	for _, tx := range r.txs {
+		cursor.TxId, cursor.TxHeight = tx.Id, max(cursor.TxHeight, tx.Height)
+	}
+	for _, pool := range r.pools {
+		cursor.PoolHeight = max(cursor.PoolHeight, pool.Height)
+	}
+	return cursor, nil
+}
+
+func (r *repoFake) TxsAfter(afterId uint64, limit int) ([]Tx, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	txs := []Tx{}
+	for _, tx := range r.txs {
+		if tx.Id > afterId && len(txs) < limit {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+func (r *repoFake) TxsBetween(fromHeight, toHeight, untilId uint64) ([]Tx, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	txs := []Tx{}
+	for _, tx := range r.txs {
+		if tx.Height >= fromHeight && tx.Height <= toHeight && tx.Id <= untilId {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+func (r *repoFake) PoolsAfter(height uint64) ([]Pool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	latest := map[string]Pool{}
+	for _, pool := range r.pools {
+		latest[pool.Pair] = pool
+	}
+	pools := []Pool{}
+	for _, pool := range latest {
+		if pool.Height > height {
+			pools = append(pools, pool)
+		}
+	}
+	return pools, nil
+}
+
+func (r *repoFake) PoolsBetween(fromHeight, toHeight uint64) ([]Pool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	pools := []Pool{}
+	for _, pool := range r.pools {
+		if pool.Height >= fromHeight && pool.Height <= toHeight {
+			pools = append(pools, pool)
+		}
+	}
+	return pools, nil
+}
+
+func swapTx(id, height uint64, pair string) Tx {
+	return Tx{Id: id, Height: height, Type: "swap", Pair: pair, Asset0: pair + "0", Asset0Amount: "1000000", Asset1: pair + "1", Asset1Amount: "-2000000", Asset0Decimals: 6, Asset1Decimals: 6}
+}
+
+func TestStream_ReplayThenLive(t *testing.T) {
+	repo := &repoFake{}
+	repo.add([]Tx{swapTx(1, 10, "pairA"), swapTx(2, 11, "pairB")}, []Pool{{Pair: "pairA", Height: 10}})
+	s := New(repo, 10*time.Millisecond, 16, 100, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := []Event{}
+	done := make(chan error)
+	go func() {
+		done <- s.Subscribe(ctx, Filter{Pairs: []string{"pairA"}}, 10, func(e Event) error {
+			events = append(events, e)
+			if len(events) == 5 {
+				cancel()
+			}
+			return nil
+		})
+	}()
+
+	time.Sleep(30 * time.Millisecond)
+	repo.add([]Tx{swapTx(3, 12, "pairA")}, nil)
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("subscription did not end")
+	}
+
+	require.Len(t, events, 5)
+	assert.Equal(t, TopicTx, events[0].Topic)
+	assert.Equal(t, uint64(1), events[0].Tx.Id)
+	assert.Equal(t, TopicPrice, events[1].Topic)
+	assert.Equal(t, "2.000000000000000000", events[1].Price.Price)
+	assert.Equal(t, TopicPool, events[2].Topic)
+	assert.Equal(t, uint64(3), events[3].Tx.Id)
+	assert.Equal(t, TopicPrice, events[4].Topic)
+}
+
+func TestStream_Lagged(t *testing.T) {
+	repo := &repoFake{}
+	s := New(repo, 10*time.Millisecond, 1, 100, 10)
+
+	block := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- s.Subscribe(context.Background(), Filter{Topics: []Topic{TopicTx}}, 0, func(e Event) error {
+			<-block
+			return nil
+		})
+	}()
+
+	time.Sleep(30 * time.Millisecond)
+	repo.add([]Tx{swapTx(1, 10, "pair"), swapTx(2, 10, "pair"), swapTx(3, 10, "pair")}, nil)
+	time.Sleep(30 * time.Millisecond)
+
+	// the slow subscriber is dropped from the live events at once and ends after the pending send
+	s.(*streamImpl).mu.Lock()
+	assert.Empty(t, s.(*streamImpl).subscribers)
+	s.(*streamImpl).mu.Unlock()
+	close(block)
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, ErrLagged)
+	case <-time.After(time.Second):
+		t.Fatal("slow subscriber was not disconnected")
+	}
+}
+
+func TestStream_ReplayTooFar(t *testing.T) {
+	repo := &repoFake{}
+	repo.add([]Tx{swapTx(1, 1_000, "pair")}, nil)
+	s := New(repo, time.Second, 16, 100, 10)
+
+	err := s.Subscribe(context.Background(), Filter{}, 1, func(e Event) error { return nil })
+
+	assert.ErrorIs(t, err, ErrReplayTooFar)
+}
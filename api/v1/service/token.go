@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+
+	"github.com/dezswap/dezswap-api/api/tracing"
 	"github.com/dezswap/dezswap-api/pkg/db/indexer"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
@@ -18,9 +21,11 @@ func NewTokenService(chainId string, db *gorm.DB) Getter[Token] {
 }
 
 // Get implements Getter
-func (s *tokenService) Get(key string) (*Token, error) {
+func (s *tokenService) Get(ctx context.Context, key string) (*Token, error) {
+	ctx, span := tracing.Start(ctx, "tokenService.Get")
+	defer span.End()
 	token := &indexer.Token{}
-	if err := s.Model(&indexer.Token{}).Where("chain_id = ? and address = ?", s.chainId, key).Omit("id,created_at,updated_at,deleted_at").Find(token).Error; err != nil {
+	if err := s.WithContext(ctx).Model(&indexer.Token{}).Where("chain_id = ? and address = ?", s.chainId, key).Omit("id,created_at,updated_at,deleted_at").Find(token).Error; err != nil {
 		return nil, errors.Wrap(err, "TokenService.Get")
 	}
 
@@ -32,9 +37,11 @@ func (s *tokenService) Get(key string) (*Token, error) {
 }
 
 // GetAll implements Getter
-func (s *tokenService) GetAll() ([]Token, error) {
+func (s *tokenService) GetAll(ctx context.Context) ([]Token, error) {
+	ctx, span := tracing.Start(ctx, "tokenService.GetAll")
+	defer span.End()
 	tokens := []indexer.Token{}
-	if err := s.Model(&indexer.Token{}).Where("chain_id = ?", s.chainId).Omit("id,created_at,updated_at,deleted_at").Order("id").Find(&tokens).Error; err != nil {
+	if err := s.WithContext(ctx).Model(&indexer.Token{}).Where("chain_id = ?", s.chainId).Omit("id,created_at,updated_at,deleted_at").Order("id").Find(&tokens).Error; err != nil {
 		return nil, errors.Wrap(err, "TokenService.GetAll")
 	}
 
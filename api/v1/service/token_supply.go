@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type tokenSupplyService struct {
+	chainId string
+	*gorm.DB
+}
+
+var _ Getter[TokenSupply] = &tokenSupplyService{}
+
+func NewTokenSupplyService(chainId string, db *gorm.DB) Getter[TokenSupply] {
+	return &tokenSupplyService{chainId, db}
+}
+
+type tokenSupplyRow struct {
+	Address           string
+	Decimals          uint8
+	TotalSupply       string
+	CirculatingSupply string
+}
+
+const latestTokenSupplyQuery = `
+select distinct on (t.address) t.address, t.decimals, s.total_supply, s.circulating_supply
+from tokens t
+    join token_supplies s on s.chain_id = t.chain_id and s.address = t.address
+where t.chain_id = ?`
+
+// Get implements Getter
+func (s *tokenSupplyService) Get(ctx context.Context, key string) (*TokenSupply, error) {
+	ctx, span := tracing.Start(ctx, "tokenSupplyService.Get")
+	defer span.End()
+	rows := []tokenSupplyRow{}
+	query := latestTokenSupplyQuery + ` and t.address = ? order by t.address, s.height desc`
+	if err := s.WithContext(ctx).Raw(query, s.chainId, key).Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "tokenSupplyService.Get")
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	supply := rows[0].toTokenSupply()
+	return &supply, nil
+}
+
+// GetAll implements Getter
+func (s *tokenSupplyService) GetAll(ctx context.Context) ([]TokenSupply, error) {
+	ctx, span := tracing.Start(ctx, "tokenSupplyService.GetAll")
+	defer span.End()
+	rows := []tokenSupplyRow{}
+	query := latestTokenSupplyQuery + ` order by t.address, s.height desc`
+	if err := s.WithContext(ctx).Raw(query, s.chainId).Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "tokenSupplyService.GetAll")
+	}
+
+	supplies := make([]TokenSupply, len(rows))
+	for i, r := range rows {
+		supplies[i] = r.toTokenSupply()
+	}
+	return supplies, nil
+}
+
+func (r tokenSupplyRow) toTokenSupply() TokenSupply {
+	return TokenSupply{
+		Address:           r.Address,
+		TotalSupply:       normalizeAmount(r.TotalSupply, r.Decimals),
+		CirculatingSupply: normalizeAmount(r.CirculatingSupply, r.Decimals),
+	}
+}
+
+// normalizeAmount shifts the decimal point of an integer amount by decimals, e.g. ("1234500", 6) => "1.2345"
+func normalizeAmount(amount string, decimals uint8) string {
+	if amount == "" {
+		return "0"
+	}
+	if decimals == 0 {
+		return amount
+	}
+
+	digits := int(decimals)
+	if len(amount) <= digits {
+		amount = strings.Repeat("0", digits-len(amount)+1) + amount
+	}
+	integer, fraction := amount[:len(amount)-digits], strings.TrimRight(amount[len(amount)-digits:], "0")
+	if fraction == "" {
+		return integer
+	}
+	return integer + "." + fraction
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func TestTokenSupplyService_Get(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	service := &tokenSupplyService{chainId: "test-chain", DB: gormDB}
+
+	mock.ExpectQuery(`from tokens t`).
+		WithArgs("test-chain", "xpla1token").
+		WillReturnRows(sqlmock.NewRows([]string{"address", "decimals", "total_supply", "circulating_supply"}).
+			AddRow("xpla1token", 6, "1000000500000", "250000000000"))
+	mock.ExpectQuery(`from tokens t`).
+		WithArgs("test-chain", "xpla1unknown").
+		WillReturnRows(sqlmock.NewRows([]string{"address", "decimals", "total_supply", "circulating_supply"}))
+
+	supply, err := service.Get(context.Background(), "xpla1token")
+	require.NoError(t, err)
+	require.Equal(t, &TokenSupply{Address: "xpla1token", TotalSupply: "1000000.5", CirculatingSupply: "250000"}, supply)
+
+	supply, err = service.Get(context.Background(), "xpla1unknown")
+	require.NoError(t, err)
+	require.Nil(t, supply)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNormalizeAmount(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		decimals uint8
+		expected string
+	}{
+		{"", 6, "0"},
+		{"0", 6, "0"},
+		{"1000000", 0, "1000000"},
+		{"1000000", 6, "1"},
+		{"1234500", 6, "1.2345"},
+		{"1", 6, "0.000001"},
+		{"123", 18, "0.000000000000000123"},
+		{"1000000000000000000000000", 18, "1000000"},
+	}
+
+	for _, tc := range tcs {
+		require.Equal(t, tc.expected, normalizeAmount(tc.amount, tc.decimals), "amount(%s) decimals(%d)", tc.amount, tc.decimals)
+	}
+}
@@ -0,0 +1,210 @@
+package tx
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/tracing"
+	ss "github.com/dezswap/dezswap-api/api/v1/service/simulate"
+	"github.com/dezswap/dezswap-api/pkg"
+	"github.com/dezswap/dezswap-api/pkg/dezswap"
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidSlippage = errors.New("slippage must be in [0, 1)")
+
+type Coin struct {
+	Denom  string
+	Amount math.Int
+}
+
+// ExecuteMsg is an unsigned MsgExecuteContract
+type ExecuteMsg struct {
+	Sender   string
+	Contract string
+	Msg      json.RawMessage
+	Funds    []Coin
+}
+
+type SwapTx struct {
+	Msgs           []ExecuteMsg
+	MinimumReceive math.Int
+	Simulation     ss.RouteSimulation
+}
+
+type Builder interface {
+	// Swap builds execute_swap_operations of the router along the route, minimum_receive is the simulated return less the slippage
+	Swap(ctx context.Context, sender string, route []string, amount math.Int, slippage math.LegacyDec) (*SwapTx, error)
+	// Provide builds provide_liquidity of the pair with the deposits in the asset order of the pair and the allowances of cw20 deposits
+	Provide(ctx context.Context, sender, pair string, deposit0, deposit1 math.Int, slippage math.LegacyDec) ([]ExecuteMsg, error)
+	// Withdraw builds the send of lp tokens to the pair which withdraws the liquidity
+	Withdraw(ctx context.Context, sender, pair string, lpAmount math.Int) ([]ExecuteMsg, error)
+}
+
+type builderImpl struct {
+	ss.PoolRepo
+	ss.Simulator
+	pkg.NetworkMetadata
+	chainId string
+}
+
+func NewBuilder(chainId string, networkMetadata pkg.NetworkMetadata, repo ss.PoolRepo, simulator ss.Simulator) Builder {
+	return &builderImpl{repo, simulator, networkMetadata, chainId}
+}
+
+// Swap implements Builder
+func (b *builderImpl) Swap(ctx context.Context, sender string, route []string, amount math.Int, slippage math.LegacyDec) (*SwapTx, error) {
+	ctx, span := tracing.Start(ctx, "builder.Swap")
+	defer span.End()
+	if err := validateSlippage(slippage); err != nil {
+		return nil, err
+	}
+	router, err := b.GetRouterAddress(b.chainId)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation, err := b.SwapRoute(ctx, route, amount)
+	if err != nil {
+		return nil, err
+	}
+	minimumReceive := math.LegacyOneDec().Sub(slippage).MulInt(simulation.ReturnAmount).TruncateInt()
+
+	operations := make([]map[string]interface{}, len(route)-1)
+	for i := range operations {
+		operations[i] = map[string]interface{}{
+			"dez_swap": map[string]dezswap.AssetInfoTokenRes{
+				"offer_asset_info": dezswap.ToAssetInfoTokenRes(route[i], b.NetworkMetadata),
+				"ask_asset_info":   dezswap.ToAssetInfoTokenRes(route[i+1], b.NetworkMetadata),
+			},
+		}
+	}
+	swapMsg, err := json.Marshal(map[string]interface{}{
+		"execute_swap_operations": map[string]interface{}{
+			"operations":      operations,
+			"minimum_receive": minimumReceive.String(),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "builder.Swap")
+	}
+
+	msg, err := b.executeWithAsset(sender, router, route[0], amount, swapMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "builder.Swap")
+	}
+
+	return &SwapTx{
+		Msgs:           []ExecuteMsg{*msg},
+		MinimumReceive: minimumReceive,
+		Simulation:     *simulation,
+	}, nil
+}
+
+// Provide implements Builder
+func (b *builderImpl) Provide(ctx context.Context, sender, pair string, deposit0, deposit1 math.Int, slippage math.LegacyDec) ([]ExecuteMsg, error) {
+	ctx, span := tracing.Start(ctx, "builder.Provide")
+	defer span.End()
+	if err := validateSlippage(slippage); err != nil {
+		return nil, err
+	}
+	pool, err := b.PoolOf(ctx, pair)
+	if err != nil {
+		return nil, errors.Wrap(err, "builder.Provide")
+	}
+	if pool == nil {
+		return nil, ss.ErrPoolNotFound
+	}
+
+	msgs := []ExecuteMsg{}
+	funds := []Coin{}
+	deposits := []struct {
+		asset  string
+		amount math.Int
+	}{{pool.Asset0, deposit0}, {pool.Asset1, deposit1}}
+	for _, d := range deposits {
+		if !b.IsCw20(d.asset) {
+			funds = append(funds, Coin{d.asset, d.amount})
+			continue
+		}
+		allowance, err := json.Marshal(map[string]interface{}{
+			"increase_allowance": map[string]string{"spender": pool.Address, "amount": d.amount.String()},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "builder.Provide")
+		}
+		msgs = append(msgs, ExecuteMsg{Sender: sender, Contract: d.asset, Msg: allowance, Funds: []Coin{}})
+	}
+	sort.Slice(funds, func(i, j int) bool { return funds[i].Denom < funds[j].Denom })
+
+	provide, err := json.Marshal(map[string]interface{}{
+		"provide_liquidity": map[string]interface{}{
+			"assets": []dezswap.AssetInfoRes{
+				dezswap.ToAssetInfoRes(pool.Asset0, deposit0.String(), b.NetworkMetadata),
+				dezswap.ToAssetInfoRes(pool.Asset1, deposit1.String(), b.NetworkMetadata),
+			},
+			"slippage_tolerance": slippage.String(),
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "builder.Provide")
+	}
+
+	return append(msgs, ExecuteMsg{Sender: sender, Contract: pool.Address, Msg: provide, Funds: funds}), nil
+}
+
+// Withdraw implements Builder
+func (b *builderImpl) Withdraw(ctx context.Context, sender, pair string, lpAmount math.Int) ([]ExecuteMsg, error) {
+	ctx, span := tracing.Start(ctx, "builder.Withdraw")
+	defer span.End()
+	pool, err := b.PoolOf(ctx, pair)
+	if err != nil {
+		return nil, errors.Wrap(err, "builder.Withdraw")
+	}
+	if pool == nil {
+		return nil, ss.ErrPoolNotFound
+	}
+	if lpAmount.GT(pool.LpAmount) {
+		return nil, ss.ErrInvalidShare
+	}
+
+	withdraw, err := json.Marshal(map[string]interface{}{"withdraw_liquidity": map[string]interface{}{}})
+	if err != nil {
+		return nil, errors.Wrap(err, "builder.Withdraw")
+	}
+	msg, err := b.executeWithAsset(sender, pool.Address, pool.Lp, lpAmount, withdraw)
+	if err != nil {
+		return nil, errors.Wrap(err, "builder.Withdraw")
+	}
+
+	return []ExecuteMsg{*msg}, nil
+}
+
+// executeWithAsset executes msg of the contract with the asset attached,
+// a cw20 asset is sent to the contract with the msg as a hook and a native asset is attached as funds
+func (b *builderImpl) executeWithAsset(sender, contract, asset string, amount math.Int, msg []byte) (*ExecuteMsg, error) {
+	if !b.IsCw20(asset) {
+		return &ExecuteMsg{Sender: sender, Contract: contract, Msg: msg, Funds: []Coin{{asset, amount}}}, nil
+	}
+
+	send, err := json.Marshal(map[string]interface{}{
+		"send": map[string]interface{}{
+			"contract": contract,
+			"amount":   amount.String(),
+			"msg":      msg, // []byte is encoded in base64 as cw20 expects
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ExecuteMsg{Sender: sender, Contract: asset, Msg: send, Funds: []Coin{}}, nil
+}
+
+func validateSlippage(slippage math.LegacyDec) error {
+	if slippage.IsNil() || slippage.IsNegative() || slippage.GTE(math.LegacyOneDec()) {
+		return ErrInvalidSlippage
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package tx
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	ss "github.com/dezswap/dezswap-api/api/v1/service/simulate"
+	"github.com/dezswap/dezswap-api/pkg"
+	"github.com/dezswap/dezswap-api/pkg/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	cw20Token = "xpla1cw20"
+	lpToken   = "xpla1lp"
+	pair      = "xpla1pair"
+	router    = "xpla1router"
+	sender    = "xpla1sender"
+)
+
+type poolRepoMock struct {
+	ss.PoolRepo
+	mock.Mock
+}
+
+func (m *poolRepoMock) PoolOf(_ context.Context, pair string) (*ss.Pool, error) {
+	args := m.Called(pair)
+	pool, _ := args.Get(0).(*ss.Pool)
+	return pool, args.Error(1)
+}
+
+type simulatorMock struct {
+	ss.Simulator
+	mock.Mock
+}
+
+func (m *simulatorMock) SwapRoute(_ context.Context, route []string, amount math.Int) (*ss.RouteSimulation, error) {
+	args := m.Called(route, amount)
+	simulation, _ := args.Get(0).(*ss.RouteSimulation)
+	return simulation, args.Error(1)
+}
+
+func networkMetadata(routerAddress string) pkg.NetworkMetadata {
+	return pkg.NewNetworkMetadata(
+		pkg.NetworkNameXplaChain,
+		"dimension",
+		"cube",
+		"xpla1",
+		map[types.TokenType]string{types.TokenTypeCW20: "xcw20:", types.TokenTypeERC20: "xerc20:"},
+		5,
+		0,
+		"",
+		"",
+		"",
+		routerAddress,
+	)
+}
+
+func newPool() *ss.Pool {
+	return &ss.Pool{
+		Address: pair, Asset0: "axpla", Asset0Amount: math.NewInt(1_000_000), Asset1: cw20Token, Asset1Amount: math.NewInt(2_000_000),
+		Lp: lpToken, LpAmount: math.NewInt(1_414_213),
+	}
+}
+
+func TestBuilder_Swap(t *testing.T) {
+	simulator := &simulatorMock{}
+	b := NewBuilder("cube_47-5", networkMetadata(router), &poolRepoMock{}, simulator)
+	amount := math.NewInt(10_000)
+
+	nativeRoute := []string{"axpla", cw20Token}
+	simulator.On("SwapRoute", nativeRoute, amount).Return(&ss.RouteSimulation{Route: nativeRoute, ReturnAmount: math.NewInt(19742)}, nil).Once()
+
+	tx, err := b.Swap(context.Background(), sender, nativeRoute, amount, math.LegacyMustNewDecFromStr("0.01"))
+
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(19544), tx.MinimumReceive)
+	require.Len(t, tx.Msgs, 1)
+	require.Equal(t, router, tx.Msgs[0].Contract)
+	require.Equal(t, []Coin{{"axpla", amount}}, tx.Msgs[0].Funds)
+	require.JSONEq(t, `{"execute_swap_operations":{"operations":[{"dez_swap":{"offer_asset_info":{"native_token":{"denom":"axpla"}},"ask_asset_info":{"token":{"contract_addr":"xpla1cw20"}}}}],"minimum_receive":"19544"}}`, string(tx.Msgs[0].Msg))
+
+	cw20Route := []string{cw20Token, "axpla"}
+	simulator.On("SwapRoute", cw20Route, amount).Return(&ss.RouteSimulation{Route: cw20Route, ReturnAmount: math.NewInt(4961)}, nil).Once()
+
+	tx, err = b.Swap(context.Background(), sender, cw20Route, amount, math.LegacyZeroDec())
+
+	require.NoError(t, err)
+	require.Equal(t, cw20Token, tx.Msgs[0].Contract)
+	require.Empty(t, tx.Msgs[0].Funds)
+	send := struct {
+		Send struct {
+			Contract string `json:"contract"`
+			Amount   string `json:"amount"`
+			Msg      string `json:"msg"`
+		} `json:"send"`
+	}{}
+	require.NoError(t, json.Unmarshal(tx.Msgs[0].Msg, &send))
+	require.Equal(t, router, send.Send.Contract)
+	require.Equal(t, "10000", send.Send.Amount)
+	hook, err := base64.StdEncoding.DecodeString(send.Send.Msg)
+	require.NoError(t, err)
+	require.Contains(t, string(hook), `"minimum_receive":"4961"`)
+	simulator.AssertExpectations(t)
+}
+
+func TestBuilder_Swap_ConfiguredRouter(t *testing.T) {
+	registered, err := pkg.GetNetworkMetadata("dimension_37-1")
+	require.NoError(t, err)
+	configured, err := registered.WithRouterAddress("dimension_37-1", router)
+	require.NoError(t, err)
+	simulator := &simulatorMock{}
+	amount := math.NewInt(10_000)
+	route := []string{"axpla", cw20Token}
+	simulator.On("SwapRoute", route, amount).Return(&ss.RouteSimulation{Route: route, ReturnAmount: math.NewInt(19742)}, nil).Once()
+
+	tx, err := NewBuilder("dimension_37-1", configured, &poolRepoMock{}, simulator).Swap(context.Background(), sender, route, amount, math.LegacyMustNewDecFromStr("0.01"))
+
+	require.NoError(t, err)
+	require.Equal(t, router, tx.Msgs[0].Contract)
+	require.Equal(t, []Coin{{"axpla", amount}}, tx.Msgs[0].Funds)
+	require.JSONEq(t, `{"execute_swap_operations":{"operations":[{"dez_swap":{"offer_asset_info":{"native_token":{"denom":"axpla"}},"ask_asset_info":{"token":{"contract_addr":"xpla1cw20"}}}}],"minimum_receive":"19544"}}`, string(tx.Msgs[0].Msg))
+	simulator.AssertExpectations(t)
+}
+
+func TestBuilder_Swap_Errors(t *testing.T) {
+	b := NewBuilder("cube_47-5", networkMetadata(""), &poolRepoMock{}, &simulatorMock{})
+
+	_, err := b.Swap(context.Background(), sender, []string{"axpla", cw20Token}, math.NewInt(1), math.LegacyZeroDec())
+	require.ErrorIs(t, err, pkg.ErrUnregisteredRouterAddress)
+
+	_, err = b.Swap(context.Background(), sender, []string{"axpla", cw20Token}, math.NewInt(1), math.LegacyOneDec())
+	require.ErrorIs(t, err, ErrInvalidSlippage)
+}
+
+func TestBuilder_Provide(t *testing.T) {
+	repo := &poolRepoMock{}
+	repo.On("PoolOf", pair).Return(newPool(), nil).Once()
+	b := NewBuilder("cube_47-5", networkMetadata(router), repo, &simulatorMock{})
+
+	msgs, err := b.Provide(context.Background(), sender, pair, math.NewInt(10_000), math.NewInt(20_000), math.LegacyMustNewDecFromStr("0.005"))
+
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	require.Equal(t, cw20Token, msgs[0].Contract)
+	require.JSONEq(t, `{"increase_allowance":{"spender":"xpla1pair","amount":"20000"}}`, string(msgs[0].Msg))
+	require.Equal(t, pair, msgs[1].Contract)
+	require.Equal(t, []Coin{{"axpla", math.NewInt(10_000)}}, msgs[1].Funds)
+	require.JSONEq(t, `{"provide_liquidity":{"assets":[{"info":{"native_token":{"denom":"axpla"}},"amount":"10000"},{"info":{"token":{"contract_addr":"xpla1cw20"}},"amount":"20000"}],"slippage_tolerance":"0.005000000000000000"}}`, string(msgs[1].Msg))
+	repo.AssertExpectations(t)
+}
+
+func TestBuilder_Withdraw(t *testing.T) {
+	repo := &poolRepoMock{}
+	repo.On("PoolOf", pair).Return(newPool(), nil).Twice()
+	b := NewBuilder("cube_47-5", networkMetadata(router), repo, &simulatorMock{})
+
+	msgs, err := b.Withdraw(context.Background(), sender, pair, math.NewInt(1_000))
+
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	require.Equal(t, lpToken, msgs[0].Contract)
+	require.JSONEq(t, `{"send":{"contract":"xpla1pair","amount":"1000","msg":"eyJ3aXRoZHJhd19saXF1aWRpdHkiOnt9fQ=="}}`, string(msgs[0].Msg))
+
+	_, err = b.Withdraw(context.Background(), sender, pair, math.NewInt(1_414_214))
+	require.ErrorIs(t, err, ss.ErrInvalidShare)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,43 @@
+package udf
+
+type Resolution string
+
+const (
+	Resolution1m  Resolution = "1"
+	Resolution5m  Resolution = "5"
+	Resolution15m Resolution = "15"
+	Resolution30m Resolution = "30"
+	Resolution1h  Resolution = "60"
+	Resolution4h  Resolution = "240"
+	Resolution1D  Resolution = "1D"
+	Resolution1W  Resolution = "1W"
+)
+
+// Symbol is a pair resolved by the ticker id in the form of asset0_asset1, the price is of asset0 in asset1
+type Symbol struct {
+	Ticker        string
+	Pair          string
+	Base          string
+	BaseSymbol    string
+	BaseDecimals  int
+	Quote         string
+	QuoteSymbol   string
+	QuoteDecimals int
+}
+
+type Bar struct {
+	// Time is the unix timestamp in seconds of the beginning of the bar
+	Time  int64
+	Open  float64
+	High  float64
+	Low   float64
+	Close float64
+	// Volume is of the base asset
+	Volume float64
+}
+
+type History struct {
+	Bars []Bar
+	// NextTime is the time of the latest swap before the requested range when no bar is in the range, 0 when none
+	NextTime int64
+}
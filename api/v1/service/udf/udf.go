@@ -0,0 +1,208 @@
+package udf
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dezswap/dezswap-api/api/tracing"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidTicker         = errors.New("invalid ticker")
+	ErrSymbolNotFound        = errors.New("symbol not found")
+	ErrUnsupportedResolution = errors.New("unsupported resolution")
+)
+
+// Resolutions are the supported resolutions in the ascending order
+var Resolutions = []Resolution{Resolution1m, Resolution5m, Resolution15m, Resolution30m, Resolution1h, Resolution4h, Resolution1D, Resolution1W}
+
+var resolutionSeconds = map[Resolution]int64{
+	Resolution1m:  60,
+	Resolution5m:  5 * 60,
+	Resolution15m: 15 * 60,
+	Resolution30m: 30 * 60,
+	Resolution1h:  60 * 60,
+	Resolution4h:  4 * 60 * 60,
+	Resolution1D:  24 * 60 * 60,
+	Resolution1W:  7 * 24 * 60 * 60,
+}
+
+// the unix epoch is a Thursday, the weekly bars begin on Monday
+const weekOffset = 4 * 24 * 60 * 60
+
+// Service serves the datafeed of the TradingView UDF, the bars are built from the swaps
+type Service interface {
+	Symbol(ctx context.Context, ticker string) (*Symbol, error)
+	// Search returns the symbols of which the pair or the token symbols contain the query, or the addresses are the query
+	Search(ctx context.Context, query string, limit int) ([]Symbol, error)
+	// History returns the bars in [from, to) in seconds, the latest countback bars before to regardless of from when countback is positive
+	History(ctx context.Context, ticker string, resolution Resolution, from, to int64, countback int) (*History, error)
+}
+
+type udfService struct {
+	chainId string
+	*gorm.DB
+}
+
+func NewService(chainId string, db *gorm.DB) Service {
+	return &udfService{chainId, db}
+}
+
+// ParseResolution returns the resolution, accepting the aliases without the multiplier such as D and W
+func ParseResolution(value string) (Resolution, error) {
+	switch value {
+	case "D":
+		return Resolution1D, nil
+	case "W":
+		return Resolution1W, nil
+	}
+	resolution := Resolution(value)
+	if _, ok := resolutionSeconds[resolution]; !ok {
+		return "", ErrUnsupportedResolution
+	}
+	return resolution, nil
+}
+
+// Symbol implements Service
+func (s *udfService) Symbol(ctx context.Context, ticker string) (*Symbol, error) {
+	ctx, span := tracing.Start(ctx, "udfService.Symbol")
+	defer span.End()
+	base, quote, err := parseTicker(ticker)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := []Symbol{}
+	if err := s.symbols(ctx).
+		Where("p.asset0 = ? AND p.asset1 = ?", base, quote).
+		Order("p.id DESC").
+		Limit(1).
+		Scan(&symbols).Error; err != nil {
+		return nil, errors.Wrap(err, "udfService.Symbol")
+	}
+	if len(symbols) == 0 {
+		return nil, ErrSymbolNotFound
+	}
+	return &symbols[0], nil
+}
+
+// Search implements Service
+func (s *udfService) Search(ctx context.Context, query string, limit int) ([]Symbol, error) {
+	ctx, span := tracing.Start(ctx, "udfService.Search")
+	defer span.End()
+	pattern := "%" + strings.ReplaceAll(strings.ReplaceAll(query, `\`, `\\`), "%", `\%`) + "%"
+	symbols := []Symbol{}
+	if err := s.symbols(ctx).
+		Where("(t0.symbol || '/' || t1.symbol ILIKE ? OR p.asset0 = ? OR p.asset1 = ? OR p.contract = ?)", pattern, query, query, query).
+		Order("p.id").
+		Limit(limit).
+		Scan(&symbols).Error; err != nil {
+		return nil, errors.Wrap(err, "udfService.Search")
+	}
+	return symbols, nil
+}
+
+func (s *udfService) symbols(ctx context.Context) *gorm.DB {
+	return s.WithContext(ctx).Table("pair AS p").
+		Joins("JOIN tokens AS t0 ON t0.chain_id = p.chain_id AND t0.address = p.asset0").
+		Joins("JOIN tokens AS t1 ON t1.chain_id = p.chain_id AND t1.address = p.asset1").
+		Where("p.chain_id = ?", s.chainId).
+		Select("p.asset0 || '_' || p.asset1 ticker, p.contract pair, " +
+			"p.asset0 base, t0.symbol base_symbol, t0.decimals base_decimals, " +
+			"p.asset1 quote, t1.symbol quote_symbol, t1.decimals quote_decimals")
+}
+
+// History implements Service
+func (s *udfService) History(ctx context.Context, ticker string, resolution Resolution, from, to int64, countback int) (*History, error) {
+	ctx, span := tracing.Start(ctx, "udfService.History")
+	defer span.End()
+	symbol, err := s.Symbol(ctx, ticker)
+	if err != nil {
+		return nil, err
+	}
+	seconds, ok := resolutionSeconds[resolution]
+	if !ok {
+		return nil, ErrUnsupportedResolution
+	}
+	offset := int64(0)
+	if resolution == Resolution1W {
+		offset = weekOffset
+	}
+
+	// the amounts are signed from the pool, the price and the volume do not depend on the direction
+	query := `
+select cast(floor((pt.timestamp - @offset) / @seconds) * @seconds + @offset as bigint) as time, pt.timestamp, pt.id,
+       (abs(pt.asset1_amount) / power(10, @quoteDecimals)) / (abs(pt.asset0_amount) / power(10, @baseDecimals)) as price,
+       abs(pt.asset0_amount) / power(10, @baseDecimals) as volume
+from parsed_tx pt
+where pt.chain_id = @chainId
+  and pt.contract = @pair
+  and pt.type = 'swap'
+  and pt.asset0_amount <> 0
+  and pt.timestamp < @to`
+	if countback <= 0 {
+		query += `
+  and pt.timestamp >= @from`
+	}
+	query = `
+with swaps as (` + query + `
+)
+select time,
+       ((array_agg(price order by timestamp, id))[1])::float8 as open,
+       max(price)::float8 as high,
+       min(price)::float8 as low,
+       ((array_agg(price order by timestamp desc, id desc))[1])::float8 as close,
+       sum(volume)::float8 as volume
+from swaps
+group by time`
+	args := map[string]interface{}{
+		"offset":        offset,
+		"seconds":       seconds,
+		"quoteDecimals": symbol.QuoteDecimals,
+		"baseDecimals":  symbol.BaseDecimals,
+		"chainId":       s.chainId,
+		"pair":          symbol.Pair,
+		"from":          from,
+		"to":            to,
+	}
+	if countback > 0 {
+		query = `
+select * from (` + query + `
+order by time desc
+limit @countback) bars`
+		args["countback"] = countback
+	}
+	query += `
+order by time`
+
+	history := History{Bars: []Bar{}}
+	if err := s.WithContext(ctx).Raw(query, args).Scan(&history.Bars).Error; err != nil {
+		return nil, errors.Wrap(err, "udfService.History")
+	}
+	if len(history.Bars) > 0 {
+		return &history, nil
+	}
+
+	var nextTime *float64
+	if err := s.WithContext(ctx).Table("parsed_tx").
+		Where("chain_id = ? AND contract = ? AND type = 'swap' AND timestamp < ?", s.chainId, symbol.Pair, from).
+		Select("max(timestamp)").
+		Scan(&nextTime).Error; err != nil {
+		return nil, errors.Wrap(err, "udfService.History")
+	}
+	if nextTime != nil {
+		history.NextTime = int64(*nextTime)
+	}
+	return &history, nil
+}
+
+// parseTicker returns the base and the quote of the ticker in the form of base_quote
+func parseTicker(ticker string) (string, string, error) {
+	tokens := strings.Split(ticker, "_")
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" {
+		return "", "", ErrInvalidTicker
+	}
+	return tokens[0], tokens[1], nil
+}
@@ -0,0 +1,96 @@
+package udf
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var symbolColumns = []string{"ticker", "pair", "base", "base_symbol", "base_decimals", "quote", "quote_symbol", "quote_decimals"}
+
+func newTestService(t *testing.T) (Service, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	return NewService("test-chain", gormDB), mock
+}
+
+func TestParseResolution(t *testing.T) {
+	tcs := []struct {
+		value    string
+		expected Resolution
+		err      error
+	}{
+		{"1", Resolution1m, nil},
+		{"240", Resolution4h, nil},
+		{"D", Resolution1D, nil},
+		{"1W", Resolution1W, nil},
+		{"2", "", ErrUnsupportedResolution},
+	}
+	for _, tc := range tcs {
+		actual, err := ParseResolution(tc.value)
+		assert.ErrorIs(t, err, tc.err, tc.value)
+		assert.Equal(t, tc.expected, actual, tc.value)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	s, mock := newTestService(t)
+
+	mock.ExpectQuery(`FROM pair AS p JOIN tokens AS t0`).
+		WithArgs("test-chain", "tokenA", "tokenB").
+		WillReturnRows(sqlmock.NewRows(symbolColumns).AddRow("tokenA_tokenB", "pair", "tokenA", "A", 6, "tokenB", "B", 18))
+	mock.ExpectQuery(`with swaps as`).
+		WillReturnRows(sqlmock.NewRows([]string{"time", "open", "high", "low", "close", "volume"}).
+			AddRow(1_699_999_200, 2.0, 2.5, 1.5, 2.5, 3.0).
+			AddRow(1_700_002_800, 2.4, 2.4, 2.4, 2.4, 1.0))
+
+	history, err := s.History(context.Background(), "tokenA_tokenB", Resolution1h, 1_699_999_200, 1_700_006_400, 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, []Bar{
+		{Time: 1_699_999_200, Open: 2.0, High: 2.5, Low: 1.5, Close: 2.5, Volume: 3.0},
+		{Time: 1_700_002_800, Open: 2.4, High: 2.4, Low: 2.4, Close: 2.4, Volume: 1.0},
+	}, history.Bars)
+	assert.Zero(t, history.NextTime)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHistory_NoData(t *testing.T) {
+	s, mock := newTestService(t)
+
+	mock.ExpectQuery(`FROM pair AS p JOIN tokens AS t0`).
+		WillReturnRows(sqlmock.NewRows(symbolColumns).AddRow("tokenA_tokenB", "pair", "tokenA", "A", 6, "tokenB", "B", 18))
+	mock.ExpectQuery(`with swaps as`).
+		WillReturnRows(sqlmock.NewRows([]string{"time", "open", "high", "low", "close", "volume"}))
+	mock.ExpectQuery(`SELECT max\(timestamp\) FROM "parsed_tx"`).
+		WithArgs("test-chain", "pair", int64(1_700_000_000)).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(1_600_000_000.5))
+
+	history, err := s.History(context.Background(), "tokenA_tokenB", Resolution1D, 1_700_000_000, 1_700_086_400, 0)
+
+	require.NoError(t, err)
+	assert.Empty(t, history.Bars)
+	assert.Equal(t, int64(1_600_000_000), history.NextTime)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSymbol_NotFound(t *testing.T) {
+	s, mock := newTestService(t)
+
+	mock.ExpectQuery(`FROM pair AS p JOIN tokens AS t0`).
+		WillReturnRows(sqlmock.NewRows(symbolColumns))
+
+	_, err := s.Symbol(context.Background(), "tokenA_tokenB")
+	assert.ErrorIs(t, err, ErrSymbolNotFound)
+
+	_, err = s.Symbol(context.Background(), "tokenA")
+	assert.ErrorIs(t, err, ErrInvalidTicker)
+}
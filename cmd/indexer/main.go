@@ -1,6 +1,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"reflect"
@@ -18,6 +19,8 @@ import (
 	"github.com/go-co-op/gocron"
 )
 
+const tokenSupplyDelay = 10 * time.Minute
+
 type repeatableJob struct {
 	each         func() error
 	errorHandler func(err error)
@@ -35,7 +38,9 @@ func runJob(j *repeatableJob, logger logging.Logger) {
 	elapsed := time.Since(start)
 	logger.Debugf(fmt.Sprintf("Binomial took %ds, delay: %ds", elapsed/time.Second, j.delay/time.Second))
 
-	if err != nil {
+	// a job skipping some items saved the rest, it never reaches the tolerance by the items failing every time
+	var skipped *indexer.SkippedError
+	if err != nil && !errors.As(err, &skipped) {
 		j.errCount++
 		logger.Error(err)
 		if j.errorHandler != nil {
@@ -43,6 +48,9 @@ func runJob(j *repeatableJob, logger logging.Logger) {
 		}
 
 	} else {
+		if err != nil {
+			logger.Warn(err)
+		}
 		j.errCount = 0
 	}
 
@@ -66,6 +74,10 @@ func main() {
 	jobs := []*repeatableJob{
 		{each: app.UpdateTokens, errorHandler: nil, delay: time.Duration(networkMetadata.BlockSecond) * time.Second, errCount: 0, tolerance: 3},
 		{each: app.UpdateLatestPools, errorHandler: nil, delay: time.Duration(networkMetadata.BlockSecond) * time.Second, errCount: 0, tolerance: 3},
+		{each: app.UpdateAccountActivities, errorHandler: nil, delay: time.Duration(networkMetadata.BlockSecond) * time.Second, errCount: 0, tolerance: 3},
+		{each: app.UpdateLpPositions, errorHandler: nil, delay: time.Duration(networkMetadata.BlockSecond) * time.Second, errCount: 0, tolerance: 3},
+		// supplies rarely change, querying every token each block is a waste of node resources
+		{each: app.UpdateTokenSupplies, errorHandler: nil, delay: tokenSupplyDelay, errCount: 0, tolerance: 3},
 	}
 	// indexer.UpdateVerifiedTokens can run only when assetRepo exists
 	if hasAssetRepo {
@@ -81,6 +93,11 @@ func main() {
 		)
 	}
 
+	// the jobs never look up the tokens already indexed on the node again
+	if err := app.BackfillIbcOrigins(); err != nil {
+		logger.Error(err)
+	}
+
 	logger.Info("Starting indexer...")
 
 	s := gocron.NewScheduler(time.UTC)
@@ -125,7 +142,7 @@ func initApp(config configs.IndexerConfig, networkMetadata pkg.NetworkMetadata)
 
 	indexerRepo := repo.NewRepo(nodeRepo, dbRepo, assetRepo)
 
-	return indexer.NewDexIndexer(networkMetadata, indexerRepo, config.ChainId), assetRepo != nil
+	return indexer.NewDexIndexer(networkMetadata, indexerRepo, config.ChainId, configs.SupplyExclusionsByToken(config.CirculatingSupplyExclusions)), assetRepo != nil
 
 }
 
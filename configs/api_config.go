@@ -29,11 +29,83 @@ func apiConfig(v *viper.Viper) ApiConfig {
 		mcpC.Enabled = envMCPC.Enabled
 	}
 
+	routerC := routerConfig(v.Sub("api.router"))
+	envRouterC := routerConfigFromEnv(v, "API_ROUTER")
+	routerC.Override(envRouterC)
+
+	depthC := depthConfig(v.Sub("api.depth"))
+	envDepthC := depthConfigFromEnv(v, "API_DEPTH")
+	depthC.Override(envDepthC)
+
+	streamC := streamConfig(v.Sub("api.stream"))
+	envStreamC := streamConfigFromEnv(v, "API_STREAM")
+	streamC.Override(envStreamC)
+
+	graphqlC := graphqlConfig(v.Sub("api.graphql"))
+	envGraphqlC := graphqlConfigFromEnv(v, "API_GRAPHQL")
+	graphqlC.Override(envGraphqlC)
+
+	grpcServerC := grpcServerConfig(v.Sub("api.grpc_server"))
+	envGrpcServerC := grpcServerConfigFromEnv(v, "API_GRPC_SERVER")
+	grpcServerC.Override(envGrpcServerC)
+
+	rateLimitC, err := rateLimitConfig(v.Sub("api.rate_limit"))
+	if err != nil {
+		panic(err)
+	}
+	envRateLimitC, err := rateLimitConfigFromEnv(v, "API_RATE_LIMIT")
+	if err != nil {
+		panic(err)
+	}
+	rateLimitC.Override(envRateLimitC)
+	if v.IsSet(strings.ToUpper(fmt.Sprintf("%s_%s", "API_RATE_LIMIT", "enabled"))) {
+		rateLimitC.Enabled = envRateLimitC.Enabled
+	}
+
+	metricsC := metricsConfig(v.Sub("api.metrics"))
+	envMetricsC := metricsConfigFromEnv(v, "API_METRICS")
+	metricsC.Override(envMetricsC)
+	if v.IsSet(strings.ToUpper(fmt.Sprintf("%s_%s", "API_METRICS", "enabled"))) {
+		metricsC.Enabled = envMetricsC.Enabled
+	}
+
+	tracingC := tracingConfig(v.Sub("api.tracing"))
+	envTracingC := tracingConfigFromEnv(v, "API_TRACING")
+	tracingC.Override(envTracingC)
+	if v.IsSet(strings.ToUpper(fmt.Sprintf("%s_%s", "API_TRACING", "enabled"))) {
+		tracingC.Enabled = envTracingC.Enabled
+	}
+
+	statusC := statusConfig(v.Sub("api.status"))
+	envStatusC := statusConfigFromEnv(v, "API_STATUS")
+	statusC.Override(envStatusC)
+
+	nodeCs, err := grpcConfigsFromEnv(v, "API_NODES")
+	if err != nil {
+		panic(err)
+	}
+	if len(nodeCs) == 0 {
+		nodeCs, err = grpcConfigs(v, "api.nodes")
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	return ApiConfig{
-		Server: apiServerC,
-		MCP:    mcpC,
-		DB:     dbC,
-		Cache:  cacheC,
+		Server:     apiServerC,
+		MCP:        mcpC,
+		DB:         dbC,
+		Cache:      cacheC,
+		Nodes:      nodeCs,
+		Router:     routerC,
+		Depth:      depthC,
+		Stream:     streamC,
+		Graphql:    graphqlC,
+		GrpcServer: grpcServerC,
+		RateLimit:  rateLimitC,
+		Metrics:    metricsC,
+		Tracing:    tracingC,
+		Status:     statusC,
 	}
 }
 
@@ -50,6 +122,7 @@ func apiServerConfig(v *viper.Viper) ApiServerConfig {
 		Mode:               v.GetString("mode"),
 		ChainId:            v.GetString("chain_id"),
 		CorsAllowedOrigins: v.GetStringSlice("cors_allowed_origins"),
+		TrustedProxies:     v.GetStringSlice("trusted_proxies"),
 		CoinGeckoApiKey:    v.GetString("coingecko_api_key"),
 	}
 }
@@ -66,6 +139,7 @@ func apiServerConfigFromEnv(v *viper.Viper, prefix string) ApiServerConfig {
 		Mode:               v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "mode"))),
 		ChainId:            v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "chain_id"))),
 		CorsAllowedOrigins: splitAndTrim(v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "cors_allowed_origins")))),
+		TrustedProxies:     splitAndTrim(v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "trusted_proxies")))),
 		CoinGeckoApiKey:    v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "coingecko_api_key"))),
 	}
 }
@@ -75,6 +149,17 @@ type ApiConfig struct {
 	MCP    ApiMCPConfig
 	DB     RdbConfig
 	Cache  CacheConfig
+	// Nodes are the grpc endpoints of the chain in the failover order, on-chain queries are disabled when empty
+	Nodes      []GrpcConfig
+	Router     RouterConfig
+	Depth      DepthConfig
+	Stream     StreamConfig
+	Graphql    GraphqlConfig
+	GrpcServer GrpcServerConfig
+	RateLimit  RateLimitConfig
+	Metrics    MetricsConfig
+	Tracing    TracingConfig
+	Status     StatusConfig
 }
 
 // ApiServerConfig is config struct for app
@@ -86,7 +171,9 @@ type ApiServerConfig struct {
 	Mode               string
 	ChainId            string
 	CorsAllowedOrigins []string
-	CoinGeckoApiKey    string
+	// TrustedProxies are the IPs and CIDRs whose X-Forwarded-For is the client IP, none are trusted when empty
+	TrustedProxies  []string
+	CoinGeckoApiKey string
 }
 
 type ApiMCPConfig struct {
@@ -121,6 +208,9 @@ func (lhs *ApiServerConfig) Override(rhs ApiServerConfig) {
 	if len(rhs.CorsAllowedOrigins) > 0 {
 		lhs.CorsAllowedOrigins = rhs.CorsAllowedOrigins
 	}
+	if len(rhs.TrustedProxies) > 0 {
+		lhs.TrustedProxies = rhs.TrustedProxies
+	}
 	if rhs.CoinGeckoApiKey != "" {
 		lhs.CoinGeckoApiKey = rhs.CoinGeckoApiKey
 	}
@@ -48,6 +48,34 @@ api:
 	}
 }
 
+func TestApiConfig_TrustedProxies(t *testing.T) {
+	cfg := apiConfig(newTestViper(t, `
+api:
+  server:
+    port: 8000
+`))
+	if cfg.Server.TrustedProxies != nil {
+		t.Fatalf("expected no trusted proxies by default, got %v", cfg.Server.TrustedProxies)
+	}
+
+	const envKey = "APP_API_SERVER_TRUSTED_PROXIES"
+	if err := os.Setenv(envKey, "10.0.0.0/8, 192.168.0.1"); err != nil {
+		t.Fatalf("failed to set env: %v", err)
+	}
+	defer os.Unsetenv(envKey)
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  server:
+    trusted_proxies:
+      - 172.16.0.0/12
+`))
+	expected := []string{"10.0.0.0/8", "192.168.0.1"}
+	if !reflect.DeepEqual(cfg.Server.TrustedProxies, expected) {
+		t.Fatalf("expected trusted proxies %v, got %v", expected, cfg.Server.TrustedProxies)
+	}
+}
+
 func TestApiConfig_MCPFromConfig(t *testing.T) {
 	v := newTestViper(t, `
 api:
@@ -161,6 +189,365 @@ api:
 	}
 }
 
+func TestApiConfig_NodesFromConfig(t *testing.T) {
+	v := newTestViper(t, `
+api:
+  nodes:
+    - host: node-1.example.com
+      port: "443"
+      use_tls: true
+    - host: node-2.example.com
+      port: "9090"
+`)
+
+	cfg := apiConfig(v)
+
+	expected := []GrpcConfig{
+		{Host: "node-1.example.com", Port: "443", UseTls: true},
+		{Host: "node-2.example.com", Port: "9090"},
+	}
+	if !reflect.DeepEqual(cfg.Nodes, expected) {
+		t.Fatalf("expected nodes %v, got %v", expected, cfg.Nodes)
+	}
+}
+
+func TestApiConfig_NodesOverrideByEnv(t *testing.T) {
+	const envKey = "APP_API_NODES"
+	if err := os.Setenv(envKey, `[{"host":"env.example.com","port":"443","use_tls":true}]`); err != nil {
+		t.Fatalf("failed to set env: %v", err)
+	}
+	defer os.Unsetenv(envKey)
+
+	v := newTestViper(t, `
+api:
+  nodes:
+    - host: node-1.example.com
+      port: "443"
+`)
+
+	cfg := apiConfig(v)
+
+	expected := []GrpcConfig{{Host: "env.example.com", Port: "443", UseTls: true}}
+	if !reflect.DeepEqual(cfg.Nodes, expected) {
+		t.Fatalf("expected nodes %v, got %v", expected, cfg.Nodes)
+	}
+}
+
+func TestApiConfig_Router(t *testing.T) {
+	cfg := apiConfig(newTestViper(t, ``))
+
+	expected := RouterConfig{Source: RouterSourceAggregator, MaxHopCount: defaultRouterMaxHopCount}
+	if !reflect.DeepEqual(cfg.Router, expected) {
+		t.Fatalf("expected default router %v, got %v", expected, cfg.Router)
+	}
+
+	const envKey = "APP_API_ROUTER_SOURCE"
+	if err := os.Setenv(envKey, RouterSourceGraph); err != nil {
+		t.Fatalf("failed to set env: %v", err)
+	}
+	defer os.Unsetenv(envKey)
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  router:
+    max_hop_count: 2
+    min_pool_liquidity: "1000"
+    contract: xpla1router
+`))
+
+	expected = RouterConfig{Source: RouterSourceGraph, MaxHopCount: 2, MinPoolLiquidity: "1000", Contract: "xpla1router"}
+	if !reflect.DeepEqual(cfg.Router, expected) {
+		t.Fatalf("expected router %v, got %v", expected, cfg.Router)
+	}
+}
+
+func TestApiConfig_Depth(t *testing.T) {
+	cfg := apiConfig(newTestViper(t, ``))
+
+	expected := DepthConfig{Percentages: defaultDepthPercentages, OrderbookPercentages: defaultOrderbookDepthPercentages}
+	if !reflect.DeepEqual(cfg.Depth, expected) {
+		t.Fatalf("expected default depth %v, got %v", expected, cfg.Depth)
+	}
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  depth:
+    percentages: ["1", "2"]
+    orderbook_percentages: ["1", "3"]
+`))
+
+	expected = DepthConfig{Percentages: []string{"1", "2"}, OrderbookPercentages: []string{"1", "3"}}
+	if !reflect.DeepEqual(cfg.Depth, expected) {
+		t.Fatalf("expected depth %v, got %v", expected, cfg.Depth)
+	}
+
+	const envKey = "APP_API_DEPTH_PERCENTAGES"
+	if err := os.Setenv(envKey, "2, 10"); err != nil {
+		t.Fatalf("failed to set env: %v", err)
+	}
+	defer os.Unsetenv(envKey)
+
+	cfg = apiConfig(newTestViper(t, ``))
+
+	expected = DepthConfig{Percentages: []string{"2", "10"}, OrderbookPercentages: defaultOrderbookDepthPercentages}
+	if !reflect.DeepEqual(cfg.Depth, expected) {
+		t.Fatalf("expected depth %v, got %v", expected, cfg.Depth)
+	}
+}
+
+func TestApiConfig_Stream(t *testing.T) {
+	cfg := apiConfig(newTestViper(t, ``))
+
+	expected := StreamConfig{BufferSize: defaultStreamBufferSize, MaxReplayBlocks: defaultStreamMaxReplayBlocks, MaxSubscribers: defaultStreamMaxSubscribers}
+	if !reflect.DeepEqual(cfg.Stream, expected) {
+		t.Fatalf("expected default stream %v, got %v", expected, cfg.Stream)
+	}
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  stream:
+    buffer_size: 16
+    max_replay_blocks: 100
+`))
+
+	expected = StreamConfig{BufferSize: 16, MaxReplayBlocks: 100, MaxSubscribers: defaultStreamMaxSubscribers}
+	if !reflect.DeepEqual(cfg.Stream, expected) {
+		t.Fatalf("expected stream %v, got %v", expected, cfg.Stream)
+	}
+
+	const envKey = "APP_API_STREAM_MAX_SUBSCRIBERS"
+	if err := os.Setenv(envKey, "10"); err != nil {
+		t.Fatalf("failed to set env: %v", err)
+	}
+	defer os.Unsetenv(envKey)
+
+	cfg = apiConfig(newTestViper(t, ``))
+
+	expected = StreamConfig{BufferSize: defaultStreamBufferSize, MaxReplayBlocks: defaultStreamMaxReplayBlocks, MaxSubscribers: 10}
+	if !reflect.DeepEqual(cfg.Stream, expected) {
+		t.Fatalf("expected stream %v, got %v", expected, cfg.Stream)
+	}
+}
+
+func TestApiConfig_Graphql(t *testing.T) {
+	cfg := apiConfig(newTestViper(t, ``))
+
+	expected := GraphqlConfig{MaxDepth: defaultGraphqlMaxDepth, MaxComplexity: defaultGraphqlMaxComplexity}
+	if !reflect.DeepEqual(cfg.Graphql, expected) {
+		t.Fatalf("expected default graphql %v, got %v", expected, cfg.Graphql)
+	}
+
+	const envKey = "APP_API_GRAPHQL_MAX_DEPTH"
+	if err := os.Setenv(envKey, "4"); err != nil {
+		t.Fatalf("failed to set env: %v", err)
+	}
+	defer os.Unsetenv(envKey)
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  graphql:
+    max_complexity: 100
+`))
+
+	expected = GraphqlConfig{MaxDepth: 4, MaxComplexity: 100}
+	if !reflect.DeepEqual(cfg.Graphql, expected) {
+		t.Fatalf("expected graphql %v, got %v", expected, cfg.Graphql)
+	}
+}
+
+func TestApiConfig_GrpcServer(t *testing.T) {
+	cfg := apiConfig(newTestViper(t, ``))
+	if cfg.GrpcServer.Port != "" {
+		t.Fatalf("expected the grpc server disabled by default, got port %s", cfg.GrpcServer.Port)
+	}
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  grpc_server:
+    port: "9090"
+`))
+	if cfg.GrpcServer.Port != "9090" {
+		t.Fatalf("expected grpc server port 9090, got %s", cfg.GrpcServer.Port)
+	}
+
+	const envKey = "APP_API_GRPC_SERVER_PORT"
+	if err := os.Setenv(envKey, "9091"); err != nil {
+		t.Fatalf("failed to set env: %v", err)
+	}
+	defer os.Unsetenv(envKey)
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  grpc_server:
+    port: "9090"
+`))
+	if cfg.GrpcServer.Port != "9091" {
+		t.Fatalf("expected grpc server port 9091 from env, got %s", cfg.GrpcServer.Port)
+	}
+}
+
+func TestApiConfig_RateLimit(t *testing.T) {
+	cfg := apiConfig(newTestViper(t, ``))
+	if cfg.RateLimit.Enabled {
+		t.Fatalf("expected the rate limit disabled by default")
+	}
+	if cfg.RateLimit.Ip != defaultRateLimitIp || cfg.RateLimit.Key != defaultRateLimitKey || cfg.RateLimit.MCP != defaultRateLimitMCP {
+		t.Fatalf("expected the default tiers, got %+v", cfg.RateLimit)
+	}
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  rate_limit:
+    enabled: true
+    ip:
+      burst: 5
+      daily_quota: 1000
+    keys:
+      - name: partner
+        key: secret
+        rate: 100
+`))
+	if !cfg.RateLimit.Enabled {
+		t.Fatalf("expected the rate limit enabled")
+	}
+	if want := (RateLimitTier{Rate: defaultRateLimitIp.Rate, Burst: 5, DailyQuota: 1000}); cfg.RateLimit.Ip != want {
+		t.Fatalf("expected ip tier %+v, got %+v", want, cfg.RateLimit.Ip)
+	}
+	if want := []ApiKeyConfig{{Name: "partner", Key: "secret", RateLimitTier: RateLimitTier{Rate: 100}}}; !reflect.DeepEqual(cfg.RateLimit.Keys, want) {
+		t.Fatalf("expected keys %+v, got %+v", want, cfg.RateLimit.Keys)
+	}
+
+	envs := map[string]string{
+		"APP_API_RATE_LIMIT_ENABLED":  "false",
+		"APP_API_RATE_LIMIT_KEY_RATE": "7.5",
+		"APP_API_RATE_LIMIT_KEYS":     `[{"name":"bot","key":"k","burst":3}]`,
+	}
+	for k, v := range envs {
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatalf("failed to set env: %v", err)
+		}
+		defer os.Unsetenv(k)
+	}
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  rate_limit:
+    enabled: true
+`))
+	if cfg.RateLimit.Enabled {
+		t.Fatalf("expected the rate limit disabled from env")
+	}
+	if cfg.RateLimit.Key.Rate != 7.5 {
+		t.Fatalf("expected key rate 7.5 from env, got %v", cfg.RateLimit.Key.Rate)
+	}
+	if want := []ApiKeyConfig{{Name: "bot", Key: "k", RateLimitTier: RateLimitTier{Burst: 3}}}; !reflect.DeepEqual(cfg.RateLimit.Keys, want) {
+		t.Fatalf("expected keys %+v from env, got %+v", want, cfg.RateLimit.Keys)
+	}
+}
+
+func TestApiConfig_Metrics(t *testing.T) {
+	cfg := apiConfig(newTestViper(t, ``))
+	if cfg.Metrics.Enabled || cfg.Metrics.Path != defaultMetricsPath {
+		t.Fatalf("expected the metrics disabled on %s by default, got %+v", defaultMetricsPath, cfg.Metrics)
+	}
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  metrics:
+    enabled: true
+    path: /internal/metrics
+`))
+	if !cfg.Metrics.Enabled || cfg.Metrics.Path != "/internal/metrics" {
+		t.Fatalf("expected the metrics enabled on /internal/metrics, got %+v", cfg.Metrics)
+	}
+
+	const envKey = "APP_API_METRICS_ENABLED"
+	if err := os.Setenv(envKey, "false"); err != nil {
+		t.Fatalf("failed to set env: %v", err)
+	}
+	defer os.Unsetenv(envKey)
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  metrics:
+    enabled: true
+`))
+	if cfg.Metrics.Enabled {
+		t.Fatalf("expected the metrics disabled from env")
+	}
+}
+
+func TestApiConfig_Tracing(t *testing.T) {
+	cfg := apiConfig(newTestViper(t, ``))
+	if cfg.Tracing.Enabled || cfg.Tracing.SampleRatio != defaultTracingSampleRatio {
+		t.Fatalf("expected the tracing disabled with the default sample ratio, got %+v", cfg.Tracing)
+	}
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  tracing:
+    enabled: true
+    endpoint: otel-collector:4317
+    insecure: true
+    sample_ratio: 0.5
+`))
+	if want := (TracingConfig{Enabled: true, Endpoint: "otel-collector:4317", Insecure: true, SampleRatio: 0.5}); cfg.Tracing != want {
+		t.Fatalf("expected tracing %+v, got %+v", want, cfg.Tracing)
+	}
+
+	envs := map[string]string{
+		"APP_API_TRACING_ENABLED":      "false",
+		"APP_API_TRACING_SAMPLE_RATIO": "1",
+	}
+	for k, v := range envs {
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatalf("failed to set env: %v", err)
+		}
+		defer os.Unsetenv(k)
+	}
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  tracing:
+    enabled: true
+    sample_ratio: 0.5
+`))
+	if cfg.Tracing.Enabled || cfg.Tracing.SampleRatio != 1 {
+		t.Fatalf("expected the tracing disabled with sample ratio 1 from env, got %+v", cfg.Tracing)
+	}
+}
+
+func TestApiConfig_Status(t *testing.T) {
+	cfg := apiConfig(newTestViper(t, ``))
+	if cfg.Status.MaxStalenessSeconds != 0 {
+		t.Fatalf("expected the staleness unchecked by default, got %+v", cfg.Status)
+	}
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  status:
+    max_staleness_seconds: 600
+`))
+	if cfg.Status.MaxStalenessSeconds != 600 {
+		t.Fatalf("expected max staleness 600, got %+v", cfg.Status)
+	}
+
+	const envKey = "APP_API_STATUS_MAX_STALENESS_SECONDS"
+	if err := os.Setenv(envKey, "60"); err != nil {
+		t.Fatalf("failed to set env: %v", err)
+	}
+	defer os.Unsetenv(envKey)
+
+	cfg = apiConfig(newTestViper(t, `
+api:
+  status:
+    max_staleness_seconds: 600
+`))
+	if cfg.Status.MaxStalenessSeconds != 60 {
+		t.Fatalf("expected max staleness 60 from env, got %+v", cfg.Status)
+	}
+}
+
 func newTestViper(t *testing.T, config string) *viper.Viper {
 	t.Helper()
 
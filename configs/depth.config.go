@@ -0,0 +1,52 @@
+package configs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+var (
+	defaultDepthPercentages          = []string{"2", "5"}
+	defaultOrderbookDepthPercentages = []string{"0.5", "1", "2", "5", "10"}
+)
+
+type DepthConfig struct {
+	// Percentages are the price moves in percent measured on both sides of the pools
+	Percentages []string
+	// OrderbookPercentages are the price moves in percent of the levels of the synthetic orderbooks
+	OrderbookPercentages []string
+}
+
+func (lhs *DepthConfig) Override(rhs DepthConfig) {
+	if len(rhs.Percentages) > 0 {
+		lhs.Percentages = rhs.Percentages
+	}
+	if len(rhs.OrderbookPercentages) > 0 {
+		lhs.OrderbookPercentages = rhs.OrderbookPercentages
+	}
+}
+
+func depthConfig(v *viper.Viper) DepthConfig {
+	c := DepthConfig{Percentages: defaultDepthPercentages, OrderbookPercentages: defaultOrderbookDepthPercentages}
+	if v == nil {
+		return c
+	}
+
+	c.Override(DepthConfig{
+		Percentages:          v.GetStringSlice("percentages"),
+		OrderbookPercentages: v.GetStringSlice("orderbook_percentages"),
+	})
+	return c
+}
+
+func depthConfigFromEnv(v *viper.Viper, prefix string) DepthConfig {
+	if v == nil {
+		return DepthConfig{}
+	}
+	return DepthConfig{
+		Percentages:          splitAndTrim(v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "percentages")))),
+		OrderbookPercentages: splitAndTrim(v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "orderbook_percentages")))),
+	}
+}
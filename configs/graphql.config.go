@@ -0,0 +1,52 @@
+package configs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultGraphqlMaxDepth      = 6
+	defaultGraphqlMaxComplexity = 5_000
+)
+
+type GraphqlConfig struct {
+	// MaxDepth caps the nesting of the selections, a deeper query is rejected before the execution
+	MaxDepth int
+	// MaxComplexity caps the resolved objects and the time series of a query, a field of a query estimated beyond it fails before it resolves anything
+	MaxComplexity int
+}
+
+func (lhs *GraphqlConfig) Override(rhs GraphqlConfig) {
+	if rhs.MaxDepth != 0 {
+		lhs.MaxDepth = rhs.MaxDepth
+	}
+	if rhs.MaxComplexity != 0 {
+		lhs.MaxComplexity = rhs.MaxComplexity
+	}
+}
+
+func graphqlConfig(v *viper.Viper) GraphqlConfig {
+	c := GraphqlConfig{MaxDepth: defaultGraphqlMaxDepth, MaxComplexity: defaultGraphqlMaxComplexity}
+	if v == nil {
+		return c
+	}
+
+	c.Override(GraphqlConfig{
+		MaxDepth:      v.GetInt("max_depth"),
+		MaxComplexity: v.GetInt("max_complexity"),
+	})
+	return c
+}
+
+func graphqlConfigFromEnv(v *viper.Viper, prefix string) GraphqlConfig {
+	if v == nil {
+		return GraphqlConfig{}
+	}
+	return GraphqlConfig{
+		MaxDepth:      v.GetInt(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "max_depth"))),
+		MaxComplexity: v.GetInt(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "max_complexity"))),
+	}
+}
@@ -0,0 +1,38 @@
+package configs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// GrpcServerConfig is of the gRPC server of the API, served next to the REST endpoints
+type GrpcServerConfig struct {
+	// Port of the gRPC server, the server is not started when empty
+	Port string
+}
+
+func (lhs *GrpcServerConfig) Override(rhs GrpcServerConfig) {
+	if rhs.Port != "" {
+		lhs.Port = rhs.Port
+	}
+}
+
+func grpcServerConfig(v *viper.Viper) GrpcServerConfig {
+	if v == nil {
+		return GrpcServerConfig{}
+	}
+	return GrpcServerConfig{
+		Port: v.GetString("port"),
+	}
+}
+
+func grpcServerConfigFromEnv(v *viper.Viper, prefix string) GrpcServerConfig {
+	if v == nil {
+		return GrpcServerConfig{}
+	}
+	return GrpcServerConfig{
+		Port: v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "port"))),
+	}
+}
@@ -12,6 +12,8 @@ type IndexerConfig struct {
 	SrcDb             RdbConfig
 	Db                RdbConfig
 	FactoryAddress    string
+
+	CirculatingSupplyExclusions []SupplyExclusionConfig
 }
 
 func indexerConfig(v *viper.Viper) IndexerConfig {
@@ -59,6 +61,17 @@ func indexerConfig(v *viper.Viper) IndexerConfig {
 		factoryAddress = envFactoryAddress
 	}
 
+	supplyExclusions, err := supplyExclusionConfigsFromEnv(v, "INDEXER_CIRCULATING_SUPPLY_EXCLUSIONS")
+	if err != nil {
+		panic(err)
+	}
+	if len(supplyExclusions) == 0 {
+		supplyExclusions, err = supplyExclusionConfigs(v, "indexer.circulating_supply_exclusions")
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	return IndexerConfig{
 		ChainId:           chainId,
 		SrcNode:           nodeC,
@@ -67,5 +80,7 @@ func indexerConfig(v *viper.Viper) IndexerConfig {
 		SrcDb:             srcDbC,
 		Db:                dbC,
 		FactoryAddress:    factoryAddress,
+
+		CirculatingSupplyExclusions: supplyExclusions,
 	}
 }
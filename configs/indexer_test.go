@@ -109,3 +109,41 @@ func TestIndexerConfigSrcNodesUnmarshalErrorPanics(t *testing.T) {
 		indexerConfig(v)
 	})
 }
+
+func TestIndexerConfigCirculatingSupplyExclusions(t *testing.T) {
+	v := newTestViper(t, `
+indexer:
+  chain_id: dorado-1
+  circulating_supply_exclusions:
+    - token: ibc/ABCDEF
+      addresses:
+        - fetch1treasury
+        - fetch1vesting
+`)
+
+	c := indexerConfig(v)
+
+	require.Equal(t, []SupplyExclusionConfig{
+		{Token: "ibc/ABCDEF", Addresses: []string{"fetch1treasury", "fetch1vesting"}},
+	}, c.CirculatingSupplyExclusions)
+	require.Equal(t, map[string][]string{"ibc/ABCDEF": {"fetch1treasury", "fetch1vesting"}}, SupplyExclusionsByToken(c.CirculatingSupplyExclusions))
+}
+
+func TestIndexerConfigCirculatingSupplyExclusionsOverrideByEnv(t *testing.T) {
+	const envKey = "APP_INDEXER_CIRCULATING_SUPPLY_EXCLUSIONS"
+	require.NoError(t, os.Setenv(envKey, `[{"token":"xpla1token","addresses":["xpla1env"]}]`))
+	defer os.Unsetenv(envKey)
+
+	v := newTestViper(t, `
+indexer:
+  chain_id: dorado-1
+  circulating_supply_exclusions:
+    - token: xpla1token
+      addresses:
+        - xpla1file
+`)
+
+	c := indexerConfig(v)
+
+	require.Equal(t, []SupplyExclusionConfig{{Token: "xpla1token", Addresses: []string{"xpla1env"}}}, c.CirculatingSupplyExclusions)
+}
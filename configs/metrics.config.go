@@ -0,0 +1,48 @@
+package configs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const defaultMetricsPath = "/metrics"
+
+// MetricsConfig is of the Prometheus metrics of the API
+type MetricsConfig struct {
+	Enabled bool
+	// Path serves the metrics, /metrics when empty
+	Path string
+}
+
+func (lhs *MetricsConfig) Override(rhs MetricsConfig) {
+	if rhs.Enabled {
+		lhs.Enabled = rhs.Enabled
+	}
+	if rhs.Path != "" {
+		lhs.Path = rhs.Path
+	}
+}
+
+func metricsConfig(v *viper.Viper) MetricsConfig {
+	c := MetricsConfig{Path: defaultMetricsPath}
+	if v == nil {
+		return c
+	}
+	c.Override(MetricsConfig{
+		Enabled: v.GetBool("enabled"),
+		Path:    v.GetString("path"),
+	})
+	return c
+}
+
+func metricsConfigFromEnv(v *viper.Viper, prefix string) MetricsConfig {
+	if v == nil {
+		return MetricsConfig{}
+	}
+	return MetricsConfig{
+		Enabled: v.GetBool(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "enabled"))),
+		Path:    v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "path"))),
+	}
+}
@@ -0,0 +1,135 @@
+package configs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+var (
+	defaultRateLimitIp  = RateLimitTier{Rate: 10, Burst: 20}
+	defaultRateLimitKey = RateLimitTier{Rate: 50, Burst: 100}
+	defaultRateLimitMCP = RateLimitTier{Rate: 2, Burst: 10}
+)
+
+type RateLimitConfig struct {
+	Enabled bool
+	// RequireKey rejects the requests without a key, they are limited by the IP otherwise
+	RequireKey bool
+	// Ip limits each IP of the requests without a key
+	Ip RateLimitTier
+	// Key limits each key which has no limit of its own
+	Key RateLimitTier
+	// MCP limits the MCP endpoint per key or IP, apart from the other endpoints
+	MCP RateLimitTier
+	// Keys are accepted in addition to the keys of the DB
+	Keys []ApiKeyConfig
+}
+
+// RateLimitTier is a token bucket of Burst tokens refilled by Rate a second, with an optional quota of a UTC day
+type RateLimitTier struct {
+	Rate       float64 `mapstructure:"rate" json:"rate"`
+	Burst      int     `mapstructure:"burst" json:"burst"`
+	DailyQuota int     `mapstructure:"daily_quota" json:"daily_quota"`
+}
+
+// ApiKeyConfig is a key of the config, the zero limits follow the key tier
+type ApiKeyConfig struct {
+	Name          string `mapstructure:"name" json:"name"`
+	Key           string `mapstructure:"key" json:"key"`
+	RateLimitTier `mapstructure:",squash"`
+}
+
+func (lhs *RateLimitTier) Override(rhs RateLimitTier) {
+	if rhs.Rate != 0 {
+		lhs.Rate = rhs.Rate
+	}
+	if rhs.Burst != 0 {
+		lhs.Burst = rhs.Burst
+	}
+	if rhs.DailyQuota != 0 {
+		lhs.DailyQuota = rhs.DailyQuota
+	}
+}
+
+func (lhs *RateLimitConfig) Override(rhs RateLimitConfig) {
+	if rhs.Enabled {
+		lhs.Enabled = rhs.Enabled
+	}
+	if rhs.RequireKey {
+		lhs.RequireKey = rhs.RequireKey
+	}
+	lhs.Ip.Override(rhs.Ip)
+	lhs.Key.Override(rhs.Key)
+	lhs.MCP.Override(rhs.MCP)
+	if len(rhs.Keys) > 0 {
+		lhs.Keys = rhs.Keys
+	}
+}
+
+func rateLimitConfig(v *viper.Viper) (RateLimitConfig, error) {
+	c := RateLimitConfig{Ip: defaultRateLimitIp, Key: defaultRateLimitKey, MCP: defaultRateLimitMCP}
+	if v == nil {
+		return c, nil
+	}
+
+	var keys []ApiKeyConfig
+	// the sub config sees the JSON of the env as the keys, it is read by rateLimitConfigFromEnv
+	if _, ok := v.Get("keys").(string); !ok {
+		if err := v.UnmarshalKey("keys", &keys); err != nil {
+			return RateLimitConfig{}, fmt.Errorf("unmarshal keys: %w", err)
+		}
+	}
+	c.Override(RateLimitConfig{
+		Enabled:    v.GetBool("enabled"),
+		RequireKey: v.GetBool("require_key"),
+		Ip:         rateLimitTier(v.Sub("ip")),
+		Key:        rateLimitTier(v.Sub("key")),
+		MCP:        rateLimitTier(v.Sub("mcp")),
+		Keys:       keys,
+	})
+	return c, nil
+}
+
+func rateLimitTier(v *viper.Viper) RateLimitTier {
+	if v == nil {
+		return RateLimitTier{}
+	}
+	return RateLimitTier{
+		Rate:       v.GetFloat64("rate"),
+		Burst:      v.GetInt("burst"),
+		DailyQuota: v.GetInt("daily_quota"),
+	}
+}
+
+// rateLimitConfigFromEnv reads the keys from a JSON array as the nodes
+func rateLimitConfigFromEnv(v *viper.Viper, prefix string) (RateLimitConfig, error) {
+	if v == nil {
+		return RateLimitConfig{}, nil
+	}
+
+	var keys []ApiKeyConfig
+	if value := v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "keys"))); value != "" {
+		if err := json.Unmarshal([]byte(value), &keys); err != nil {
+			return RateLimitConfig{}, fmt.Errorf("unmarshal %s_KEYS: %w", strings.ToUpper(prefix), err)
+		}
+	}
+	return RateLimitConfig{
+		Enabled:    v.GetBool(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "enabled"))),
+		RequireKey: v.GetBool(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "require_key"))),
+		Ip:         rateLimitTierFromEnv(v, fmt.Sprintf("%s_%s", prefix, "ip")),
+		Key:        rateLimitTierFromEnv(v, fmt.Sprintf("%s_%s", prefix, "key")),
+		MCP:        rateLimitTierFromEnv(v, fmt.Sprintf("%s_%s", prefix, "mcp")),
+		Keys:       keys,
+	}, nil
+}
+
+func rateLimitTierFromEnv(v *viper.Viper, prefix string) RateLimitTier {
+	return RateLimitTier{
+		Rate:       v.GetFloat64(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "rate"))),
+		Burst:      v.GetInt(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "burst"))),
+		DailyQuota: v.GetInt(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "daily_quota"))),
+	}
+}
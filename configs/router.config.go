@@ -0,0 +1,69 @@
+package configs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// RouterSourceAggregator reads the routes precomputed by the aggregator
+	RouterSourceAggregator = "aggregator"
+	// RouterSourceGraph computes the routes in process from the pairs and their latest pools
+	RouterSourceGraph = "graph"
+
+	defaultRouterMaxHopCount = 3
+)
+
+type RouterConfig struct {
+	Source string
+	// MaxHopCount caps the hop count of the routes computed in process
+	MaxHopCount int
+	// MinPoolLiquidity is the minimum reserve of each asset in the smallest unit, pools holding less are not routed
+	MinPoolLiquidity string
+	// Contract is the router contract which the swap txs execute, the registered one of the network when empty
+	Contract string
+}
+
+func (lhs *RouterConfig) Override(rhs RouterConfig) {
+	if rhs.Source != "" {
+		lhs.Source = rhs.Source
+	}
+	if rhs.MaxHopCount != 0 {
+		lhs.MaxHopCount = rhs.MaxHopCount
+	}
+	if rhs.MinPoolLiquidity != "" {
+		lhs.MinPoolLiquidity = rhs.MinPoolLiquidity
+	}
+	if rhs.Contract != "" {
+		lhs.Contract = rhs.Contract
+	}
+}
+
+func routerConfig(v *viper.Viper) RouterConfig {
+	c := RouterConfig{Source: RouterSourceAggregator, MaxHopCount: defaultRouterMaxHopCount}
+	if v == nil {
+		return c
+	}
+
+	c.Override(RouterConfig{
+		Source:           v.GetString("source"),
+		MaxHopCount:      v.GetInt("max_hop_count"),
+		MinPoolLiquidity: v.GetString("min_pool_liquidity"),
+		Contract:         v.GetString("contract"),
+	})
+	return c
+}
+
+func routerConfigFromEnv(v *viper.Viper, prefix string) RouterConfig {
+	if v == nil {
+		return RouterConfig{}
+	}
+	return RouterConfig{
+		Source:           v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "source"))),
+		MaxHopCount:      v.GetInt(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "max_hop_count"))),
+		MinPoolLiquidity: v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "min_pool_liquidity"))),
+		Contract:         v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "contract"))),
+	}
+}
@@ -0,0 +1,38 @@
+package configs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// StatusConfig is of the status endpoints
+type StatusConfig struct {
+	// MaxStalenessSeconds fails the readiness when the parsed txs or the latest pools are older, unchecked when 0
+	MaxStalenessSeconds int
+}
+
+func (lhs *StatusConfig) Override(rhs StatusConfig) {
+	if rhs.MaxStalenessSeconds != 0 {
+		lhs.MaxStalenessSeconds = rhs.MaxStalenessSeconds
+	}
+}
+
+func statusConfig(v *viper.Viper) StatusConfig {
+	if v == nil {
+		return StatusConfig{}
+	}
+	return StatusConfig{
+		MaxStalenessSeconds: v.GetInt("max_staleness_seconds"),
+	}
+}
+
+func statusConfigFromEnv(v *viper.Viper, prefix string) StatusConfig {
+	if v == nil {
+		return StatusConfig{}
+	}
+	return StatusConfig{
+		MaxStalenessSeconds: v.GetInt(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "max_staleness_seconds"))),
+	}
+}
@@ -0,0 +1,63 @@
+package configs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultStreamBufferSize      = 256
+	defaultStreamMaxReplayBlocks = 10_000
+	defaultStreamMaxSubscribers  = 1_000
+)
+
+type StreamConfig struct {
+	// BufferSize is the number of the live events held for a subscriber, a subscriber falling further behind is disconnected
+	BufferSize int
+	// MaxReplayBlocks caps how far behind the latest block a subscription may replay from
+	MaxReplayBlocks int
+	MaxSubscribers  int
+}
+
+func (lhs *StreamConfig) Override(rhs StreamConfig) {
+	if rhs.BufferSize != 0 {
+		lhs.BufferSize = rhs.BufferSize
+	}
+	if rhs.MaxReplayBlocks != 0 {
+		lhs.MaxReplayBlocks = rhs.MaxReplayBlocks
+	}
+	if rhs.MaxSubscribers != 0 {
+		lhs.MaxSubscribers = rhs.MaxSubscribers
+	}
+}
+
+func streamConfig(v *viper.Viper) StreamConfig {
+	c := StreamConfig{
+		BufferSize:      defaultStreamBufferSize,
+		MaxReplayBlocks: defaultStreamMaxReplayBlocks,
+		MaxSubscribers:  defaultStreamMaxSubscribers,
+	}
+	if v == nil {
+		return c
+	}
+
+	c.Override(StreamConfig{
+		BufferSize:      v.GetInt("buffer_size"),
+		MaxReplayBlocks: v.GetInt("max_replay_blocks"),
+		MaxSubscribers:  v.GetInt("max_subscribers"),
+	})
+	return c
+}
+
+func streamConfigFromEnv(v *viper.Viper, prefix string) StreamConfig {
+	if v == nil {
+		return StreamConfig{}
+	}
+	return StreamConfig{
+		BufferSize:      v.GetInt(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "buffer_size"))),
+		MaxReplayBlocks: v.GetInt(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "max_replay_blocks"))),
+		MaxSubscribers:  v.GetInt(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "max_subscribers"))),
+	}
+}
@@ -0,0 +1,53 @@
+package configs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// SupplyExclusionConfig lists holders of a token (e.g. treasury, vesting) whose balances are not circulating
+type SupplyExclusionConfig struct {
+	Token     string   `mapstructure:"token" json:"token"`
+	Addresses []string `mapstructure:"addresses" json:"addresses"`
+}
+
+func supplyExclusionConfigs(v *viper.Viper, key string) ([]SupplyExclusionConfig, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	var configs []SupplyExclusionConfig
+	if err := v.UnmarshalKey(key, &configs); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", key, err)
+	}
+	return configs, nil
+}
+
+func supplyExclusionConfigsFromEnv(v *viper.Viper, prefix string) ([]SupplyExclusionConfig, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	value := v.GetString(strings.ToUpper(prefix))
+	if value == "" {
+		return nil, nil
+	}
+
+	var configs []SupplyExclusionConfig
+	if err := json.Unmarshal([]byte(value), &configs); err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", strings.ToUpper(prefix), err)
+	}
+	return configs, nil
+}
+
+// SupplyExclusionsByToken groups the excluded holders by token address
+func SupplyExclusionsByToken(configs []SupplyExclusionConfig) map[string][]string {
+	exclusions := make(map[string][]string, len(configs))
+	for _, c := range configs {
+		exclusions[c.Token] = append(exclusions[c.Token], c.Addresses...)
+	}
+	return exclusions
+}
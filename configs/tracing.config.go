@@ -0,0 +1,62 @@
+package configs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+const defaultTracingSampleRatio = 0.1
+
+// TracingConfig is of the OpenTelemetry traces of the API, exported over OTLP gRPC
+type TracingConfig struct {
+	Enabled bool
+	// Endpoint is the host:port of the OTLP collector, OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4317 when empty
+	Endpoint string
+	// Insecure exports without TLS
+	Insecure bool
+	// SampleRatio of the traces started by the API in (0, 1], the traces of the callers follow their sampling
+	SampleRatio float64
+}
+
+func (lhs *TracingConfig) Override(rhs TracingConfig) {
+	if rhs.Enabled {
+		lhs.Enabled = rhs.Enabled
+	}
+	if rhs.Endpoint != "" {
+		lhs.Endpoint = rhs.Endpoint
+	}
+	if rhs.Insecure {
+		lhs.Insecure = rhs.Insecure
+	}
+	if rhs.SampleRatio != 0 {
+		lhs.SampleRatio = rhs.SampleRatio
+	}
+}
+
+func tracingConfig(v *viper.Viper) TracingConfig {
+	c := TracingConfig{SampleRatio: defaultTracingSampleRatio}
+	if v == nil {
+		return c
+	}
+	c.Override(TracingConfig{
+		Enabled:     v.GetBool("enabled"),
+		Endpoint:    v.GetString("endpoint"),
+		Insecure:    v.GetBool("insecure"),
+		SampleRatio: v.GetFloat64("sample_ratio"),
+	})
+	return c
+}
+
+func tracingConfigFromEnv(v *viper.Viper, prefix string) TracingConfig {
+	if v == nil {
+		return TracingConfig{}
+	}
+	return TracingConfig{
+		Enabled:     v.GetBool(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "enabled"))),
+		Endpoint:    v.GetString(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "endpoint"))),
+		Insecure:    v.GetBool(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "insecure"))),
+		SampleRatio: v.GetFloat64(strings.ToUpper(fmt.Sprintf("%s_%s", prefix, "sample_ratio"))),
+	}
+}
@@ -0,0 +1,20 @@
+//go:build mig
+// +build mig
+
+package main
+
+import (
+	"github.com/dezswap/dezswap-api/pkg/db/indexer"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var M20261019_130000 = &gormigrate.Migration{
+	ID: "20261019_130000",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&indexer.LpPosition{})
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&indexer.LpPosition{})
+	},
+}
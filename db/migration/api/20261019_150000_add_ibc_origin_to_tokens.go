@@ -0,0 +1,44 @@
+//go:build mig
+// +build mig
+
+package main
+
+import (
+	"github.com/dezswap/dezswap-api/pkg/db/indexer"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var ibcOriginColumns = []string{"IbcPath", "IbcBaseDenom", "IbcOriginChain"}
+
+var M20261019_150000 = &gormigrate.Migration{
+	ID: "20261019_150000",
+	Migrate: func(tx *gorm.DB) error {
+		m := tx.Migrator()
+		for _, c := range ibcOriginColumns {
+			if m.HasColumn(&indexer.Token{}, c) {
+				continue
+			}
+			if err := m.AddColumn(&indexer.Token{}, c); err != nil {
+				return err
+			}
+		}
+		// the origins of the existing tokens are backfilled by the indexer at startup, it has the node
+		if m.HasIndex(&indexer.Token{}, "IbcBaseDenom") {
+			return nil
+		}
+		return m.CreateIndex(&indexer.Token{}, "IbcBaseDenom")
+	},
+	Rollback: func(tx *gorm.DB) error {
+		m := tx.Migrator()
+		for _, c := range ibcOriginColumns {
+			if !m.HasColumn(&indexer.Token{}, c) {
+				continue
+			}
+			if err := m.DropColumn(&indexer.Token{}, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
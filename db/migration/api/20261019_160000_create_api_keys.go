@@ -0,0 +1,20 @@
+//go:build mig
+// +build mig
+
+package main
+
+import (
+	"github.com/dezswap/dezswap-api/pkg/db/api"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var M20261019_160000 = &gormigrate.Migration{
+	ID: "20261019_160000",
+	Migrate: func(tx *gorm.DB) error {
+		return tx.AutoMigrate(&api.ApiKey{})
+	},
+	Rollback: func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(&api.ApiKey{})
+	},
+}
@@ -0,0 +1,39 @@
+//go:build mig
+// +build mig
+
+package main
+
+import (
+	"github.com/dezswap/dezswap-api/pkg/db/indexer"
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+var M20261019_170000 = &gormigrate.Migration{
+	ID: "20261019_170000",
+	Migrate: func(tx *gorm.DB) error {
+		m := tx.Migrator()
+		// the swaps indexed before are not valued, the volumes start at zero
+		for _, model := range []interface{}{&indexer.AccountActivity{}, &indexer.AccountPairActivity{}} {
+			if m.HasColumn(model, "Volume") {
+				continue
+			}
+			if err := m.AddColumn(model, "Volume"); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+	Rollback: func(tx *gorm.DB) error {
+		m := tx.Migrator()
+		for _, model := range []interface{}{&indexer.AccountActivity{}, &indexer.AccountPairActivity{}} {
+			if !m.HasColumn(model, "Volume") {
+				continue
+			}
+			if err := m.DropColumn(model, "Volume"); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
@@ -15,7 +15,7 @@ import (
 	"gorm.io/gorm"
 )
 
-var migrations = []*gormigrate.Migration{M20231121_201814}
+var migrations = []*gormigrate.Migration{M20231121_201814, M20261019_120000, M20261019_130000, M20261019_140000, M20261019_150000, M20261019_160000, M20261019_170000}
 
 func main() {
 	rollback := os.Args[len(os.Args)-1]
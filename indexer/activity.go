@@ -0,0 +1,171 @@
+package indexer
+
+import (
+	"cosmossdk.io/math"
+	"github.com/pkg/errors"
+)
+
+// accountActivityHeightRange limits the number of heights processed in a single run
+const accountActivityHeightRange = 10_000
+
+// UpdateAccountActivities implements Indexer
+func (d *dexIndexer) UpdateAccountActivities() error {
+	fromHeight, toHeight, err := d.nextHeightRange(AccountActivityJob, accountActivityHeightRange)
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateAccountActivities")
+	}
+	if fromHeight == toHeight {
+		return nil
+	}
+
+	txs, err := d.repo.ParsedTxsInRange(fromHeight, toHeight)
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateAccountActivities")
+	}
+
+	values, err := d.repo.SwapValues(fromHeight, toHeight)
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateAccountActivities")
+	}
+
+	accounts, pairs, err := accumulateAccountActivities(txs, values)
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateAccountActivities")
+	}
+
+	if err := d.repo.SaveAccountActivities(accounts, pairs, toHeight); err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateAccountActivities")
+	}
+	return nil
+}
+
+// nextHeightRange returns the heights (fromHeight, toHeight] the job has to index next, at most maxRange.
+// fromHeight equals toHeight when the job has caught up with the parser.
+func (d *dexIndexer) nextHeightRange(job string, maxRange uint64) (uint64, uint64, error) {
+	indexedHeight, err := d.repo.IndexedHeight(job)
+	if err != nil {
+		return 0, 0, err
+	}
+	syncedHeight, err := d.repo.SyncedHeight()
+	if err != nil {
+		return 0, 0, err
+	}
+	if syncedHeight <= indexedHeight {
+		return indexedHeight, indexedHeight, nil
+	}
+	return indexedHeight, min(indexedHeight+maxRange, syncedHeight), nil
+}
+
+// accumulateAccountActivities sums up swaps, provides and withdraws of txs by account and by account and pair.
+// The volume of an account is of the values of its swaps by the tx id, the volumes of the assets are of a pair only.
+// txs must be ordered by height.
+func accumulateAccountActivities(txs []ParsedTx, values map[uint64]string) ([]AccountActivity, []AccountPairActivity, error) {
+	type pairKey struct {
+		address string
+		pair    string
+	}
+	type pairVolume struct {
+		asset0 math.Int
+		asset1 math.Int
+		value  math.LegacyDec
+	}
+
+	accounts := []AccountActivity{}
+	pairs := []AccountPairActivity{}
+	accountIdx := make(map[string]int)
+	pairIdx := make(map[pairKey]int)
+	volumes := make(map[pairKey]*pairVolume)
+	accountVolumes := make(map[string]math.LegacyDec)
+
+	for _, tx := range txs {
+		if tx.Sender == "" || (tx.Type != Swap && tx.Type != Provide && tx.Type != Withdraw) {
+			continue
+		}
+
+		idx, ok := accountIdx[tx.Sender]
+		if !ok {
+			idx = len(accounts)
+			accountIdx[tx.Sender] = idx
+			accounts = append(accounts, newAccountActivity(tx))
+			accountVolumes[tx.Sender] = math.LegacyZeroDec()
+		}
+		accounts[idx].seen(tx)
+
+		key := pairKey{tx.Sender, tx.Address}
+		pIdx, ok := pairIdx[key]
+		if !ok {
+			pIdx = len(pairs)
+			pairIdx[key] = pIdx
+			pairs = append(pairs, AccountPairActivity{AccountActivity: newAccountActivity(tx), Pair: tx.Address})
+			volumes[key] = &pairVolume{math.ZeroInt(), math.ZeroInt(), math.LegacyZeroDec()}
+		}
+		pairs[pIdx].seen(tx)
+
+		if tx.Type == Swap {
+			amount0, err := absAmount(tx.Asset0Amount)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "accumulateAccountActivities: tx(%s)", tx.Hash)
+			}
+			amount1, err := absAmount(tx.Asset1Amount)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "accumulateAccountActivities: tx(%s)", tx.Hash)
+			}
+			volumes[key].asset0 = volumes[key].asset0.Add(amount0)
+			volumes[key].asset1 = volumes[key].asset1.Add(amount1)
+
+			if v, ok := values[tx.ID]; ok {
+				value, err := math.LegacyNewDecFromStr(v)
+				if err != nil {
+					return nil, nil, errors.Wrapf(err, "accumulateAccountActivities: value of tx(%s)", tx.Hash)
+				}
+				volumes[key].value = volumes[key].value.Add(value)
+				accountVolumes[tx.Sender] = accountVolumes[tx.Sender].Add(value)
+			}
+		}
+	}
+
+	for address, idx := range accountIdx {
+		accounts[idx].Volume = accountVolumes[address].String()
+	}
+	for key, idx := range pairIdx {
+		pairs[idx].Asset0Volume = volumes[key].asset0.String()
+		pairs[idx].Asset1Volume = volumes[key].asset1.String()
+		pairs[idx].Volume = volumes[key].value.String()
+	}
+
+	return accounts, pairs, nil
+}
+
+func newAccountActivity(tx ParsedTx) AccountActivity {
+	return AccountActivity{
+		ChainId:         tx.ChainId,
+		Address:         tx.Sender,
+		FirstSeenHeight: tx.Height,
+		FirstSeenAt:     tx.Timestamp,
+	}
+}
+
+func (a *AccountActivity) seen(tx ParsedTx) {
+	switch tx.Type {
+	case Swap:
+		a.SwapCount++
+	case Provide:
+		a.ProvideCount++
+	case Withdraw:
+		a.WithdrawCount++
+	}
+	a.LastSeenHeight = tx.Height
+	a.LastSeenAt = tx.Timestamp
+}
+
+// absAmount parses an amount of parsed tx which is signed by the direction to the pool
+func absAmount(amount string) (math.Int, error) {
+	if amount == "" {
+		return math.ZeroInt(), nil
+	}
+	v, ok := math.NewIntFromString(amount)
+	if !ok {
+		return math.Int{}, errors.Errorf("invalid amount(%s)", amount)
+	}
+	return v.Abs(), nil
+}
@@ -12,6 +12,12 @@ const (
 	Withdraw Action = "withdraw"
 )
 
+// Job names of the indexer which keep their own indexed height
+const (
+	AccountActivityJob = "account_activity"
+	LpPositionJob      = "lp_position"
+)
+
 type Pair struct {
 	ID      string `json:"id"`
 	Address string `json:"address"`
@@ -30,6 +36,10 @@ type Token struct {
 	Decimals uint8  `json:"decimals"`
 	Icon     string `json:"icon"`
 	Verified bool   `json:"verified"`
+
+	IbcPath        string `json:"ibcPath"`
+	IbcBaseDenom   string `json:"ibcBaseDenom"`
+	IbcOriginChain string `json:"ibcOriginChain"`
 }
 
 // Equal implements comparable
@@ -44,7 +54,24 @@ func (lhs *Token) Equal(rhs comparable) bool {
 		lhs.Name == t.Name &&
 		lhs.Decimals == t.Decimals &&
 		lhs.Icon == t.Icon &&
-		lhs.Verified == t.Verified
+		lhs.Verified == t.Verified &&
+		lhs.IbcPath == t.IbcPath &&
+		lhs.IbcBaseDenom == t.IbcBaseDenom &&
+		lhs.IbcOriginChain == t.IbcOriginChain
+}
+
+// fillIbcOrigin keeps the IBC origin of rhs where lhs does not know it,
+// e.g. the origin chain is only given by the asset list while the path comes from the node
+func (lhs *Token) fillIbcOrigin(rhs Token) {
+	if lhs.IbcPath == "" {
+		lhs.IbcPath = rhs.IbcPath
+	}
+	if lhs.IbcBaseDenom == "" {
+		lhs.IbcBaseDenom = rhs.IbcBaseDenom
+	}
+	if lhs.IbcOriginChain == "" {
+		lhs.IbcOriginChain = rhs.IbcOriginChain
+	}
 }
 
 type PoolInfo struct {
@@ -92,3 +119,51 @@ type ParsedTx struct {
 	Commission0Amount string  `json:"commission0Amount"`
 	Commission1Amount string  `json:"commission1Amount"`
 }
+
+// AccountActivity is the accumulated activity of an account over the indexed parsed txs
+type AccountActivity struct {
+	ChainId         string  `json:"chainId"`
+	Address         string  `json:"address"`
+	SwapCount       uint64  `json:"swapCount"`
+	ProvideCount    uint64  `json:"provideCount"`
+	WithdrawCount   uint64  `json:"withdrawCount"`
+	Volume          string  `json:"volume"` // value of the swaps in the price token, a swap of no priced asset is not counted
+	FirstSeenHeight uint64  `json:"firstSeenHeight"`
+	FirstSeenAt     float64 `json:"firstSeenAt"` // timestamp of a block in second
+	LastSeenHeight  uint64  `json:"lastSeenHeight"`
+	LastSeenAt      float64 `json:"lastSeenAt"` // timestamp of a block in second
+}
+
+// AccountPairActivity is the accumulated activity of an account on a pair
+type AccountPairActivity struct {
+	AccountActivity
+	Pair         string `json:"pair"`
+	Asset0Volume string `json:"asset0Volume"`
+	Asset1Volume string `json:"asset1Volume"`
+}
+
+// LpPosition is the LP balance of an account on a pair with the assets deposited for it.
+// The cost basis shrinks in proportion to the withdrawn share.
+type LpPosition struct {
+	ChainId         string  `json:"chainId"`
+	Address         string  `json:"address"`
+	Pair            string  `json:"pair"`
+	Lp              string  `json:"lp"`
+	LpAmount        string  `json:"lpAmount"`
+	Asset0          string  `json:"asset0"`
+	Asset0CostBasis string  `json:"asset0CostBasis"`
+	Asset1          string  `json:"asset1"`
+	Asset1CostBasis string  `json:"asset1CostBasis"`
+	Height          uint64  `json:"height"`    // height of the last provide or withdraw
+	Timestamp       float64 `json:"timestamp"` // timestamp of a block in second
+}
+
+// TokenSupply is the supply of a token at the height.
+// CirculatingSupply excludes balances of the configured holders like treasury or vesting accounts.
+type TokenSupply struct {
+	ChainId           string `json:"chainId"`
+	Address           string `json:"address"`
+	Height            uint64 `json:"height"`
+	TotalSupply       string `json:"totalSupply"`
+	CirculatingSupply string `json:"circulatingSupply"`
+}
@@ -10,12 +10,14 @@ type dexIndexer struct {
 	pkg.NetworkMetadata
 	repo    Repo
 	chainId string
+	// supplyExclusions maps a token address to the holders excluded from its circulating supply
+	supplyExclusions map[string][]string
 }
 
 var _ Indexer = &dexIndexer{}
 
-func NewDexIndexer(networkMetadata pkg.NetworkMetadata, repo Repo, chainId string) Indexer {
-	return &dexIndexer{networkMetadata, repo, chainId}
+func NewDexIndexer(networkMetadata pkg.NetworkMetadata, repo Repo, chainId string, supplyExclusions map[string][]string) Indexer {
+	return &dexIndexer{networkMetadata, repo, chainId, supplyExclusions}
 }
 
 // UpdatePools implements Indexer
@@ -121,6 +123,7 @@ func (d *dexIndexer) UpdateVerifiedTokens() error {
 	updatableTokens := []Token{}
 	for _, vt := range newVerifiedTokens {
 		t, ok := tokenMap[vt.Address]
+		vt.fillIbcOrigin(t)
 		if !ok || !isEqual(&t, &vt) {
 			vt.ID = t.ID
 			updatableTokens = append(updatableTokens, vt)
@@ -139,3 +142,35 @@ func (d *dexIndexer) UpdateVerifiedTokens() error {
 	}
 	return nil
 }
+
+// BackfillIbcOrigins implements Indexer
+func (d *dexIndexer) BackfillIbcOrigins() error {
+	tokens, err := d.repo.Tokens(db.LastIdLimitCondition{})
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.BackfillIbcOrigins")
+	}
+
+	backfilled := []Token{}
+	errs := []error{}
+	for _, t := range tokens {
+		if !d.IsIbcToken(t.Address) || t.IbcPath != "" {
+			continue
+		}
+		// a token failing on the node is tried again at the next startup
+		origin, err := d.repo.TokenFromNode(t.Address)
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, t.Address))
+			continue
+		}
+		t.fillIbcOrigin(*origin)
+		backfilled = append(backfilled, t)
+	}
+
+	if err := d.repo.SaveTokens(backfilled); err != nil {
+		return errors.Wrap(err, "dexIndexer.BackfillIbcOrigins")
+	}
+	if err := joinErrors(errs); err != nil {
+		return errors.Wrapf(err, "dexIndexer.BackfillIbcOrigins: %d tokens skipped", len(errs))
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package indexer
 
 import (
+	"errors"
 	"github.com/dezswap/dezswap-api/pkg"
 	"testing"
 
@@ -32,7 +33,7 @@ func (m *mockRepo) SaveTokens(tokens []Token) error {
 
 func Test_UpdateVerified(t *testing.T) {
 	repo := mockRepo{nil, &mock.Mock{}}
-	dexIndexer := dexIndexer{pkg.NetworkMetadata{}, &repo, "chainId"}
+	dexIndexer := dexIndexer{pkg.NetworkMetadata{}, &repo, "chainId", nil}
 
 	type testcase struct {
 		tokens                  []Token
@@ -61,6 +62,13 @@ func Test_UpdateVerified(t *testing.T) {
 			[]Token{{Address: "0x1", ChainId: "chainId", Protocol: "protocol", Symbol: "symbol", Name: "name", Decimals: 18, Icon: "icon", Verified: true}},
 			"",
 		},
+		// ibc origin found on the node must be kept when the asset list does not know it
+		{
+			[]Token{{Address: "ibc/1", ChainId: "chainId", Symbol: "uusdc", Name: "uusdc", Decimals: 18, IbcPath: "transfer/channel-1", IbcBaseDenom: "uusdc"}},
+			[]Token{{Address: "ibc/1", ChainId: "chainId", Symbol: "USDC", Name: "USD Coin", Decimals: 6, Verified: true, IbcOriginChain: "axelar"}},
+			[]Token{{Address: "ibc/1", ChainId: "chainId", Symbol: "USDC", Name: "USD Coin", Decimals: 6, Verified: true, IbcPath: "transfer/channel-1", IbcBaseDenom: "uusdc", IbcOriginChain: "axelar"}},
+			"",
+		},
 	}
 
 	assert := assert.New(t)
@@ -78,3 +86,240 @@ func Test_UpdateVerified(t *testing.T) {
 		}
 	}
 }
+
+func (m *mockRepo) TokenFromNode(addr string) (*Token, error) {
+	args := m.Called(addr)
+	token, _ := args.Get(0).(*Token)
+	return token, args.Error(1)
+}
+
+func Test_BackfillIbcOrigins(t *testing.T) {
+	repo := mockRepo{nil, &mock.Mock{}}
+	dexIndexer := dexIndexer{pkg.NetworkMetadata{}, &repo, "chainId", nil}
+
+	repo.On("Tokens", db.LastIdLimitCondition{}).Return([]Token{
+		{Address: "xpla1cw20", ChainId: "chainId", Symbol: "CW"},
+		{Address: "ibc/1", ChainId: "chainId", Symbol: "USDC", Verified: true, IbcOriginChain: "axelar"},
+		{Address: "ibc/2", ChainId: "chainId", Symbol: "uatom", IbcPath: "transfer/channel-2", IbcBaseDenom: "uatom"},
+		{Address: "ibc/3", ChainId: "chainId", Symbol: "uosmo"},
+	}, nil).Once()
+	repo.On("TokenFromNode", "ibc/1").Return(&Token{Address: "ibc/1", Symbol: "uusdc", IbcPath: "transfer/channel-1", IbcBaseDenom: "uusdc"}, nil).Once()
+	repo.On("TokenFromNode", "ibc/3").Return(nil, errors.New("node unavailable")).Once()
+	// only the origin is filled, the token failing on the node is skipped
+	repo.On("SaveTokens", []Token{
+		{Address: "ibc/1", ChainId: "chainId", Symbol: "USDC", Verified: true, IbcPath: "transfer/channel-1", IbcBaseDenom: "uusdc", IbcOriginChain: "axelar"},
+	}).Return(nil).Once()
+
+	err := dexIndexer.BackfillIbcOrigins()
+	assert.ErrorContains(t, err, "ibc/3")
+	repo.AssertExpectations(t)
+}
+
+func (m *mockRepo) IndexedHeight(job string) (uint64, error) {
+	args := m.Called(job)
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+func (m *mockRepo) SyncedHeight() (uint64, error) {
+	args := m.Called()
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+func (m *mockRepo) ParsedTxsInRange(fromHeight, toHeight uint64) ([]ParsedTx, error) {
+	args := m.Called(fromHeight, toHeight)
+	return args.Get(0).([]ParsedTx), args.Error(1)
+}
+
+func (m *mockRepo) SwapValues(fromHeight, toHeight uint64) (map[uint64]string, error) {
+	args := m.Called(fromHeight, toHeight)
+	return args.Get(0).(map[uint64]string), args.Error(1)
+}
+
+func (m *mockRepo) SaveAccountActivities(accounts []AccountActivity, pairs []AccountPairActivity, height uint64) error {
+	args := m.Called(accounts, pairs, height)
+	return args.Error(0)
+}
+
+func Test_UpdateAccountActivities(t *testing.T) {
+	repo := mockRepo{nil, &mock.Mock{}}
+	dexIndexer := dexIndexer{pkg.NetworkMetadata{}, &repo, "chainId", nil}
+
+	txs := []ParsedTx{
+		{ID: 1, ChainId: "chainId", Height: 11, Timestamp: 100, Sender: "user1", Type: Provide, Address: "pair1", Asset0Amount: "100", Asset1Amount: "200", LpAmount: "141"},
+		{ID: 2, ChainId: "chainId", Height: 12, Timestamp: 105, Sender: "user1", Type: Swap, Address: "pair1", Asset0Amount: "10", Asset1Amount: "-19"},
+		{ID: 3, ChainId: "chainId", Height: 12, Timestamp: 105, Sender: "user2", Type: Swap, Address: "pair2", Asset0Amount: "-5", Asset1Amount: "7"},
+		{ID: 4, ChainId: "chainId", Height: 13, Timestamp: 110, Sender: "user1", Type: Swap, Address: "pair2", Asset0Amount: "-1", Asset1Amount: "2"},
+		{ID: 5, ChainId: "chainId", Height: 14, Timestamp: 115, Sender: "user1", Type: Withdraw, Address: "pair1", Asset0Amount: "-50", Asset1Amount: "-100", LpAmount: "70"},
+		// transfers are not an activity of the account
+		{ID: 6, ChainId: "chainId", Height: 14, Timestamp: 115, Sender: "user3", Type: "transfer", Address: "pair1", Asset0Amount: "1"},
+	}
+	// the swap of tx 4 has no priced asset
+	values := map[uint64]string{2: "1.5", 3: "0.25"}
+	expectedAccounts := []AccountActivity{
+		{ChainId: "chainId", Address: "user1", SwapCount: 2, ProvideCount: 1, WithdrawCount: 1, Volume: "1.500000000000000000", FirstSeenHeight: 11, FirstSeenAt: 100, LastSeenHeight: 14, LastSeenAt: 115},
+		{ChainId: "chainId", Address: "user2", SwapCount: 1, Volume: "0.250000000000000000", FirstSeenHeight: 12, FirstSeenAt: 105, LastSeenHeight: 12, LastSeenAt: 105},
+	}
+	expectedPairs := []AccountPairActivity{
+		{
+			AccountActivity: AccountActivity{ChainId: "chainId", Address: "user1", SwapCount: 1, ProvideCount: 1, WithdrawCount: 1, Volume: "1.500000000000000000", FirstSeenHeight: 11, FirstSeenAt: 100, LastSeenHeight: 14, LastSeenAt: 115},
+			Pair:            "pair1", Asset0Volume: "10", Asset1Volume: "19",
+		},
+		{
+			AccountActivity: AccountActivity{ChainId: "chainId", Address: "user2", SwapCount: 1, Volume: "0.250000000000000000", FirstSeenHeight: 12, FirstSeenAt: 105, LastSeenHeight: 12, LastSeenAt: 105},
+			Pair:            "pair2", Asset0Volume: "5", Asset1Volume: "7",
+		},
+		{
+			AccountActivity: AccountActivity{ChainId: "chainId", Address: "user1", SwapCount: 1, Volume: "0.000000000000000000", FirstSeenHeight: 13, FirstSeenAt: 110, LastSeenHeight: 13, LastSeenAt: 110},
+			Pair:            "pair2", Asset0Volume: "1", Asset1Volume: "2",
+		},
+	}
+
+	assert := assert.New(t)
+
+	repo.On("IndexedHeight", AccountActivityJob).Return(uint64(10), nil).Once()
+	repo.On("SyncedHeight").Return(uint64(20), nil).Once()
+	repo.On("ParsedTxsInRange", uint64(10), uint64(20)).Return(txs, nil).Once()
+	repo.On("SwapValues", uint64(10), uint64(20)).Return(values, nil).Once()
+	repo.On("SaveAccountActivities", expectedAccounts, expectedPairs, uint64(20)).Return(nil).Once()
+	assert.NoError(dexIndexer.UpdateAccountActivities())
+
+	// nothing to index
+	repo.On("IndexedHeight", AccountActivityJob).Return(uint64(20), nil).Once()
+	repo.On("SyncedHeight").Return(uint64(20), nil).Once()
+	assert.NoError(dexIndexer.UpdateAccountActivities())
+
+	// a run is limited to accountActivityHeightRange
+	repo.On("IndexedHeight", AccountActivityJob).Return(uint64(20), nil).Once()
+	repo.On("SyncedHeight").Return(uint64(20+accountActivityHeightRange+1), nil).Once()
+	repo.On("ParsedTxsInRange", uint64(20), uint64(20+accountActivityHeightRange)).Return([]ParsedTx{}, nil).Once()
+	repo.On("SwapValues", uint64(20), uint64(20+accountActivityHeightRange)).Return(map[uint64]string{}, nil).Once()
+	repo.On("SaveAccountActivities", []AccountActivity{}, []AccountPairActivity{}, uint64(20+accountActivityHeightRange)).Return(nil).Once()
+	assert.NoError(dexIndexer.UpdateAccountActivities())
+
+	repo.AssertExpectations(t)
+}
+
+func (m *mockRepo) LpPositions(addrs []string) ([]LpPosition, error) {
+	args := m.Called(addrs)
+	return args.Get(0).([]LpPosition), args.Error(1)
+}
+
+func (m *mockRepo) SaveLpPositions(positions []LpPosition, height uint64) error {
+	args := m.Called(positions, height)
+	return args.Error(0)
+}
+
+func Test_UpdateLpPositions(t *testing.T) {
+	repo := mockRepo{nil, &mock.Mock{}}
+	dexIndexer := dexIndexer{pkg.NetworkMetadata{}, &repo, "chainId", nil}
+
+	txs := []ParsedTx{
+		{ChainId: "chainId", Height: 11, Timestamp: 100, Sender: "user1", Type: Provide, Address: "pair1", Asset0: "a0", Asset0Amount: "100", Asset1: "a1", Asset1Amount: "200", Lp: "lp1", LpAmount: "100"},
+		{ChainId: "chainId", Height: 12, Timestamp: 105, Sender: "user1", Type: Swap, Address: "pair1", Asset0: "a0", Asset0Amount: "10", Asset1: "a1", Asset1Amount: "-19"},
+		{ChainId: "chainId", Height: 13, Timestamp: 110, Sender: "user1", Type: Withdraw, Address: "pair1", Asset0: "a0", Asset0Amount: "-30", Asset1: "a1", Asset1Amount: "-55", Lp: "lp1", LpAmount: "25"},
+		{ChainId: "chainId", Height: 13, Timestamp: 110, Sender: "user2", Type: Withdraw, Address: "pair2", Asset0: "b0", Asset0Amount: "-10", Asset1: "b1", Asset1Amount: "-10", Lp: "lp2", LpAmount: "50"},
+	}
+	existing := []LpPosition{
+		{ChainId: "chainId", Address: "user2", Pair: "pair2", Lp: "lp2", LpAmount: "200", Asset0: "b0", Asset0CostBasis: "30", Asset1: "b1", Asset1CostBasis: "41", Height: 5, Timestamp: 50},
+	}
+	expected := []LpPosition{
+		{ChainId: "chainId", Address: "user1", Pair: "pair1", Lp: "lp1", LpAmount: "75", Asset0: "a0", Asset0CostBasis: "75", Asset1: "a1", Asset1CostBasis: "150", Height: 13, Timestamp: 110},
+		// cost basis is reduced by a quarter, rounded down
+		{ChainId: "chainId", Address: "user2", Pair: "pair2", Lp: "lp2", LpAmount: "150", Asset0: "b0", Asset0CostBasis: "23", Asset1: "b1", Asset1CostBasis: "31", Height: 13, Timestamp: 110},
+	}
+
+	repo.On("IndexedHeight", LpPositionJob).Return(uint64(10), nil).Once()
+	repo.On("SyncedHeight").Return(uint64(20), nil).Once()
+	repo.On("ParsedTxsInRange", uint64(10), uint64(20)).Return(txs, nil).Once()
+	repo.On("LpPositions", []string{"user1", "user2"}).Return(existing, nil).Once()
+	repo.On("SaveLpPositions", expected, uint64(20)).Return(nil).Once()
+	assert.NoError(t, dexIndexer.UpdateLpPositions())
+	repo.AssertExpectations(t)
+}
+
+func Test_applyLiquidityTxs_WithdrawMoreThanPosition(t *testing.T) {
+	positions := []LpPosition{{ChainId: "chainId", Address: "user1", Pair: "pair1", Lp: "lp1", LpAmount: "10", Asset0CostBasis: "10", Asset1CostBasis: "10"}}
+	txs := []ParsedTx{{ChainId: "chainId", Height: 2, Sender: "user1", Type: Withdraw, Address: "pair1", Asset0: "a0", Asset0Amount: "-20", Asset1: "a1", Asset1Amount: "-20", Lp: "lp1", LpAmount: "20"}}
+
+	actual, err := applyLiquidityTxs(positions, txs)
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, "0", actual[0].LpAmount)
+	assert.Equal(t, "0", actual[0].Asset0CostBasis)
+	assert.Equal(t, "0", actual[0].Asset1CostBasis)
+}
+
+func (m *mockRepo) TokenAddresses(cond db.LastIdLimitCondition) ([]string, error) {
+	args := m.Called(cond)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockRepo) LatestHeightFromNode() (uint64, error) {
+	args := m.Called()
+	return args.Get(0).(uint64), args.Error(1)
+}
+
+func (m *mockRepo) LatestTokenSupplies() ([]TokenSupply, error) {
+	args := m.Called()
+	return args.Get(0).([]TokenSupply), args.Error(1)
+}
+
+func (m *mockRepo) TokenSupplyFromNode(addr string, excludedHolders []string) (*TokenSupply, error) {
+	args := m.Called(addr, excludedHolders)
+	return args.Get(0).(*TokenSupply), args.Error(1)
+}
+
+func (m *mockRepo) SaveTokenSupplies(supplies []TokenSupply) error {
+	args := m.Called(supplies)
+	return args.Error(0)
+}
+
+func Test_UpdateTokenSupplies(t *testing.T) {
+	repo := mockRepo{nil, &mock.Mock{}}
+	exclusions := map[string][]string{"token2": {"treasury"}}
+	dexIndexer := dexIndexer{pkg.NetworkMetadata{}, &repo, "chainId", exclusions}
+
+	repo.On("TokenAddresses", db.LastIdLimitCondition{}).Return([]string{"token1", "token2", "token3"}, nil).Once()
+	repo.On("LatestHeightFromNode").Return(uint64(100), nil).Once()
+	repo.On("LatestTokenSupplies").Return([]TokenSupply{
+		{ChainId: "chainId", Address: "token1", Height: 50, TotalSupply: "1000", CirculatingSupply: "1000"},
+		{ChainId: "chainId", Address: "token2", Height: 50, TotalSupply: "1000", CirculatingSupply: "1000"},
+	}, nil).Once()
+	repo.On("TokenSupplyFromNode", "token1", []string(nil)).Return(&TokenSupply{ChainId: "chainId", Address: "token1", TotalSupply: "1000", CirculatingSupply: "1000"}, nil).Once()
+	repo.On("TokenSupplyFromNode", "token2", []string{"treasury"}).Return(&TokenSupply{ChainId: "chainId", Address: "token2", TotalSupply: "1000", CirculatingSupply: "400"}, nil).Once()
+	repo.On("TokenSupplyFromNode", "token3", []string(nil)).Return(&TokenSupply{ChainId: "chainId", Address: "token3", TotalSupply: "10", CirculatingSupply: "10"}, nil).Once()
+	repo.On("SaveTokenSupplies", []TokenSupply{
+		{ChainId: "chainId", Address: "token2", Height: 100, TotalSupply: "1000", CirculatingSupply: "400"},
+		{ChainId: "chainId", Address: "token3", Height: 100, TotalSupply: "10", CirculatingSupply: "10"},
+	}).Return(nil).Once()
+
+	assert.NoError(t, dexIndexer.UpdateTokenSupplies())
+	repo.AssertExpectations(t)
+
+	// the token failing on the node is skipped, the rest are saved
+	repo.On("TokenAddresses", db.LastIdLimitCondition{}).Return([]string{"token3", "token4"}, nil).Once()
+	repo.On("LatestHeightFromNode").Return(uint64(101), nil).Once()
+	repo.On("LatestTokenSupplies").Return([]TokenSupply{}, nil).Once()
+	repo.On("TokenSupplyFromNode", "token3", []string(nil)).Return((*TokenSupply)(nil), errors.New("node unavailable")).Once()
+	repo.On("TokenSupplyFromNode", "token4", []string(nil)).Return(&TokenSupply{ChainId: "chainId", Address: "token4", TotalSupply: "10", CirculatingSupply: "10"}, nil).Once()
+	repo.On("SaveTokenSupplies", []TokenSupply{
+		{ChainId: "chainId", Address: "token4", Height: 101, TotalSupply: "10", CirculatingSupply: "10"},
+	}).Return(nil).Once()
+
+	err := dexIndexer.UpdateTokenSupplies()
+	var skipped *SkippedError
+	assert.ErrorAs(t, err, &skipped)
+	assert.ErrorContains(t, err, "token3")
+	repo.AssertExpectations(t)
+
+	// the job fails without any token
+	repo.On("TokenAddresses", db.LastIdLimitCondition{}).Return([]string{"token3"}, nil).Once()
+	repo.On("LatestHeightFromNode").Return(uint64(102), nil).Once()
+	repo.On("LatestTokenSupplies").Return([]TokenSupply{}, nil).Once()
+	repo.On("TokenSupplyFromNode", "token3", []string(nil)).Return((*TokenSupply)(nil), errors.New("node unavailable")).Once()
+
+	err = dexIndexer.UpdateTokenSupplies()
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &skipped))
+	repo.AssertExpectations(t)
+}
@@ -10,6 +10,8 @@ type NodeRepo interface {
 	LatestHeightFromNode() (uint64, error)
 	TokenFromNode(addr string) (*Token, error)
 	PoolFromNode(addr string, height uint64) (*PoolInfo, error)
+	// TokenSupplyFromNode returns the latest supply of the token, the circulating supply excludes balances of excludedHolders
+	TokenSupplyFromNode(addr string, excludedHolders []string) (*TokenSupply, error)
 }
 
 type DbRepo interface {
@@ -28,9 +30,26 @@ type DbRepo interface {
 	LatestPools() ([]PoolInfo, error)
 
 	ParsedTxs(height uint64) ([]ParsedTx, error)
+	// ParsedTxsInRange returns parsed txs of (fromHeight, toHeight] ordered by height
+	ParsedTxsInRange(fromHeight, toHeight uint64) ([]ParsedTx, error)
+	// SwapValues returns the values of the swaps of (fromHeight, toHeight] in the price token by the parsed tx id,
+	// a swap of which neither asset is priced has no value
+	SwapValues(fromHeight, toHeight uint64) (map[uint64]string, error)
+
+	IndexedHeight(job string) (uint64, error)
+
+	LpPositions(addrs []string) ([]LpPosition, error)
+
+	LatestTokenSupplies() ([]TokenSupply, error)
 
 	SaveLatestPools(pools []PoolInfo, height uint64) error
 	SaveTokens([]Token) error
+	// SaveAccountActivities accumulates the given activities and stores height as the indexed height of AccountActivityJob
+	SaveAccountActivities(accounts []AccountActivity, pairs []AccountPairActivity, height uint64) error
+	// SaveLpPositions overwrites the given positions and stores height as the indexed height of LpPositionJob
+	SaveLpPositions(positions []LpPosition, height uint64) error
+	// SaveTokenSupplies appends the supplies to the history
+	SaveTokenSupplies([]TokenSupply) error
 }
 
 type Repo interface {
@@ -40,7 +59,12 @@ type Repo interface {
 }
 
 type Indexer interface {
+	// BackfillIbcOrigins fills the IBC origin of the tokens indexed before the origin was, from the node
+	BackfillIbcOrigins() error
 	UpdateVerifiedTokens() error
 	UpdateTokens() error
 	UpdateLatestPools() error
+	UpdateAccountActivities() error
+	UpdateLpPositions() error
+	UpdateTokenSupplies() error
 }
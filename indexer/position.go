@@ -0,0 +1,173 @@
+package indexer
+
+import (
+	"cosmossdk.io/math"
+	"github.com/pkg/errors"
+)
+
+// lpPositionHeightRange limits the number of heights processed in a single run
+const lpPositionHeightRange = 10_000
+
+// UpdateLpPositions implements Indexer
+//
+// Positions are built from provide and withdraw txs of the sender.
+// The parser only records LP transfers from and to pairs, which are the withdraws themselves,
+// so LP tokens moved between wallets are not reflected.
+func (d *dexIndexer) UpdateLpPositions() error {
+	fromHeight, toHeight, err := d.nextHeightRange(LpPositionJob, lpPositionHeightRange)
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateLpPositions")
+	}
+	if fromHeight == toHeight {
+		return nil
+	}
+
+	txs, err := d.repo.ParsedTxsInRange(fromHeight, toHeight)
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateLpPositions")
+	}
+
+	liquidityTxs := []ParsedTx{}
+	senders := []string{}
+	seen := make(map[string]bool)
+	for _, tx := range txs {
+		if tx.Sender == "" || (tx.Type != Provide && tx.Type != Withdraw) {
+			continue
+		}
+		liquidityTxs = append(liquidityTxs, tx)
+		if !seen[tx.Sender] {
+			seen[tx.Sender] = true
+			senders = append(senders, tx.Sender)
+		}
+	}
+
+	positions, err := d.repo.LpPositions(senders)
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateLpPositions")
+	}
+
+	updated, err := applyLiquidityTxs(positions, liquidityTxs)
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateLpPositions")
+	}
+
+	if err := d.repo.SaveLpPositions(updated, toHeight); err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateLpPositions")
+	}
+	return nil
+}
+
+type lpPositionKey struct {
+	address string
+	pair    string
+}
+
+type lpPositionState struct {
+	LpPosition
+	lpAmount math.Int
+	cost0    math.Int
+	cost1    math.Int
+}
+
+// applyLiquidityTxs applies provide and withdraw txs to the positions in order and returns the positions changed by them.
+func applyLiquidityTxs(positions []LpPosition, txs []ParsedTx) ([]LpPosition, error) {
+	states := make(map[lpPositionKey]*lpPositionState)
+	for _, p := range positions {
+		state, err := newLpPositionState(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "applyLiquidityTxs: position(%s, %s)", p.Address, p.Pair)
+		}
+		states[lpPositionKey{p.Address, p.Pair}] = state
+	}
+
+	updatedKeys := []lpPositionKey{}
+	updated := make(map[lpPositionKey]bool)
+	for _, tx := range txs {
+		key := lpPositionKey{tx.Sender, tx.Address}
+		state, ok := states[key]
+		if !ok {
+			state = &lpPositionState{
+				LpPosition: LpPosition{ChainId: tx.ChainId, Address: tx.Sender, Pair: tx.Address},
+				lpAmount:   math.ZeroInt(),
+				cost0:      math.ZeroInt(),
+				cost1:      math.ZeroInt(),
+			}
+			states[key] = state
+		}
+		if err := state.apply(tx); err != nil {
+			return nil, errors.Wrapf(err, "applyLiquidityTxs: tx(%s)", tx.Hash)
+		}
+		if !updated[key] {
+			updated[key] = true
+			updatedKeys = append(updatedKeys, key)
+		}
+	}
+
+	res := make([]LpPosition, 0, len(updatedKeys))
+	for _, key := range updatedKeys {
+		state := states[key]
+		state.LpAmount = state.lpAmount.String()
+		state.Asset0CostBasis = state.cost0.String()
+		state.Asset1CostBasis = state.cost1.String()
+		res = append(res, state.LpPosition)
+	}
+	return res, nil
+}
+
+func newLpPositionState(p LpPosition) (*lpPositionState, error) {
+	lpAmount, err := absAmount(p.LpAmount)
+	if err != nil {
+		return nil, err
+	}
+	cost0, err := absAmount(p.Asset0CostBasis)
+	if err != nil {
+		return nil, err
+	}
+	cost1, err := absAmount(p.Asset1CostBasis)
+	if err != nil {
+		return nil, err
+	}
+	return &lpPositionState{p, lpAmount, cost0, cost1}, nil
+}
+
+func (s *lpPositionState) apply(tx ParsedTx) error {
+	share, err := absAmount(tx.LpAmount)
+	if err != nil {
+		return err
+	}
+	amount0, err := absAmount(tx.Asset0Amount)
+	if err != nil {
+		return err
+	}
+	amount1, err := absAmount(tx.Asset1Amount)
+	if err != nil {
+		return err
+	}
+
+	switch tx.Type {
+	case Provide:
+		s.lpAmount = s.lpAmount.Add(share)
+		s.cost0 = s.cost0.Add(amount0)
+		s.cost1 = s.cost1.Add(amount1)
+	case Withdraw:
+		// LP received from other wallets is not tracked, so the whole position is closed
+		if share.GTE(s.lpAmount) {
+			s.lpAmount = math.ZeroInt()
+			s.cost0 = math.ZeroInt()
+			s.cost1 = math.ZeroInt()
+			break
+		}
+		s.cost0 = s.cost0.Sub(s.cost0.Mul(share).Quo(s.lpAmount))
+		s.cost1 = s.cost1.Sub(s.cost1.Mul(share).Quo(s.lpAmount))
+		s.lpAmount = s.lpAmount.Sub(share)
+	}
+
+	if tx.Lp != "" {
+		s.Lp = tx.Lp
+	}
+	s.Asset0 = tx.Asset0
+	s.Asset1 = tx.Asset1
+	s.Height = tx.Height
+	s.Timestamp = tx.Timestamp
+	return nil
+}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dezswap/dezswap-api/indexer"
 	"github.com/dezswap/dezswap-api/pkg"
@@ -68,6 +69,16 @@ func (*assetMapperImpl) IbcsResToTokens(res types.IbcResMap, chainId string) []i
 		if v.Decimals != nil {
 			token.Decimals = *v.Decimals
 		}
+		if v.BaseDenom != nil {
+			token.IbcBaseDenom = *v.BaseDenom
+		}
+		if v.Path != nil {
+			// the path may end with the base denom, keep only the hops
+			token.IbcPath = strings.TrimSuffix(*v.Path, "/"+token.IbcBaseDenom)
+		}
+		if v.OriginChain != nil {
+			token.IbcOriginChain = *v.OriginChain
+		}
 		tokens = append(tokens, token)
 	}
 	return tokens
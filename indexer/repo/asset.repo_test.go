@@ -31,6 +31,8 @@ func (s *assetRepoSuite) SetupSuite() {
 		0,
 		"",
 		"",
+		"",
+		"",
 	)
 	s.r = assetRepoImpl{s.client, &assetMapperImpl{}, s.networkMetadata}
 }
@@ -87,6 +89,43 @@ func (s *assetRepoSuite) Test_VerifiedTokens() {
 			},
 			err: nil,
 		},
+		{
+			chainID: "cube_47-5",
+			expected: []indexer.Token{
+				{
+					Address:        "ibc/ABCD",
+					ChainId:        "cube_47-5",
+					Symbol:         "USDC",
+					Name:           "USD Coin",
+					Decimals:       6,
+					Verified:       true,
+					IbcPath:        "transfer/channel-3",
+					IbcBaseDenom:   "uusdc",
+					IbcOriginChain: "axelar",
+				},
+			},
+			verifiedCw20sResult: &types.TokensRes{
+				Mainnet: types.TokenResMap{}, Testnet: types.TokenResMap{},
+			},
+			verifiedIbcsResult: &types.IbcsRes{
+				Mainnet: types.IbcResMap{},
+				Testnet: types.IbcResMap{
+					"ABCD": types.IbcRes{
+						Denom:       strPtr("ibc/ABCD"),
+						Path:        strPtr("transfer/channel-3/uusdc"),
+						BaseDenom:   strPtr("uusdc"),
+						Symbol:      strPtr("USDC"),
+						Name:        strPtr("USD Coin"),
+						Decimals:    u8Ptr(6),
+						OriginChain: strPtr("axelar"),
+					},
+				},
+			},
+			verifiedErc20sResult: &types.TokensRes{
+				Mainnet: types.TokenResMap{}, Testnet: types.TokenResMap{},
+			},
+			err: nil,
+		},
 		{
 			chainID:              "cube_47-5",
 			expected:             []indexer.Token{},
@@ -129,6 +168,8 @@ func Test_NewAssetRepo(t *testing.T) {
 		0,
 		"xpla1abcd",
 		"xpla1efgh",
+		"",
+		"",
 	)
 
 	t.Run("success with valid factory address", func(t *testing.T) {
@@ -145,7 +186,7 @@ func Test_NewAssetRepo(t *testing.T) {
 	})
 
 	t.Run("error with unsupported network", func(t *testing.T) {
-		unsupportedMetadata := pkg.NewNetworkMetadata("unsupported", "mainprefix", "testprefix", "uns1", map[types.TokenType]string{}, 5, 0, "mainfactory", "testfactory")
+		unsupportedMetadata := pkg.NewNetworkMetadata("unsupported", "mainprefix", "testprefix", "uns1", map[types.TokenType]string{}, 5, 0, "mainfactory", "testfactory", "", "")
 		repo, err := NewAssetRepo(unsupportedMetadata, "testprefix", "testfactory")
 		assert.Error(t, err)
 		assert.Equal(t, pkg.ErrUnsupportedNetwork, err)
@@ -29,6 +29,15 @@ type dbMapper interface {
 
 	poolToPoolModel(p indexer.PoolInfo, height uint64) (indexer_db.LatestPool, error)
 	poolsToPoolModels(ps []indexer.PoolInfo, height uint64) ([]indexer_db.LatestPool, error)
+
+	accountActivitiesToModels(activities []indexer.AccountActivity) ([]indexer_db.AccountActivity, error)
+	accountPairActivitiesToModels(activities []indexer.AccountPairActivity) ([]indexer_db.AccountPairActivity, error)
+
+	lpPositionModelsToLpPositions(models []indexer_db.LpPosition) ([]indexer.LpPosition, error)
+	lpPositionsToModels(positions []indexer.LpPosition) ([]indexer_db.LpPosition, error)
+
+	tokenSupplyModelsToTokenSupplies(models []indexer_db.TokenSupply) ([]indexer.TokenSupply, error)
+	tokenSuppliesToModels(supplies []indexer.TokenSupply) ([]indexer_db.TokenSupply, error)
 }
 
 var _ dbMapper = &dbMapperImpl{}
@@ -47,6 +56,10 @@ func (m *dbMapperImpl) tokenToModel(token indexer.Token) (indexer_db.Token, erro
 		Icon:     token.Icon,
 		Verified: token.Verified,
 		Decimals: token.Decimals,
+
+		IbcPath:        token.IbcPath,
+		IbcBaseDenom:   token.IbcBaseDenom,
+		IbcOriginChain: token.IbcOriginChain,
 		ChainModel: indexer_db.ChainModel{
 			ChainId: token.ChainId,
 			Address: token.Address,
@@ -109,6 +122,10 @@ func (*dbMapperImpl) tokenModelToToken(token indexer_db.Token) (indexer.Token, e
 		Decimals: token.Decimals,
 		Icon:     token.Icon,
 		Verified: token.Verified,
+
+		IbcPath:        token.IbcPath,
+		IbcBaseDenom:   token.IbcBaseDenom,
+		IbcOriginChain: token.IbcOriginChain,
 	}, nil
 }
 
@@ -234,3 +251,124 @@ func (d *dbMapperImpl) parserPairsToPairs(sourcePairs []parser.Pair) ([]indexer.
 	}
 	return pairs, nil
 }
+
+// accountActivitiesToModels implements dbMapper
+func (m *dbMapperImpl) accountActivitiesToModels(activities []indexer.AccountActivity) ([]indexer_db.AccountActivity, error) {
+	models := make([]indexer_db.AccountActivity, len(activities))
+	for idx, a := range activities {
+		models[idx] = indexer_db.AccountActivity{
+			Model: &gorm.Model{},
+			ChainModel: indexer_db.ChainModel{
+				ChainId: a.ChainId,
+				Address: a.Address,
+			},
+			SwapCount:       a.SwapCount,
+			ProvideCount:    a.ProvideCount,
+			WithdrawCount:   a.WithdrawCount,
+			Volume:          a.Volume,
+			FirstSeenHeight: a.FirstSeenHeight,
+			FirstSeenAt:     a.FirstSeenAt,
+			LastSeenHeight:  a.LastSeenHeight,
+			LastSeenAt:      a.LastSeenAt,
+		}
+	}
+	return models, nil
+}
+
+// accountPairActivitiesToModels implements dbMapper
+func (m *dbMapperImpl) accountPairActivitiesToModels(activities []indexer.AccountPairActivity) ([]indexer_db.AccountPairActivity, error) {
+	models := make([]indexer_db.AccountPairActivity, len(activities))
+	for idx, a := range activities {
+		models[idx] = indexer_db.AccountPairActivity{
+			Model:           &gorm.Model{},
+			ChainId:         a.ChainId,
+			Address:         a.Address,
+			Pair:            a.Pair,
+			SwapCount:       a.SwapCount,
+			ProvideCount:    a.ProvideCount,
+			WithdrawCount:   a.WithdrawCount,
+			Asset0Volume:    a.Asset0Volume,
+			Asset1Volume:    a.Asset1Volume,
+			Volume:          a.Volume,
+			FirstSeenHeight: a.FirstSeenHeight,
+			FirstSeenAt:     a.FirstSeenAt,
+			LastSeenHeight:  a.LastSeenHeight,
+			LastSeenAt:      a.LastSeenAt,
+		}
+	}
+	return models, nil
+}
+
+// lpPositionModelsToLpPositions implements dbMapper
+func (m *dbMapperImpl) lpPositionModelsToLpPositions(models []indexer_db.LpPosition) ([]indexer.LpPosition, error) {
+	positions := make([]indexer.LpPosition, len(models))
+	for idx, p := range models {
+		positions[idx] = indexer.LpPosition{
+			ChainId:         p.ChainId,
+			Address:         p.Address,
+			Pair:            p.Pair,
+			Lp:              p.Lp,
+			LpAmount:        p.LpAmount,
+			Asset0:          p.Asset0,
+			Asset0CostBasis: p.Asset0CostBasis,
+			Asset1:          p.Asset1,
+			Asset1CostBasis: p.Asset1CostBasis,
+			Height:          p.Height,
+			Timestamp:       p.Timestamp,
+		}
+	}
+	return positions, nil
+}
+
+// lpPositionsToModels implements dbMapper
+func (m *dbMapperImpl) lpPositionsToModels(positions []indexer.LpPosition) ([]indexer_db.LpPosition, error) {
+	models := make([]indexer_db.LpPosition, len(positions))
+	for idx, p := range positions {
+		models[idx] = indexer_db.LpPosition{
+			Model:           &gorm.Model{},
+			ChainId:         p.ChainId,
+			Address:         p.Address,
+			Pair:            p.Pair,
+			Lp:              p.Lp,
+			LpAmount:        p.LpAmount,
+			Asset0:          p.Asset0,
+			Asset0CostBasis: p.Asset0CostBasis,
+			Asset1:          p.Asset1,
+			Asset1CostBasis: p.Asset1CostBasis,
+			Height:          p.Height,
+			Timestamp:       p.Timestamp,
+		}
+	}
+	return models, nil
+}
+
+// tokenSupplyModelsToTokenSupplies implements dbMapper
+func (m *dbMapperImpl) tokenSupplyModelsToTokenSupplies(models []indexer_db.TokenSupply) ([]indexer.TokenSupply, error) {
+	supplies := make([]indexer.TokenSupply, len(models))
+	for idx, s := range models {
+		supplies[idx] = indexer.TokenSupply{
+			ChainId:           s.ChainId,
+			Address:           s.Address,
+			Height:            s.Height,
+			TotalSupply:       s.TotalSupply,
+			CirculatingSupply: s.CirculatingSupply,
+		}
+	}
+	return supplies, nil
+}
+
+// tokenSuppliesToModels implements dbMapper
+func (m *dbMapperImpl) tokenSuppliesToModels(supplies []indexer.TokenSupply) ([]indexer_db.TokenSupply, error) {
+	models := make([]indexer_db.TokenSupply, len(supplies))
+	for idx, s := range supplies {
+		models[idx] = indexer_db.TokenSupply{
+			Model:             &gorm.Model{},
+			ChainId:           s.ChainId,
+			Address:           s.Address,
+			Height:            s.Height,
+			TotalSupply:       s.TotalSupply,
+			CirculatingSupply: s.CirculatingSupply,
+		}
+	}
+	return models, nil
+}
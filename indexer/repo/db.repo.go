@@ -113,6 +113,71 @@ func (r *dbRepoImpl) ParsedTxs(height uint64) ([]indexer.ParsedTx, error) {
 	return txs, nil
 }
 
+// ParsedTxsInRange implements indexer.DbRepo
+func (r *dbRepoImpl) ParsedTxsInRange(fromHeight, toHeight uint64) ([]indexer.ParsedTx, error) {
+	if fromHeight >= toHeight {
+		return nil, nil
+	}
+	condition := r.Where("height > ? and height <= ? and chain_id = ?", fromHeight, toHeight, r.chainId).Order("height, id").Omit("CreatedAt", "UpdatedAt", "DeletedAt")
+	sourceTxs := []parser.ParsedTx{}
+	if err := condition.Find(&sourceTxs).Error; err != nil {
+		return nil, errors.Wrap(err, "dbRepoImpl.ParsedTxsInRange")
+	}
+
+	txs, err := r.parserParsedTxsToParsedTxs(sourceTxs)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbRepoImpl.ParsedTxsInRange")
+	}
+
+	return txs, nil
+}
+
+// SwapValues implements indexer.DbRepo
+func (r *dbRepoImpl) SwapValues(fromHeight, toHeight uint64) (map[uint64]string, error) {
+	if fromHeight >= toHeight {
+		return nil, nil
+	}
+	// a swap is valued as the dashboard values it, by the latest price of an asset up to the tx
+	query := `
+select pt.id,
+       round(abs(case when pr0.price is not null
+                      then pr0.price * pt.asset0_amount / power(10, t0.decimals)
+                      else pr1.price * pt.asset1_amount / power(10, t1.decimals)
+                 end), 18)::text as value
+from parsed_tx pt
+    join tokens t0 on t0.chain_id = pt.chain_id and t0.address = pt.asset0
+    join tokens t1 on t1.chain_id = pt.chain_id and t1.address = pt.asset1
+    left join lateral (select price from price p where p.token_id = t0.id and p.tx_id <= pt.id order by p.tx_id desc limit 1) pr0 on true
+    left join lateral (select price from price p where p.token_id = t1.id and p.tx_id <= pt.id order by p.tx_id desc limit 1) pr1 on true
+where pt.chain_id = ?
+  and pt.type = 'swap'
+  and pt.height > ? and pt.height <= ?
+  and (pr0.price is not null or pr1.price is not null)
+`
+	rows := []struct {
+		Id    uint64
+		Value string
+	}{}
+	if err := r.Raw(query, r.chainId, fromHeight, toHeight).Scan(&rows).Error; err != nil {
+		return nil, errors.Wrap(err, "dbRepoImpl.SwapValues")
+	}
+
+	values := make(map[uint64]string, len(rows))
+	for _, row := range rows {
+		values[row.Id] = row.Value
+	}
+	return values, nil
+}
+
+// IndexedHeight implements indexer.DbRepo
+func (r *dbRepoImpl) IndexedHeight(job string) (uint64, error) {
+	height := indexer_db.IndexedHeight{}
+	if err := r.dest.Where("chain_id = ? and name = ?", r.chainId, job).Limit(1).Find(&height).Error; err != nil {
+		return 0, errors.Wrap(err, "dbRepoImpl.IndexedHeight")
+	}
+	return height.Height, nil
+}
+
 // Pool implements indexer.DbRepo
 func (r *dbRepoImpl) Pool(addr string, height uint64) (*indexer.PoolInfo, error) {
 	//gorm pool
@@ -218,6 +283,149 @@ func (r *dbRepoImpl) SaveTokens(tokens []indexer.Token) error {
 	return nil
 }
 
+// SaveAccountActivities implements indexer.DbRepo
+func (r *dbRepoImpl) SaveAccountActivities(accounts []indexer.AccountActivity, pairs []indexer.AccountPairActivity, height uint64) error {
+	accountModels, err := r.accountActivitiesToModels(accounts)
+	if err != nil {
+		return errors.Wrap(err, "dbRepoImpl.SaveAccountActivities")
+	}
+	pairModels, err := r.accountPairActivitiesToModels(pairs)
+	if err != nil {
+		return errors.Wrap(err, "dbRepoImpl.SaveAccountActivities")
+	}
+
+	counts := []string{"swap_count", "provide_count", "withdraw_count"}
+	err = r.dest.Transaction(func(tx *gorm.DB) error {
+		if len(accountModels) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "chain_id"}, {Name: "address"}},
+				DoUpdates: accumulateAssignments("account_activities", append(counts, "volume")),
+			}).Create(&accountModels).Error; err != nil {
+				return err
+			}
+		}
+		if len(pairModels) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "chain_id"}, {Name: "address"}, {Name: "pair"}},
+				DoUpdates: accumulateAssignments("account_pair_activities", append(counts, "asset0_volume", "asset1_volume", "volume")),
+			}).Create(&pairModels).Error; err != nil {
+				return err
+			}
+		}
+		return saveIndexedHeight(tx, r.chainId, indexer.AccountActivityJob, height)
+	})
+	if err != nil {
+		return errors.Wrap(err, "dbRepoImpl.SaveAccountActivities")
+	}
+
+	return nil
+}
+
+// LpPositions implements indexer.DbRepo
+func (r *dbRepoImpl) LpPositions(addrs []string) ([]indexer.LpPosition, error) {
+	if len(addrs) == 0 {
+		return nil, nil
+	}
+	models := []indexer_db.LpPosition{}
+	if err := r.dest.Where("chain_id = ? and address in ?", r.chainId, addrs).Omit("CreatedAt", "UpdatedAt", "DeletedAt").Find(&models).Error; err != nil {
+		return nil, errors.Wrap(err, "dbRepoImpl.LpPositions")
+	}
+
+	positions, err := r.lpPositionModelsToLpPositions(models)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbRepoImpl.LpPositions")
+	}
+	return positions, nil
+}
+
+// SaveLpPositions implements indexer.DbRepo
+func (r *dbRepoImpl) SaveLpPositions(positions []indexer.LpPosition, height uint64) error {
+	models, err := r.lpPositionsToModels(positions)
+	if err != nil {
+		return errors.Wrap(err, "dbRepoImpl.SaveLpPositions")
+	}
+
+	err = r.dest.Transaction(func(tx *gorm.DB) error {
+		if len(models) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "chain_id"}, {Name: "address"}, {Name: "pair"}},
+				DoUpdates: clause.AssignmentColumns([]string{
+					"lp", "lp_amount", "asset0", "asset0_cost_basis", "asset1", "asset1_cost_basis", "height", "timestamp", "updated_at",
+				}),
+			}).Create(&models).Error; err != nil {
+				return err
+			}
+		}
+		return saveIndexedHeight(tx, r.chainId, indexer.LpPositionJob, height)
+	})
+	if err != nil {
+		return errors.Wrap(err, "dbRepoImpl.SaveLpPositions")
+	}
+
+	return nil
+}
+
+// LatestTokenSupplies implements indexer.DbRepo
+func (r *dbRepoImpl) LatestTokenSupplies() ([]indexer.TokenSupply, error) {
+	models := []indexer_db.TokenSupply{}
+	if err := r.dest.Model(&indexer_db.TokenSupply{}).
+		Select("DISTINCT ON (address) *").
+		Where("chain_id = ?", r.chainId).
+		Order("address, height desc").
+		Find(&models).Error; err != nil {
+		return nil, errors.Wrap(err, "dbRepoImpl.LatestTokenSupplies")
+	}
+
+	supplies, err := r.tokenSupplyModelsToTokenSupplies(models)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbRepoImpl.LatestTokenSupplies")
+	}
+	return supplies, nil
+}
+
+// SaveTokenSupplies implements indexer.DbRepo
+func (r *dbRepoImpl) SaveTokenSupplies(supplies []indexer.TokenSupply) error {
+	if len(supplies) == 0 {
+		return nil
+	}
+	models, err := r.tokenSuppliesToModels(supplies)
+	if err != nil {
+		return errors.Wrap(err, "dbRepoImpl.SaveTokenSupplies")
+	}
+	if err := r.dest.Create(&models).Error; err != nil {
+		return errors.Wrap(err, "dbRepoImpl.SaveTokenSupplies")
+	}
+	return nil
+}
+
+// accumulateAssignments adds up the given columns and widens the seen range of an existing activity row
+func accumulateAssignments(table string, sumColumns []string) clause.Set {
+	assignments := map[string]interface{}{
+		"first_seen_height": gorm.Expr(fmt.Sprintf("LEAST(%s.first_seen_height, excluded.first_seen_height)", table)),
+		"first_seen_at":     gorm.Expr(fmt.Sprintf("LEAST(%s.first_seen_at, excluded.first_seen_at)", table)),
+		"last_seen_height":  gorm.Expr(fmt.Sprintf("GREATEST(%s.last_seen_height, excluded.last_seen_height)", table)),
+		"last_seen_at":      gorm.Expr(fmt.Sprintf("GREATEST(%s.last_seen_at, excluded.last_seen_at)", table)),
+		"updated_at":        gorm.Expr("excluded.updated_at"),
+	}
+	for _, c := range sumColumns {
+		assignments[c] = gorm.Expr(fmt.Sprintf("%s.%s + excluded.%s", table, c, c))
+	}
+	return clause.Assignments(assignments)
+}
+
+func saveIndexedHeight(tx *gorm.DB, chainId string, job string, height uint64) error {
+	model := indexer_db.IndexedHeight{
+		Model:   &gorm.Model{},
+		ChainId: chainId,
+		Name:    job,
+		Height:  height,
+	}
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "chain_id"}, {Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"height", "updated_at"}),
+	}).Create(&model).Error
+}
+
 // SyncedHeight implements indexer.DbRepo
 func (r *dbRepoImpl) SyncedHeight() (uint64, error) {
 	height := parser.SyncedHeight{}
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"encoding/json"
+	"strings"
 
 	ibc_types "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	"github.com/dezswap/dezswap-api/indexer"
@@ -13,6 +14,8 @@ import (
 type nodeMapper interface {
 	resToToken(addr, chainId string, data []byte) (*indexer.Token, error)
 	resToPoolInfo(addr, chainId string, height uint64, data []byte) (*indexer.PoolInfo, error)
+	resToTotalSupply(data []byte) (string, error)
+	resToBalance(data []byte) (string, error)
 
 	denomTraceToToken(addr, chainId string, trace *ibc_types.Denom) (*indexer.Token, error)
 }
@@ -54,8 +57,30 @@ func (*nodeMapperImpl) resToToken(addr, chainId string, data []byte) (*indexer.T
 	}, nil
 }
 
+// resToTotalSupply implements nodeMapper
+func (*nodeMapperImpl) resToTotalSupply(data []byte) (string, error) {
+	res := dezswap.TokenInfoRes{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return "", errors.Wrap(err, "nodeMapperImpl.resToTotalSupply")
+	}
+	return res.TotalSupply, nil
+}
+
+// resToBalance implements nodeMapper
+func (*nodeMapperImpl) resToBalance(data []byte) (string, error) {
+	res := dezswap.BalanceRes{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return "", errors.Wrap(err, "nodeMapperImpl.resToBalance")
+	}
+	return res.Balance, nil
+}
+
 // denomTraceToToken implements nodeMapper
 func (*nodeMapperImpl) denomTraceToToken(addr, chainId string, trace *ibc_types.Denom) (*indexer.Token, error) {
+	hops := make([]string, len(trace.Trace))
+	for i, h := range trace.Trace {
+		hops[i] = h.String()
+	}
 	return &indexer.Token{
 		Name:    trace.Base,
 		Symbol:  trace.Base,
@@ -63,5 +88,8 @@ func (*nodeMapperImpl) denomTraceToToken(addr, chainId string, trace *ibc_types.
 		ChainId: chainId,
 
 		Decimals: 18,
+
+		IbcPath:      strings.Join(hops, "/"),
+		IbcBaseDenom: trace.Base,
 	}, nil
 }
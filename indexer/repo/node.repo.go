@@ -3,6 +3,8 @@ package repo
 import (
 	"context"
 
+	"cosmossdk.io/math"
+
 	ibc_types "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	"github.com/dezswap/dezswap-api/indexer"
 	"github.com/dezswap/dezswap-api/pkg"
@@ -207,3 +209,139 @@ func (r *nodeRepoImpl) erc20FromNode(addr string) (*indexer.Token, error) {
 		Decimals: erc20Meta.Decimals,
 	}, nil
 }
+
+// TokenSupplyFromNode implements NodeRepo
+func (r *nodeRepoImpl) TokenSupplyFromNode(addr string, excludedHolders []string) (*indexer.TokenSupply, error) {
+	var supply math.Int
+	var err error
+
+	if r.IsCw20(addr) {
+		supply, err = r.cw20SupplyFromNode(addr)
+	} else if r.IsErc20(addr) {
+		supply, err = r.erc20SupplyFromNode(addr)
+	} else {
+		supply, err = r.bankSupplyFromNode(addr)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "nodeRepoImpl.TokenSupplyFromNode")
+	}
+
+	circulating := supply
+	for _, holder := range excludedHolders {
+		var balance math.Int
+		if r.IsCw20(addr) {
+			balance, err = r.cw20BalanceFromNode(addr, holder)
+		} else if r.IsErc20(addr) {
+			balance, err = r.erc20BalanceFromNode(addr, holder)
+		} else {
+			balance, err = r.bankBalanceFromNode(addr, holder)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "nodeRepoImpl.TokenSupplyFromNode")
+		}
+		circulating = circulating.Sub(balance)
+	}
+	if circulating.IsNegative() {
+		circulating = math.ZeroInt()
+	}
+
+	return &indexer.TokenSupply{
+		ChainId:           r.chainId,
+		Address:           addr,
+		TotalSupply:       supply.String(),
+		CirculatingSupply: circulating.String(),
+	}, nil
+}
+
+func (r *nodeRepoImpl) cw20SupplyFromNode(addr string) (math.Int, error) {
+	res, err := r.queryContractFromNode(r.TrimDenomPrefix(addr), dezswap.QUERY_TOKEN, r.LatestHeightIndicator)
+	if err != nil {
+		return math.Int{}, errors.Wrap(err, "nodeRepoImpl.cw20SupplyFromNode")
+	}
+	supply, err := r.resToTotalSupply(res)
+	if err != nil {
+		return math.Int{}, errors.Wrap(err, "nodeRepoImpl.cw20SupplyFromNode")
+	}
+	return parseAmount(supply)
+}
+
+func (r *nodeRepoImpl) cw20BalanceFromNode(addr string, holder string) (math.Int, error) {
+	res, err := r.queryContractFromNode(r.TrimDenomPrefix(addr), dezswap.QueryBalance(holder), r.LatestHeightIndicator)
+	if err != nil {
+		return math.Int{}, errors.Wrap(err, "nodeRepoImpl.cw20BalanceFromNode")
+	}
+	balance, err := r.resToBalance(res)
+	if err != nil {
+		return math.Int{}, errors.Wrap(err, "nodeRepoImpl.cw20BalanceFromNode")
+	}
+	return parseAmount(balance)
+}
+
+func (r *nodeRepoImpl) erc20SupplyFromNode(addr string) (math.Int, error) {
+	if r.EthClient == nil {
+		return math.Int{}, errors.Errorf("no rpc client supported on indexer for ETH address %s", addr)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pkg.NodeQueryTimeout)
+	defer cancel()
+
+	supply, err := r.QueryErc20TotalSupply(ctx, r.TrimDenomPrefix(addr))
+	if err != nil {
+		return math.Int{}, errors.Wrap(err, "nodeRepoImpl.erc20SupplyFromNode")
+	}
+	return math.NewIntFromBigInt(supply), nil
+}
+
+func (r *nodeRepoImpl) erc20BalanceFromNode(addr string, holder string) (math.Int, error) {
+	if r.EthClient == nil {
+		return math.Int{}, errors.Errorf("no rpc client supported on indexer for ETH address %s", addr)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pkg.NodeQueryTimeout)
+	defer cancel()
+
+	balance, err := r.QueryErc20Balance(ctx, r.TrimDenomPrefix(addr), holder)
+	if err != nil {
+		return math.Int{}, errors.Wrap(err, "nodeRepoImpl.erc20BalanceFromNode")
+	}
+	return math.NewIntFromBigInt(balance), nil
+}
+
+func (r *nodeRepoImpl) bankSupplyFromNode(denom string) (math.Int, error) {
+	var lastErr error
+	for _, client := range r.grpcClients {
+		supply, err := client.QuerySupplyOf(denom)
+		if err == nil {
+			return parseAmount(supply)
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return math.Int{}, errors.Wrap(lastErr, "nodeRepoImpl.bankSupplyFromNode")
+	}
+	return math.Int{}, errors.New("nodeRepoImpl.bankSupplyFromNode: grpc client is not configured")
+}
+
+func (r *nodeRepoImpl) bankBalanceFromNode(denom string, holder string) (math.Int, error) {
+	var lastErr error
+	for _, client := range r.grpcClients {
+		balance, err := client.QueryBalance(holder, denom)
+		if err == nil {
+			return parseAmount(balance)
+		}
+		lastErr = err
+	}
+	if lastErr != nil {
+		return math.Int{}, errors.Wrap(lastErr, "nodeRepoImpl.bankBalanceFromNode")
+	}
+	return math.Int{}, errors.New("nodeRepoImpl.bankBalanceFromNode: grpc client is not configured")
+}
+
+func parseAmount(amount string) (math.Int, error) {
+	if amount == "" {
+		return math.ZeroInt(), nil
+	}
+	v, ok := math.NewIntFromString(amount)
+	if !ok {
+		return math.Int{}, errors.Errorf("invalid amount(%s)", amount)
+	}
+	return v, nil
+}
@@ -35,6 +35,16 @@ func (m *nodeMapperMock) resToPoolInfo(addr, chainId string, height uint64, data
 	return poolInfo, args.Error(1)
 }
 
+func (m *nodeMapperMock) resToTotalSupply(data []byte) (string, error) {
+	args := m.Called(data)
+	return args.String(0), args.Error(1)
+}
+
+func (m *nodeMapperMock) resToBalance(data []byte) (string, error) {
+	args := m.Called(data)
+	return args.String(0), args.Error(1)
+}
+
 func (m *nodeMapperMock) denomTraceToToken(addr, chainId string, trace *ibc_types.Denom) (*indexer.Token, error) {
 	args := m.Called(addr, chainId, trace)
 	token, _ := args.Get(0).(*indexer.Token)
@@ -64,6 +74,8 @@ func (s *nodeRepoSuite) SetupSuite() {
 		0,
 		"",
 		"",
+		"",
+		"",
 	)
 	s.r = nodeRepoImpl{
 		EthClient:       s.ethClient,
@@ -231,6 +243,34 @@ func (s *nodeRepoSuite) Test_ibcFromNode_SelectsNextClientOnFailure() {
 	mapperMock.AssertExpectations(s.T())
 }
 
+func (s *nodeRepoSuite) Test_ibcFromNode_KeepsOrigin() {
+	client := xpla_mock.NewGrpcClientMock()
+	r := nodeRepoImpl{
+		grpcClients:     []pkg.GrpcClient{client},
+		nodeMapper:      &nodeMapperImpl{},
+		NetworkMetadata: s.networkMetadata,
+		chainId:         s.chainId,
+	}
+
+	const addr = "ibc/ABC"
+	trace := &ibc_types.Denom{
+		Base: "uusdc",
+		Trace: []ibc_types.Hop{
+			{PortId: "transfer", ChannelId: "channel-1"},
+			{PortId: "transfer", ChannelId: "channel-7"},
+		},
+	}
+	client.On("QueryIbcDenomTrace", addr).Return(trace, nil).Once()
+
+	token, err := r.ibcFromNode(addr)
+
+	s.Require().NoError(err)
+	s.Equal("transfer/channel-1/transfer/channel-7", token.IbcPath)
+	s.Equal("uusdc", token.IbcBaseDenom)
+	s.Empty(token.IbcOriginChain)
+	client.AssertExpectations(s.T())
+}
+
 func (s *nodeRepoSuite) Test_TokenFromNode() {
 	tcs := []struct {
 		inputAddr   string
@@ -354,6 +394,48 @@ func (s *nodeRepoSuite) Test_cw20FromNode() {
 	}
 }
 
+func (s *nodeRepoSuite) Test_TokenSupplyFromNode() {
+	client := xpla_mock.NewGrpcClientMock()
+	mapperMock := &nodeMapperMock{}
+	r := nodeRepoImpl{
+		EthClient:       s.ethClient,
+		grpcClients:     []pkg.GrpcClient{client},
+		nodeMapper:      mapperMock,
+		NetworkMetadata: s.networkMetadata,
+		chainId:         s.chainId,
+	}
+
+	s.Run("native denom excludes holders", func() {
+		client.On("QuerySupplyOf", "ibc/ABC").Return("1000", nil).Once()
+		client.On("QueryBalance", "xpla1treasury", "ibc/ABC").Return("300", nil).Once()
+		client.On("QueryBalance", "xpla1vesting", "ibc/ABC").Return("200", nil).Once()
+
+		supply, err := r.TokenSupplyFromNode("ibc/ABC", []string{"xpla1treasury", "xpla1vesting"})
+
+		s.Require().NoError(err)
+		s.Equal(indexer.TokenSupply{ChainId: s.chainId, Address: "ibc/ABC", TotalSupply: "1000", CirculatingSupply: "500"}, *supply)
+	})
+
+	s.Run("cw20 circulating supply is not negative", func() {
+		const addr = "xpla1token"
+		supplyRes := []byte(`{"total_supply":"100"}`)
+		balanceRes := []byte(`{"balance":"150"}`)
+		client.On("QueryContract", addr, dezswap.QUERY_TOKEN, s.networkMetadata.LatestHeightIndicator).Return(supplyRes, nil).Once()
+		client.On("QueryContract", addr, dezswap.QueryBalance("xpla1treasury"), s.networkMetadata.LatestHeightIndicator).Return(balanceRes, nil).Once()
+		mapperMock.On("resToTotalSupply", supplyRes).Return("100", nil).Once()
+		mapperMock.On("resToBalance", balanceRes).Return("150", nil).Once()
+
+		supply, err := r.TokenSupplyFromNode(addr, []string{"xpla1treasury"})
+
+		s.Require().NoError(err)
+		s.Equal("100", supply.TotalSupply)
+		s.Equal("0", supply.CirculatingSupply)
+	})
+
+	client.AssertExpectations(s.T())
+	mapperMock.AssertExpectations(s.T())
+}
+
 func Test_NodeRepo(t *testing.T) {
 	suite.Run(t, new(nodeRepoSuite))
 }
@@ -0,0 +1,59 @@
+package indexer
+
+import (
+	"github.com/dezswap/dezswap-api/pkg/db"
+	"github.com/pkg/errors"
+)
+
+// UpdateTokenSupplies implements Indexer
+func (d *dexIndexer) UpdateTokenSupplies() error {
+	tokens, err := d.repo.TokenAddresses(db.LastIdLimitCondition{})
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateTokenSupplies")
+	}
+
+	height, err := d.repo.LatestHeightFromNode()
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateTokenSupplies")
+	}
+
+	latestSupplies, err := d.repo.LatestTokenSupplies()
+	if err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateTokenSupplies")
+	}
+	supplyMap := make(map[string]TokenSupply)
+	for _, s := range latestSupplies {
+		supplyMap[s.Address] = s
+	}
+
+	changed := []TokenSupply{}
+	errs := []error{}
+	for _, addr := range tokens {
+		// a token failing on the node keeps its latest supply until the next run
+		supply, err := d.repo.TokenSupplyFromNode(addr, d.supplyExclusions[addr])
+		if err != nil {
+			errs = append(errs, errors.Wrap(err, addr))
+			continue
+		}
+
+		// keep the history small, only the changes are stored
+		if latest, ok := supplyMap[addr]; ok &&
+			latest.TotalSupply == supply.TotalSupply && latest.CirculatingSupply == supply.CirculatingSupply {
+			continue
+		}
+		supply.Height = height
+		changed = append(changed, *supply)
+	}
+
+	// nothing to save when the node itself fails, the job fails
+	if len(tokens) > 0 && len(errs) == len(tokens) {
+		return errors.Wrapf(errs[0], "dexIndexer.UpdateTokenSupplies: all %d tokens failed", len(errs))
+	}
+	if err := d.repo.SaveTokenSupplies(changed); err != nil {
+		return errors.Wrap(err, "dexIndexer.UpdateTokenSupplies")
+	}
+	if err := joinErrors(errs); err != nil {
+		return errors.Wrapf(err, "dexIndexer.UpdateTokenSupplies: %d tokens skipped", len(errs))
+	}
+	return nil
+}
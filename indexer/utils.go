@@ -1,5 +1,7 @@
 package indexer
 
+import "errors"
+
 type comparable interface {
 	Equal(comparable) bool
 }
@@ -7,3 +9,24 @@ type comparable interface {
 func isEqual(a, b comparable) bool {
 	return a.Equal(b)
 }
+
+// SkippedError is of the items a job skipped while it saved the rest, the job itself did not fail
+type SkippedError struct {
+	err error
+}
+
+func (e *SkippedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *SkippedError) Unwrap() error {
+	return e.err
+}
+
+// joinErrors combines the errors of the items a job skipped, nil without any
+func joinErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &SkippedError{errors.Join(errs...)}
+}
@@ -21,6 +21,33 @@ type Cache interface {
 	Delete(key string) error
 }
 
+// TokenBucket takes the requests from the token buckets and the quotas of the cache atomically,
+// the instances of the API sharing the cache take from the same buckets
+type TokenBucket interface {
+	TakeToken(take TokenTake, now time.Time) (TokenTaken, error)
+}
+
+// TokenTake takes a token from the bucket of BucketKey, of Burst tokens refilled by Rate a second, and counts it in the quota of QuotaKey.
+// There is no bucket without a rate or a burst, no quota without Quota.
+type TokenTake struct {
+	BucketKey string
+	Rate      float64
+	Burst     int
+	QuotaKey  string
+	Quota     int
+	// QuotaTTL expires the quota from its first take
+	QuotaTTL time.Duration
+}
+
+// TokenTaken is of a take, a take over the quota is not taken from the bucket
+type TokenTaken struct {
+	Allowed bool
+	// Tokens are left in the bucket
+	Tokens float64
+	// Used is of the quota with the take
+	Used int
+}
+
 type CacheLifeTime = time.Duration
 
 const (
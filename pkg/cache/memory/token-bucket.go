@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"math"
+	"time"
+
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/pkg/errors"
+)
+
+var _ cache.TokenBucket = &memoryCacheImpl{}
+
+type bucket struct {
+	Tokens  float64
+	Updated time.Time
+}
+
+// TakeToken implements cache.TokenBucket, the buckets are of the instance
+func (c *memoryCacheImpl) TakeToken(take cache.TokenTake, now time.Time) (cache.TokenTaken, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	res := cache.TokenTaken{Allowed: true}
+	quota, hasQuota := c.store[take.QuotaKey]
+	hasQuota = hasQuota && !expired(quota, now)
+	if take.Quota > 0 && hasQuota {
+		if err := c.codec.Decode(quota.Value.([]byte), &res.Used); err != nil {
+			return cache.TokenTaken{}, errors.Wrap(err, "memoryCacheImpl.TakeToken")
+		}
+		res.Allowed = res.Used < take.Quota
+	}
+
+	if take.Rate > 0 && take.Burst > 0 {
+		b := bucket{Tokens: float64(take.Burst), Updated: now}
+		if v, ok := c.store[take.BucketKey]; ok && !expired(v, now) {
+			// decoded into the zero value, the zero fields are not encoded
+			b = bucket{}
+			if err := c.codec.Decode(v.Value.([]byte), &b); err != nil {
+				return cache.TokenTaken{}, errors.Wrap(err, "memoryCacheImpl.TakeToken")
+			}
+		}
+		if elapsed := now.Sub(b.Updated).Seconds(); elapsed > 0 {
+			b.Tokens = math.Min(float64(take.Burst), b.Tokens+elapsed*take.Rate)
+			b.Updated = now
+		}
+		if res.Allowed {
+			res.Allowed = b.Tokens >= 1
+			if res.Allowed {
+				b.Tokens--
+			}
+		}
+		res.Tokens = b.Tokens
+		// the bucket left is as good as full once refilled
+		expireAt := now.Add(time.Duration((float64(take.Burst)-b.Tokens)/take.Rate*float64(time.Second)) + time.Second)
+		if err := c.put(take.BucketKey, b, &expireAt); err != nil {
+			return cache.TokenTaken{}, errors.Wrap(err, "memoryCacheImpl.TakeToken")
+		}
+	}
+
+	if take.Quota > 0 && res.Allowed {
+		res.Used++
+		expireAt := now.Add(take.QuotaTTL)
+		if hasQuota && quota.ExpireAt != nil {
+			expireAt = *quota.ExpireAt
+		}
+		if err := c.put(take.QuotaKey, res.Used, &expireAt); err != nil {
+			return cache.TokenTaken{}, errors.Wrap(err, "memoryCacheImpl.TakeToken")
+		}
+	}
+	return res, nil
+}
+
+// put stores the value under the lock of the caller
+func (c *memoryCacheImpl) put(key string, value interface{}, expireAt *time.Time) error {
+	bytes, err := c.codec.Encode(value)
+	if err != nil {
+		return err
+	}
+	c.store[key] = item{Value: bytes, ExpireAt: expireAt}
+	return nil
+}
+
+func expired(i item, now time.Time) bool {
+	return i.ExpireAt != nil && now.After(*i.ExpireAt)
+}
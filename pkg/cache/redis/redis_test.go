@@ -6,8 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/alicebob/miniredis/v2"
 	"github.com/dezswap/dezswap-api/pkg/cache"
 	"github.com/go-redis/redismock/v9"
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -80,3 +82,57 @@ func Test_cache(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func Test_TakeToken(t *testing.T) {
+	s := miniredis.RunT(t)
+	c := New(cache.NewByteCodec(), redis.NewClient(&redis.Options{Addr: s.Addr()})).(cache.TokenBucket)
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	take := cache.TokenTake{BucketKey: "bucket", Rate: 1, Burst: 2, QuotaKey: "quota", Quota: 3, QuotaTTL: time.Hour}
+
+	tcs := []struct {
+		elapsed  time.Duration
+		expected cache.TokenTaken
+	}{
+		{0, cache.TokenTaken{Allowed: true, Tokens: 1, Used: 1}},
+		{0, cache.TokenTaken{Allowed: true, Tokens: 0, Used: 2}},
+		// the refused requests are not counted
+		{0, cache.TokenTaken{Allowed: false, Tokens: 0, Used: 2}},
+		{time.Second, cache.TokenTaken{Allowed: true, Tokens: 0, Used: 3}},
+		// out of the quota, the bucket is refilled but not taken
+		{10 * time.Second, cache.TokenTaken{Allowed: false, Tokens: 2, Used: 3}},
+	}
+	for i, tc := range tcs {
+		now = now.Add(tc.elapsed)
+		taken, err := c.TakeToken(take, now)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, taken, "test case %d", i)
+	}
+	assert.Equal(t, time.Hour, s.TTL("quota"))
+	assert.True(t, s.TTL("bucket") > 0)
+}
+
+func Test_TakeTokenConcurrently(t *testing.T) {
+	s := miniredis.RunT(t)
+	c := New(cache.NewByteCodec(), redis.NewClient(&redis.Options{Addr: s.Addr()})).(cache.TokenBucket)
+	now := time.Now()
+	take := cache.TokenTake{BucketKey: "bucket", Rate: 0.001, Burst: 50}
+
+	wg := sync.WaitGroup{}
+	mu := sync.Mutex{}
+	allowed := 0
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			taken, err := c.TakeToken(take, now)
+			assert.NoError(t, err)
+			if taken.Allowed {
+				mu.Lock()
+				allowed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 50, allowed)
+}
@@ -0,0 +1,80 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/dezswap/dezswap-api/pkg/cache"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ cache.TokenBucket = &redisClient{}
+
+// takeTokenScript takes the token and counts the quota in one round trip, the script runs atomically in Redis.
+// KEYS are the bucket and the quota, ARGV are the rate, the burst, the time in seconds, the quota and the quota TTL in milliseconds.
+var takeTokenScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local quota = tonumber(ARGV[4])
+
+local allowed = true
+local used = 0
+if quota > 0 then
+  used = tonumber(redis.call('GET', KEYS[2]) or '0')
+  allowed = used < quota
+end
+
+local tokens = 0
+if rate > 0 and burst > 0 then
+  local b = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+  tokens = tonumber(b[1]) or burst
+  local updated = tonumber(b[2]) or now
+  if now > updated then
+    tokens = math.min(burst, tokens + (now - updated) * rate)
+    updated = now
+  end
+  if allowed then
+    allowed = tokens >= 1
+    if allowed then
+      tokens = tokens - 1
+    end
+  end
+  redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated', tostring(updated))
+  -- the bucket left is as good as full once refilled
+  redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) / rate * 1000) + 1000)
+end
+
+if quota > 0 and allowed then
+  used = redis.call('INCR', KEYS[2])
+  if used == 1 then
+    redis.call('PEXPIRE', KEYS[2], ARGV[5])
+  end
+end
+return {allowed and 1 or 0, tostring(tokens), used}
+`)
+
+// TakeToken implements cache.TokenBucket
+func (r *redisClient) TakeToken(take cache.TokenTake, now time.Time) (cache.TokenTaken, error) {
+	ctx := context.TODO()
+	res, err := takeTokenScript.Run(ctx, r.Client, []string{take.BucketKey, take.QuotaKey},
+		take.Rate, take.Burst, float64(now.UnixMicro())/1e6, take.Quota, take.QuotaTTL.Milliseconds(),
+	).Slice()
+	if err != nil {
+		return cache.TokenTaken{}, errors.Wrap(err, "redisClient.TakeToken")
+	}
+	if len(res) != 3 {
+		return cache.TokenTaken{}, errors.Errorf("redisClient.TakeToken: unexpected result(%v)", res)
+	}
+
+	allowed, _ := res[0].(int64)
+	tokensStr, _ := res[1].(string)
+	tokens, err := strconv.ParseFloat(tokensStr, 64)
+	if err != nil {
+		return cache.TokenTaken{}, errors.Wrap(err, "redisClient.TakeToken")
+	}
+	used, _ := res[2].(int64)
+	return cache.TokenTaken{Allowed: allowed == 1, Tokens: tokens, Used: int(used)}, nil
+}
@@ -88,15 +88,16 @@ func (c *client) VerifiedIbcs() (*types.IbcsRes, error) {
 				continue
 			}
 
-			baseDenom, path := getBaseDenomAndIbcPath(a)
+			baseDenom, path, originChain := getIbcOrigin(a)
 			converted.Mainnet[*a.Base] = types.IbcRes{
-				Denom:     a.Base,
-				Path:      path,
-				BaseDenom: baseDenom,
-				Symbol:    a.Symbol,
-				Name:      a.Name,
-				Icon:      getIcon(a),
-				Decimals:  getDecimals(a),
+				Denom:       a.Base,
+				Path:        path,
+				BaseDenom:   baseDenom,
+				Symbol:      a.Symbol,
+				Name:        a.Name,
+				Icon:        getIcon(a),
+				Decimals:    getDecimals(a),
+				OriginChain: originChain,
 			}
 		}
 	}
@@ -128,12 +129,13 @@ func getIcon(asset types.AssetRes) *string {
 	return icon
 }
 
-func getBaseDenomAndIbcPath(asset types.AssetRes) (*string, *string) {
+// getIbcOrigin returns the base denom, the ibc path and the counterparty chain name of the asset
+func getIbcOrigin(asset types.AssetRes) (*string, *string, *string) {
 	for _, t := range asset.Traces {
 		if t.Type != nil && (*t.Type == string(types.TraceTypeIbc) || *t.Type == string(types.TraceTypeIbcCw20)) {
-			return t.CounterParty.BaseDenom, t.Chain.Path
+			return t.CounterParty.BaseDenom, t.Chain.Path, t.CounterParty.ChainName
 		}
 	}
 
-	return nil, nil
+	return nil, nil, nil
 }
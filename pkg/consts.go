@@ -29,6 +29,9 @@ var (
 			0,
 			"xpla1j33xdql0h4kpgj2mhggy4vutw655u90z7nyj4afhxgj4v5urtadq44e3vd",
 			"xpla1j4kgjl6h4rt96uddtzdxdu39h0mhn4vrtydufdrk4uxxnrpsnw2qug2yx2",
+			// router addresses are left unregistered until they are confirmed on each network, api.router.contract sets them
+			"",
+			"",
 		),
 		NewNetworkMetadata(
 			NetworkNameAsiAlliance,
@@ -40,6 +43,8 @@ var (
 			0,
 			"fetch1slz6c85kxp4ek5ufmcakfhnscv9r2snlemxgwz6cjhklgh7v2hms8rgt5v",
 			"fetch1kmag3937lrl6dtsv29mlfsedzngl9egv5c3apnr468q50gu04zrqea398u",
+			"",
+			"",
 		),
 	}
 )
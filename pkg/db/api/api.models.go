@@ -17,3 +17,19 @@ type Notice struct {
 func (n *Notice) TableName() string {
 	return "notices"
 }
+
+// ApiKey is a key of the API with its limits, the zero limits follow the key tier of the config
+type ApiKey struct {
+	*gorm.Model
+	Name string `json:"name"`
+	// KeyHash is the hex SHA-256 of the key, the key itself is not stored
+	KeyHash    string  `json:"keyHash" gorm:"not null;uniqueIndex"`
+	Rate       float64 `json:"rate" gorm:"not null;default:0"`
+	Burst      int     `json:"burst" gorm:"not null;default:0"`
+	DailyQuota int     `json:"dailyQuota" gorm:"not null;default:0"`
+	Disabled   bool    `json:"disabled" gorm:"not null;default:false"`
+}
+
+func (k *ApiKey) TableName() string {
+	return "api_keys"
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"github.com/dezswap/dezswap-api/api/ratelimit"
+	"github.com/dezswap/dezswap-api/configs"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type apiKeyDbRepoImpl struct {
+	db *gorm.DB
+}
+
+func NewApiKeyDbRepo(db *gorm.DB) ratelimit.KeyRepo {
+	return &apiKeyDbRepoImpl{db}
+}
+
+// Key implements ratelimit.KeyRepo
+func (r *apiKeyDbRepoImpl) Key(hash string) (*ratelimit.Key, error) {
+	keys := []ApiKey{}
+	if err := r.db.Where("key_hash = ? and not disabled", hash).Limit(1).Find(&keys).Error; err != nil {
+		return nil, errors.Wrap(err, "apiKeyDbRepo.Key")
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	key := keys[0]
+	return &ratelimit.Key{
+		Id:   hash[:16],
+		Name: key.Name,
+		RateLimitTier: configs.RateLimitTier{
+			Rate:       key.Rate,
+			Burst:      key.Burst,
+			DailyQuota: key.DailyQuota,
+		},
+	}, nil
+}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/dezswap/dezswap-api/api/v1/service/depth"
+	"github.com/dezswap/dezswap-api/pkg"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type depthDbRepoImpl struct {
+	chainId string
+	db      *gorm.DB
+}
+
+type depthPool struct {
+	Address           string
+	Asset0            string
+	Asset0Amount      string
+	Asset1            string
+	Asset1Amount      string
+	Liquidity1InPrice *string
+	Height            uint64
+}
+
+func NewDepthDbRepo(chainId string, db *gorm.DB) depth.Repo {
+	return &depthDbRepoImpl{chainId, db}
+}
+
+// Pools implements depth.Repo
+func (r *depthDbRepoImpl) Pools(ctx context.Context, pairs ...string) ([]depth.Pool, error) {
+	query := `
+select lp.address, lp.asset0, lp.asset0_amount, lp.asset1, lp.asset1_amount, lp.height,
+       ps.liquidity1_in_price
+from latest_pools lp
+    left join pair p on p.chain_id = lp.chain_id and p.contract = lp.address
+    left join lateral (select liquidity1_in_price
+                       from pair_stats_30m
+                       where pair_id = p.id
+                       order by timestamp desc
+                       limit 1) ps on true
+where lp.chain_id = ?
+`
+	args := []interface{}{r.chainId}
+	if len(pairs) > 0 {
+		query += " and lp.address in ?"
+		args = append(args, pairs)
+	}
+
+	models := []depthPool{}
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&models).Error; err != nil {
+		return nil, errors.Wrap(err, "depthDbRepo.Pools")
+	}
+
+	pools := make([]depth.Pool, len(models))
+	for i, model := range models {
+		asset0Amount, ok := math.NewIntFromString(model.Asset0Amount)
+		if !ok {
+			return nil, errors.Errorf("invalid asset0 amount(%s) of pool(%s)", model.Asset0Amount, model.Address)
+		}
+		asset1Amount, ok := math.NewIntFromString(model.Asset1Amount)
+		if !ok {
+			return nil, errors.Errorf("invalid asset1 amount(%s) of pool(%s)", model.Asset1Amount, model.Address)
+		}
+		// a pool without the stats yet is not valued
+		liquidity1InPrice := math.LegacyZeroDec()
+		if model.Liquidity1InPrice != nil {
+			var err error
+			if liquidity1InPrice, err = pkg.NewDecFromStrWithTruncate(*model.Liquidity1InPrice); err != nil {
+				return nil, errors.Wrap(err, "depthDbRepo.Pools")
+			}
+		}
+
+		pools[i] = depth.Pool{
+			Address:           model.Address,
+			Asset0:            model.Asset0,
+			Asset0Amount:      asset0Amount,
+			Asset1:            model.Asset1,
+			Asset1Amount:      asset1Amount,
+			Liquidity1InPrice: liquidity1InPrice,
+			Height:            model.Height,
+		}
+	}
+	return pools, nil
+}
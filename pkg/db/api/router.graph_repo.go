@@ -0,0 +1,215 @@
+package api
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/math"
+	rs "github.com/dezswap/dezswap-api/api/v1/service/router"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// routerGraphRepoImpl computes the routes from the token graph of the pairs instead of the aggregator.
+// The graph is refreshed at most once per refreshInterval, applying only the pairs which became routable or not.
+type routerGraphRepoImpl struct {
+	chainId         string
+	db              *gorm.DB
+	maxHopCount     int
+	minLiquidity    math.Int
+	refreshInterval time.Duration
+
+	mu          sync.RWMutex
+	refreshedAt time.Time
+	// pairs are the routable pairs by the contract
+	pairs map[string]graphPair
+	// edges are the counts of the routable pairs between two assets
+	edges map[string]map[string]int
+}
+
+type graphPair struct {
+	asset0 string
+	asset1 string
+}
+
+type pairReserve struct {
+	Contract     string
+	Asset0       string
+	Asset1       string
+	Asset0Amount *string
+	Asset1Amount *string
+}
+
+func NewRouterGraphRepo(chainId string, db *gorm.DB, maxHopCount int, minLiquidity math.Int, refreshInterval time.Duration) rs.RouterRepo {
+	return &routerGraphRepoImpl{
+		chainId:         chainId,
+		db:              db,
+		maxHopCount:     maxHopCount,
+		minLiquidity:    minLiquidity,
+		refreshInterval: refreshInterval,
+		pairs:           map[string]graphPair{},
+		edges:           map[string]map[string]int{},
+	}
+}
+
+// RoutesOfToken implements RouterRepo.
+func (r *routerGraphRepoImpl) RoutesOfToken(addr string, hopCount int, reverse bool) ([]rs.Route, error) {
+	if err := r.refresh(); err != nil {
+		return nil, errors.Wrap(err, "routerGraphRepo.RoutesOfToken")
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// the graph is undirected, the routes to the token are the reversed routes from it
+	routes := []rs.Route{}
+	r.walk([]string{addr}, r.hopCount(hopCount), func(path []string) {
+		route := rs.Route{To: path[len(path)-1], HopCount: len(path) - 1, Route: path}
+		if reverse {
+			route.Route = slices.Clone(path)
+			slices.Reverse(route.Route)
+		}
+		routes = append(routes, route)
+	})
+	sortRoutes(routes)
+	return routes, nil
+}
+
+// Routes implements RouterRepo.
+func (r *routerGraphRepoImpl) Routes(from string, to string, hopCount int) ([]rs.Route, error) {
+	if err := r.refresh(); err != nil {
+		return nil, errors.Wrap(err, "routerGraphRepo.Routes")
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	routes := []rs.Route{}
+	r.walk([]string{from}, r.hopCount(hopCount), func(path []string) {
+		if path[len(path)-1] == to {
+			routes = append(routes, rs.Route{To: to, HopCount: len(path) - 1, Route: path})
+		}
+	})
+	sortRoutes(routes)
+	return routes, nil
+}
+
+func (r *routerGraphRepoImpl) hopCount(hopCount int) int {
+	return min(hopCount, r.maxHopCount)
+}
+
+// walk visits every simple path extended from the path up to the hop count
+func (r *routerGraphRepoImpl) walk(path []string, hopCount int, visit func(path []string)) {
+	if len(path)-1 >= hopCount {
+		return
+	}
+
+	last := path[len(path)-1]
+	nexts := make([]string, 0, len(r.edges[last]))
+	for next := range r.edges[last] {
+		nexts = append(nexts, next)
+	}
+	sort.Strings(nexts)
+
+	for _, next := range nexts {
+		if slices.Contains(path, next) {
+			continue
+		}
+		extended := append(slices.Clone(path), next)
+		visit(extended)
+		r.walk(extended, hopCount, visit)
+	}
+}
+
+func (r *routerGraphRepoImpl) refresh() error {
+	r.mu.RLock()
+	fresh := !r.refreshedAt.IsZero() && time.Since(r.refreshedAt) < r.refreshInterval
+	r.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	reserves := []pairReserve{}
+	if err := r.db.Table("pair AS p").
+		Joins("LEFT JOIN latest_pools AS lp ON lp.chain_id = p.chain_id AND lp.address = p.contract").
+		Where("p.chain_id = ?", r.chainId).
+		Select("p.contract, p.asset0, p.asset1, lp.asset0_amount, lp.asset1_amount").
+		Scan(&reserves).Error; err != nil {
+		return err
+	}
+
+	routable := make(map[string]graphPair, len(reserves))
+	for _, reserve := range reserves {
+		if r.isLiquid(reserve) {
+			routable[reserve.Contract] = graphPair{reserve.Asset0, reserve.Asset1}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for contract, pair := range r.pairs {
+		if _, ok := routable[contract]; !ok {
+			r.removeEdge(pair)
+			delete(r.pairs, contract)
+		}
+	}
+	for contract, pair := range routable {
+		if _, ok := r.pairs[contract]; !ok {
+			r.addEdge(pair)
+			r.pairs[contract] = pair
+		}
+	}
+	r.refreshedAt = time.Now()
+	return nil
+}
+
+// isLiquid returns whether both reserves of the pool are positive and not less than the minimum liquidity
+func (r *routerGraphRepoImpl) isLiquid(reserve pairReserve) bool {
+	for _, amount := range []*string{reserve.Asset0Amount, reserve.Asset1Amount} {
+		if amount == nil {
+			return false
+		}
+		value, ok := math.NewIntFromString(*amount)
+		if !ok || !value.IsPositive() || value.LT(r.minLiquidity) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *routerGraphRepoImpl) addEdge(pair graphPair) {
+	for _, e := range [][2]string{{pair.asset0, pair.asset1}, {pair.asset1, pair.asset0}} {
+		if r.edges[e[0]] == nil {
+			r.edges[e[0]] = map[string]int{}
+		}
+		r.edges[e[0]][e[1]]++
+	}
+}
+
+func (r *routerGraphRepoImpl) removeEdge(pair graphPair) {
+	for _, e := range [][2]string{{pair.asset0, pair.asset1}, {pair.asset1, pair.asset0}} {
+		r.edges[e[0]][e[1]]--
+		if r.edges[e[0]][e[1]] <= 0 {
+			delete(r.edges[e[0]], e[1])
+		}
+		if len(r.edges[e[0]]) == 0 {
+			delete(r.edges, e[0])
+		}
+	}
+}
+
+// sortRoutes orders the routes as the aggregator does, by the hop count and the destination
+func sortRoutes(routes []rs.Route) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].HopCount != routes[j].HopCount {
+			return routes[i].HopCount < routes[j].HopCount
+		}
+		if routes[i].To != routes[j].To {
+			return routes[i].To < routes[j].To
+		}
+		return strings.Join(routes[i].Route, ",") < strings.Join(routes[j].Route, ",")
+	})
+}
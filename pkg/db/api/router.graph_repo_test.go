@@ -0,0 +1,56 @@
+package api
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/DATA-DOG/go-sqlmock"
+	rs "github.com/dezswap/dezswap-api/api/v1/service/router"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+var reserveColumns = []string{"contract", "asset0", "asset1", "asset0_amount", "asset1_amount"}
+
+func TestRouterGraphRepo(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	require.NoError(t, err)
+	repo := NewRouterGraphRepo("test-chain", gormDB, 2, math.NewInt(100), 0)
+
+	mock.ExpectQuery(`FROM pair AS p LEFT JOIN latest_pools`).WithArgs("test-chain").
+		WillReturnRows(sqlmock.NewRows(reserveColumns).
+			AddRow("pair-ab", "a", "b", "1000", "1000").
+			AddRow("pair-bc", "b", "c", "1000", "1000").
+			AddRow("pair-ac", "a", "c", "1000", "1000").
+			AddRow("pair-cd", "c", "d", "1000", "99").
+			AddRow("pair-de", "d", "e", nil, nil))
+
+	routes, err := repo.Routes("a", "c", 3)
+
+	require.NoError(t, err)
+	require.Equal(t, []rs.Route{
+		{To: "c", HopCount: 1, Route: []string{"a", "c"}},
+		{To: "c", HopCount: 2, Route: []string{"a", "b", "c"}},
+	}, routes)
+
+	// pair-ac is drained and pair-cd is filled
+	mock.ExpectQuery(`FROM pair AS p LEFT JOIN latest_pools`).WithArgs("test-chain").
+		WillReturnRows(sqlmock.NewRows(reserveColumns).
+			AddRow("pair-ab", "a", "b", "1000", "1000").
+			AddRow("pair-bc", "b", "c", "1000", "1000").
+			AddRow("pair-ac", "a", "c", "0", "0").
+			AddRow("pair-cd", "c", "d", "1000", "1000"))
+
+	routes, err = repo.RoutesOfToken("d", 2, true)
+
+	require.NoError(t, err)
+	require.Equal(t, []rs.Route{
+		{To: "c", HopCount: 1, Route: []string{"c", "d"}},
+		{To: "b", HopCount: 2, Route: []string{"b", "c", "d"}},
+	}, routes)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	ss "github.com/dezswap/dezswap-api/api/v1/service/simulate"
+	"github.com/dezswap/dezswap-api/pkg/db/indexer"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type poolDbRepoImpl struct {
+	chainId string
+	db      *gorm.DB
+}
+
+func NewPoolDbRepo(chainId string, db *gorm.DB) ss.PoolRepo {
+	return &poolDbRepoImpl{chainId, db}
+}
+
+// Pool implements simulate.PoolRepo
+func (r *poolDbRepoImpl) Pool(ctx context.Context, assetA, assetB string) (*ss.Pool, error) {
+	models := []indexer.LatestPool{}
+	if err := r.db.WithContext(ctx).Model(&indexer.LatestPool{}).
+		Where("chain_id = ? AND ((asset0 = ? AND asset1 = ?) OR (asset0 = ? AND asset1 = ?))", r.chainId, assetA, assetB, assetB, assetA).
+		Limit(1).
+		Find(&models).Error; err != nil {
+		return nil, errors.Wrap(err, "poolDbRepo.Pool")
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+
+	return latestPoolToPool(models[0])
+}
+
+// PoolOf implements simulate.PoolRepo
+func (r *poolDbRepoImpl) PoolOf(ctx context.Context, pair string) (*ss.Pool, error) {
+	models := []indexer.LatestPool{}
+	if err := r.db.WithContext(ctx).Model(&indexer.LatestPool{}).
+		Where("chain_id = ? AND address = ?", r.chainId, pair).
+		Limit(1).
+		Find(&models).Error; err != nil {
+		return nil, errors.Wrap(err, "poolDbRepo.PoolOf")
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+
+	return latestPoolToPool(models[0])
+}
+
+func latestPoolToPool(model indexer.LatestPool) (*ss.Pool, error) {
+	asset0Amount, ok := math.NewIntFromString(model.Asset0Amount)
+	if !ok {
+		return nil, errors.Errorf("invalid asset0 amount(%s) of pool(%s)", model.Asset0Amount, model.Address)
+	}
+	asset1Amount, ok := math.NewIntFromString(model.Asset1Amount)
+	if !ok {
+		return nil, errors.Errorf("invalid asset1 amount(%s) of pool(%s)", model.Asset1Amount, model.Address)
+	}
+	lpAmount, ok := math.NewIntFromString(model.LpAmount)
+	if !ok {
+		return nil, errors.Errorf("invalid lp amount(%s) of pool(%s)", model.LpAmount, model.Address)
+	}
+
+	return &ss.Pool{
+		Address:      model.Address,
+		Asset0:       model.Asset0,
+		Asset0Amount: asset0Amount,
+		Asset1:       model.Asset1,
+		Asset1Amount: asset1Amount,
+		Lp:           model.Lp,
+		LpAmount:     lpAmount,
+		Height:       model.Height,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package api
+
+import (
+	"github.com/dezswap/dezswap-api/api/v1/service/stream"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+type streamDbRepoImpl struct {
+	chainId string
+	db      *gorm.DB
+}
+
+func NewStreamDbRepo(chainId string, db *gorm.DB) stream.Repo {
+	return &streamDbRepoImpl{chainId, db}
+}
+
+// Cursor implements stream.Repo
+func (r *streamDbRepoImpl) Cursor() (stream.Cursor, error) {
+	query := `
+select coalesce(tx.id, 0) tx_id,
+       coalesce(tx.height, 0) tx_height,
+       coalesce((select max(height) from latest_pools where chain_id = ?), 0) pool_height
+from (select 1) one
+    left join lateral (select id, height
+                       from parsed_tx
+                       where chain_id = ?
+                       order by id desc
+                       limit 1) tx on true
+`
+	cursor := stream.Cursor{}
+	if err := r.db.Raw(query, r.chainId, r.chainId).Scan(&cursor).Error; err != nil {
+		return stream.Cursor{}, errors.Wrap(err, "streamDbRepo.Cursor")
+	}
+	return cursor, nil
+}
+
+// TxsAfter implements stream.Repo
+func (r *streamDbRepoImpl) TxsAfter(afterId uint64, limit int) ([]stream.Tx, error) {
+	txs := []stream.Tx{}
+	if err := r.txs().
+		Where("pt.id > ?", afterId).
+		Order("pt.id").
+		Limit(limit).
+		Scan(&txs).Error; err != nil {
+		return nil, errors.Wrap(err, "streamDbRepo.TxsAfter")
+	}
+	return txs, nil
+}
+
+// TxsBetween implements stream.Repo
+func (r *streamDbRepoImpl) TxsBetween(fromHeight, toHeight, untilId uint64) ([]stream.Tx, error) {
+	txs := []stream.Tx{}
+	if err := r.txs().
+		Where("pt.height BETWEEN ? AND ? AND pt.id <= ?", fromHeight, toHeight, untilId).
+		Order("pt.id").
+		Scan(&txs).Error; err != nil {
+		return nil, errors.Wrap(err, "streamDbRepo.TxsBetween")
+	}
+	return txs, nil
+}
+
+func (r *streamDbRepoImpl) txs() *gorm.DB {
+	return r.db.Table("parsed_tx AS pt").
+		Joins("LEFT JOIN pair AS p ON p.chain_id = pt.chain_id AND p.contract = pt.contract").
+		Joins("LEFT JOIN tokens AS t0 ON t0.chain_id = pt.chain_id AND t0.address = COALESCE(p.asset0, pt.asset0)").
+		Joins("LEFT JOIN tokens AS t1 ON t1.chain_id = pt.chain_id AND t1.address = COALESCE(p.asset1, pt.asset1)").
+		Where("pt.chain_id = ?", r.chainId).
+		Select("pt.id, pt.height, pt.timestamp, pt.hash, pt.type::text AS type, pt.sender, pt.contract AS pair, " +
+			"COALESCE(p.asset0, pt.asset0, '') AS asset0, pt.asset0_amount::text AS asset0_amount, " +
+			"COALESCE(p.asset1, pt.asset1, '') AS asset1, pt.asset1_amount::text AS asset1_amount, " +
+			"COALESCE(t0.decimals, 0) AS asset0_decimals, COALESCE(t1.decimals, 0) AS asset1_decimals")
+}
+
+// PoolsAfter implements stream.Repo
+func (r *streamDbRepoImpl) PoolsAfter(height uint64) ([]stream.Pool, error) {
+	pools := []stream.Pool{}
+	if err := r.db.Table("latest_pools").
+		Where("chain_id = ? AND height > ?", r.chainId, height).
+		Select("address AS pair, asset0, asset0_amount::text AS asset0_amount, asset1, asset1_amount::text AS asset1_amount, height").
+		Order("height, address").
+		Scan(&pools).Error; err != nil {
+		return nil, errors.Wrap(err, "streamDbRepo.PoolsAfter")
+	}
+	return pools, nil
+}
+
+// PoolsBetween implements stream.Repo
+func (r *streamDbRepoImpl) PoolsBetween(fromHeight, toHeight uint64) ([]stream.Pool, error) {
+	pools := []stream.Pool{}
+	if err := r.db.Table("pool_info AS pi").
+		Joins("JOIN pair AS p ON p.chain_id = pi.chain_id AND p.contract = pi.contract").
+		Where("pi.chain_id = ? AND pi.height BETWEEN ? AND ?", r.chainId, fromHeight, toHeight).
+		Select("pi.contract AS pair, p.asset0, pi.asset0_amount::text AS asset0_amount, p.asset1, pi.asset1_amount::text AS asset1_amount, pi.height").
+		Order("pi.height, pi.contract").
+		Scan(&pools).Error; err != nil {
+		return nil, errors.Wrap(err, "streamDbRepo.PoolsBetween")
+	}
+	return pools, nil
+}
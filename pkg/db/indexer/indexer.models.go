@@ -18,6 +18,11 @@ type Token struct {
 	Decimals uint8  `json:"decimals"`
 	Icon     string `json:"icon"`
 	Verified bool   `json:"verified" gorm:"not null;default:false"`
+
+	// origin of an IBC token, empty for the others
+	IbcPath        string `json:"ibcPath"`
+	IbcBaseDenom   string `json:"ibcBaseDenom" gorm:"index"`
+	IbcOriginChain string `json:"ibcOriginChain"`
 }
 
 type LatestPool struct {
@@ -31,3 +36,65 @@ type LatestPool struct {
 	Lp           string `json:"lp" gorm:"index"`
 	LpAmount     string `json:"lpAmount"`
 }
+
+// IndexedHeight keeps the last height processed by an indexer job
+type IndexedHeight struct {
+	*gorm.Model
+	ChainId string `json:"chainId" gorm:"not null;index:,unique,composite:chain_id_name_key"`
+	Name    string `json:"name" gorm:"not null;index:,unique,composite:chain_id_name_key"`
+	Height  uint64 `json:"height" gorm:"not null;default:0"`
+}
+
+type AccountActivity struct {
+	*gorm.Model
+	ChainModel
+	SwapCount       uint64  `json:"swapCount" gorm:"not null;default:0"`
+	ProvideCount    uint64  `json:"provideCount" gorm:"not null;default:0"`
+	WithdrawCount   uint64  `json:"withdrawCount" gorm:"not null;default:0"`
+	Volume          string  `json:"volume" gorm:"type:numeric;not null;default:0"`
+	FirstSeenHeight uint64  `json:"firstSeenHeight" gorm:"not null"`
+	FirstSeenAt     float64 `json:"firstSeenAt" gorm:"not null"`
+	LastSeenHeight  uint64  `json:"lastSeenHeight" gorm:"not null"`
+	LastSeenAt      float64 `json:"lastSeenAt" gorm:"not null;index"`
+}
+
+type AccountPairActivity struct {
+	*gorm.Model
+	ChainId         string  `json:"chainId" gorm:"not null;index:,unique,composite:chain_id_address_pair_key"`
+	Address         string  `json:"address" gorm:"not null;index;index:,unique,composite:chain_id_address_pair_key"`
+	Pair            string  `json:"pair" gorm:"not null;index;index:,unique,composite:chain_id_address_pair_key"`
+	SwapCount       uint64  `json:"swapCount" gorm:"not null;default:0"`
+	ProvideCount    uint64  `json:"provideCount" gorm:"not null;default:0"`
+	WithdrawCount   uint64  `json:"withdrawCount" gorm:"not null;default:0"`
+	Asset0Volume    string  `json:"asset0Volume" gorm:"type:numeric;not null;default:0"`
+	Asset1Volume    string  `json:"asset1Volume" gorm:"type:numeric;not null;default:0"`
+	Volume          string  `json:"volume" gorm:"type:numeric;not null;default:0"`
+	FirstSeenHeight uint64  `json:"firstSeenHeight" gorm:"not null"`
+	FirstSeenAt     float64 `json:"firstSeenAt" gorm:"not null"`
+	LastSeenHeight  uint64  `json:"lastSeenHeight" gorm:"not null"`
+	LastSeenAt      float64 `json:"lastSeenAt" gorm:"not null"`
+}
+
+type LpPosition struct {
+	*gorm.Model
+	ChainId         string  `json:"chainId" gorm:"not null;index:,unique,composite:chain_id_address_pair_key"`
+	Address         string  `json:"address" gorm:"not null;index;index:,unique,composite:chain_id_address_pair_key"`
+	Pair            string  `json:"pair" gorm:"not null;index;index:,unique,composite:chain_id_address_pair_key"`
+	Lp              string  `json:"lp" gorm:"not null"`
+	LpAmount        string  `json:"lpAmount" gorm:"type:numeric;not null;default:0"`
+	Asset0          string  `json:"asset0"`
+	Asset0CostBasis string  `json:"asset0CostBasis" gorm:"type:numeric;not null;default:0"`
+	Asset1          string  `json:"asset1"`
+	Asset1CostBasis string  `json:"asset1CostBasis" gorm:"type:numeric;not null;default:0"`
+	Height          uint64  `json:"height" gorm:"not null"`
+	Timestamp       float64 `json:"timestamp" gorm:"not null"`
+}
+
+type TokenSupply struct {
+	*gorm.Model
+	ChainId           string `json:"chainId" gorm:"not null;index:token_supplies_chain_id_address_height_idx,priority:1"`
+	Address           string `json:"address" gorm:"not null;index:token_supplies_chain_id_address_height_idx,priority:2"`
+	Height            uint64 `json:"height" gorm:"not null;index:token_supplies_chain_id_address_height_idx,priority:3"`
+	TotalSupply       string `json:"totalSupply" gorm:"type:numeric;not null"`
+	CirculatingSupply string `json:"circulatingSupply" gorm:"type:numeric;not null"`
+}
@@ -1,10 +1,29 @@
 package dezswap
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 var (
 	QUERY_POOL  = []byte(`{"pool":{}}`)
 	QUERY_TOKEN = []byte(`{"token_info":{}}`)
 )
 
+func QueryBalance(address string) []byte {
+	return []byte(fmt.Sprintf(`{"balance":{"address":%q}}`, address))
+}
+
+func QuerySimulation(offerAsset AssetInfoRes) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"simulation": map[string]AssetInfoRes{"offer_asset": offerAsset}})
+}
+
+func QueryReverseSimulation(askAsset AssetInfoRes) ([]byte, error) {
+	return json.Marshal(map[string]interface{}{"reverse_simulation": map[string]AssetInfoRes{"ask_asset": askAsset}})
+}
+
 const (
 	SWAP_FEE = 0.003
+	// MINIMUM_LIQUIDITY_AMOUNT is locked in the pair on the first provision
+	MINIMUM_LIQUIDITY_AMOUNT = 1000
 )
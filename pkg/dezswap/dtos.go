@@ -14,11 +14,27 @@ type TokenInfoRes struct {
 	TotalSupply string `json:"total_supply"`
 }
 
+type BalanceRes struct {
+	Balance string `json:"balance"`
+}
+
 type PoolRes struct {
 	Assets     []AssetInfoRes `json:"assets"`
 	TotalShare string         `json:"total_share"`
 }
 
+type SimulationRes struct {
+	ReturnAmount     string `json:"return_amount"`
+	SpreadAmount     string `json:"spread_amount"`
+	CommissionAmount string `json:"commission_amount"`
+}
+
+type ReverseSimulationRes struct {
+	OfferAmount      string `json:"offer_amount"`
+	SpreadAmount     string `json:"spread_amount"`
+	CommissionAmount string `json:"commission_amount"`
+}
+
 type NativeTokenAssetInfoRes struct {
 	Denom string `json:"denom"`
 }
@@ -4,6 +4,8 @@ package pkg
 
 import (
 	"context"
+	"math/big"
+
 	"github.com/dezswap/dezswap-api/pkg/erc20"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -19,6 +21,8 @@ type ERC20Meta struct {
 
 type EthClient interface {
 	QueryErc20Info(ctx context.Context, contractAddr string) (ERC20Meta, error)
+	QueryErc20TotalSupply(ctx context.Context, contractAddr string) (*big.Int, error)
+	QueryErc20Balance(ctx context.Context, contractAddr string, holderAddr string) (*big.Int, error)
 }
 
 type ethClientImpl struct {
@@ -61,3 +65,43 @@ func (c *ethClientImpl) QueryErc20Info(ctx context.Context, contractAddr string)
 
 	return ERC20Meta{Name: name, Symbol: symbol, Decimals: decimals}, nil
 }
+
+// QueryErc20TotalSupply returns the total supply of the ERC-20 contract.
+func (c *ethClientImpl) QueryErc20TotalSupply(ctx context.Context, contractAddr string) (*big.Int, error) {
+	cli, err := ethclient.DialContext(ctx, c.rpcURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "QueryErc20TotalSupply: failed to dial EVM RPC: %s", c.rpcURL)
+	}
+	defer cli.Close()
+
+	client, err := erc20.NewErc20(common.HexToAddress(contractAddr), cli)
+	if err != nil {
+		return nil, errors.Wrapf(err, "QueryErc20TotalSupply: failed to init ERC20 binding (contract=%s)", contractAddr)
+	}
+
+	supply, err := client.TotalSupply(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, errors.Wrapf(err, "QueryErc20TotalSupply: erc20.TotalSupply() call failed (contract=%s)", contractAddr)
+	}
+	return supply, nil
+}
+
+// QueryErc20Balance returns the balance of the holder on the ERC-20 contract.
+func (c *ethClientImpl) QueryErc20Balance(ctx context.Context, contractAddr string, holderAddr string) (*big.Int, error) {
+	cli, err := ethclient.DialContext(ctx, c.rpcURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "QueryErc20Balance: failed to dial EVM RPC: %s", c.rpcURL)
+	}
+	defer cli.Close()
+
+	client, err := erc20.NewErc20(common.HexToAddress(contractAddr), cli)
+	if err != nil {
+		return nil, errors.Wrapf(err, "QueryErc20Balance: failed to init ERC20 binding (contract=%s)", contractAddr)
+	}
+
+	balance, err := client.BalanceOf(&bind.CallOpts{Context: ctx}, common.HexToAddress(holderAddr))
+	if err != nil {
+		return nil, errors.Wrapf(err, "QueryErc20Balance: erc20.BalanceOf() call failed (contract=%s, holder=%s)", contractAddr, holderAddr)
+	}
+	return balance, nil
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/credentials"
@@ -13,15 +14,30 @@ import (
 	cosmwasm_types "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	cosmos_types "github.com/cosmos/cosmos-sdk/types/grpc"
+	bank_types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	ibc_types "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type GrpcClient interface {
 	SyncedHeight() (uint64, error)
+	BlockTime(height uint64) (time.Time, error)
 	QueryContract(addr string, query []byte, height uint64) ([]byte, error)
 	QueryIbcDenomTrace(hash string) (*ibc_types.Denom, error)
+	QuerySupplyOf(denom string) (string, error)
+	QueryBalance(addr string, denom string) (string, error)
+}
+
+// ErrContractQuery is a failure of the contract to the query, which every node fails alike
+var ErrContractQuery = errors.New("contract query failed")
+
+// contractErrors are the messages of the deterministic wasm query errors
+var contractErrors = []string{
+	cosmwasm_types.ErrQueryFailed.Error(),
+	"no such contract",
 }
 
 type grpcClient struct {
@@ -69,19 +85,44 @@ func (c *grpcClient) SyncedHeight() (uint64, error) {
 	return uint64(res.SdkBlock.Header.Height), nil
 }
 
+// BlockTime implements GrpcClient
+func (c *grpcClient) BlockTime(height uint64) (time.Time, error) {
+	client := cmtservice.NewServiceClient(c)
+	ctx, cancel := context.WithTimeout(context.Background(), NodeQueryTimeout)
+	defer cancel()
+
+	res, err := client.GetBlockByHeight(ctx, &cmtservice.GetBlockByHeightRequest{Height: int64(height)})
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "grpcClient.BlockTime")
+	}
+
+	if res.SdkBlock == nil {
+		//nolint:staticcheck // need to read deprecated Block field for legacy chains
+		return res.Block.Header.Time, nil
+	}
+
+	return res.SdkBlock.Header.Time, nil
+}
+
 // QueryContract implements GrpcClient
 func (c *grpcClient) QueryContract(addr string, query []byte, height uint64) ([]byte, error) {
 	client := cosmwasm_types.NewQueryClient(c)
 	ctx := context.Background()
 	if height > 0 {
-		//nolint:staticcheck
-		ctx = context.WithValue(ctx, cosmos_types.GRPCBlockHeightHeader, strconv.FormatUint(height, 10))
+		// the node reads the height of the query from the metadata
+		ctx = metadata.AppendToOutgoingContext(ctx, cosmos_types.GRPCBlockHeightHeader, strconv.FormatUint(height, 10))
 	}
 	ctx, cancel := context.WithTimeout(ctx, NodeQueryTimeout)
 	defer cancel()
 
 	res, err := client.SmartContractState(ctx, &cosmwasm_types.QuerySmartContractStateRequest{Address: addr, QueryData: query})
 	if err != nil {
+		msg := status.Convert(err).Message()
+		for _, contractErr := range contractErrors {
+			if strings.Contains(msg, contractErr) {
+				return nil, errors.Wrap(ErrContractQuery, msg)
+			}
+		}
 		return nil, errors.Wrapf(err, "QueryContract(%s)", addr)
 	}
 
@@ -101,3 +142,34 @@ func (c *grpcClient) QueryIbcDenomTrace(addr string) (*ibc_types.Denom, error) {
 
 	return res.GetDenom(), nil
 }
+
+// QuerySupplyOf implements GrpcClient
+func (c *grpcClient) QuerySupplyOf(denom string) (string, error) {
+	client := bank_types.NewQueryClient(c)
+	ctx, cancel := context.WithTimeout(context.Background(), NodeQueryTimeout)
+	defer cancel()
+
+	res, err := client.SupplyOf(ctx, &bank_types.QuerySupplyOfRequest{Denom: denom})
+	if err != nil {
+		return "", errors.Wrapf(err, "QuerySupplyOf(%s)", denom)
+	}
+
+	return res.Amount.Amount.String(), nil
+}
+
+// QueryBalance implements GrpcClient
+func (c *grpcClient) QueryBalance(addr string, denom string) (string, error) {
+	client := bank_types.NewQueryClient(c)
+	ctx, cancel := context.WithTimeout(context.Background(), NodeQueryTimeout)
+	defer cancel()
+
+	res, err := client.Balance(ctx, &bank_types.QueryBalanceRequest{Address: addr, Denom: denom})
+	if err != nil {
+		return "", errors.Wrapf(err, "QueryBalance(%s, %s)", addr, denom)
+	}
+	if res.Balance == nil {
+		return "0", nil
+	}
+
+	return res.Balance.Amount.String(), nil
+}
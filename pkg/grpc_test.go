@@ -0,0 +1,71 @@
+package pkg
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	cosmos_types "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestGrpcClient serves every call of the client with handler, which takes the incoming metadata of the call
+func newTestGrpcClient(t *testing.T, handler func(md metadata.MD) error) *grpcClient {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		md, _ := metadata.FromIncomingContext(stream.Context())
+		return handler(md)
+	}))
+	go server.Serve(lis) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return &grpcClient{conn}
+}
+
+func TestGrpcClient_QueryContract_Height(t *testing.T) {
+	heights := make(chan []string, 2)
+	client := newTestGrpcClient(t, func(md metadata.MD) error {
+		heights <- md.Get(cosmos_types.GRPCBlockHeightHeader)
+		return status.Error(codes.Unavailable, "unavailable")
+	})
+
+	_, err := client.QueryContract("pair", []byte(`{}`), 100)
+	require.Error(t, err)
+	require.Equal(t, []string{"100"}, <-heights)
+
+	// the latest height is of the node
+	_, err = client.QueryContract("pair", []byte(`{}`), 0)
+	require.Error(t, err)
+	require.Empty(t, <-heights)
+}
+
+func TestGrpcClient_QueryContract_ContractError(t *testing.T) {
+	msg := "Generic error: Operation exceeds max spread limit: query wasm contract failed"
+	client := newTestGrpcClient(t, func(metadata.MD) error {
+		return status.Error(codes.Unknown, msg)
+	})
+	_, err := client.QueryContract("pair", []byte(`{}`), 100)
+	require.ErrorIs(t, err, ErrContractQuery)
+	require.Contains(t, err.Error(), "max spread limit")
+
+	client = newTestGrpcClient(t, func(metadata.MD) error {
+		return status.Error(codes.Unavailable, "connection refused")
+	})
+	_, err = client.QueryContract("pair", []byte(`{}`), 100)
+	require.Error(t, err)
+	require.False(t, errors.Is(err, ErrContractQuery))
+}
@@ -34,3 +34,9 @@ type InternalServerError struct {
 	Code    int    `json:"code" example:"500"`
 	Message string `json:"message" example:"internal server error"`
 }
+
+// HTTPError example
+type BadGatewayError struct {
+	Code    int    `json:"code" example:"502"`
+	Message string `json:"message" example:"bad gateway"`
+}
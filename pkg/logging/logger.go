@@ -1,6 +1,7 @@
 package logging
 
 import (
+	"context"
 	"io"
 	"os"
 
@@ -25,6 +26,17 @@ func AddHookToLogger(l Logger, h logrus.Hook) {
 	l.(*logrus.Entry).Logger.AddHook(h)
 }
 
+// WithContext is the logger of which the entries are of ctx, as with the ids of the trace of the request
+func WithContext(l Logger, ctx context.Context) Logger {
+	switch l := l.(type) {
+	case *logrus.Entry:
+		return l.WithContext(ctx)
+	case *logrus.Logger:
+		return l.WithContext(ctx)
+	}
+	return l
+}
+
 // New creates a new logger with the give name.
 func New(name string, config configs.LogConfig) Logger {
 	logger := logrus.New()
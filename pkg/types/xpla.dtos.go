@@ -31,4 +31,6 @@ type IbcRes struct {
 	Name      *string `json:"name,omitempty"`
 	Icon      *string `json:"icon,omitempty"`
 	Decimals  *uint8  `json:"decimals,omitempty"`
+	// OriginChain is the chain name the asset is from, not given by every asset list
+	OriginChain *string `json:"origin_chain,omitempty"`
 }
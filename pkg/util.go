@@ -12,6 +12,7 @@ import (
 var (
 	ErrUnsupportedNetwork         = errors.New("unsupported network")
 	ErrUnregisteredFactoryAddress = errors.New("unregistered factory address")
+	ErrUnregisteredRouterAddress  = errors.New("unregistered router address")
 )
 
 type NetworkMetadata struct {
@@ -23,11 +24,12 @@ type NetworkMetadata struct {
 	BlockSecond           uint8
 	LatestHeightIndicator uint64
 	factoryAddresses      map[string]string
+	routerAddresses       map[string]string
 }
 
 func NewNetworkMetadata(
 	networkName NetworkName, mainnetPrefix string, testnetPrefix string, addrPrefix string, tokenPrefixes map[types.TokenType]string, blockSecond uint8, latestHeightIndicator uint64,
-	mainnetFactoryAddress, testnetFactoryAddress string) NetworkMetadata {
+	mainnetFactoryAddress, testnetFactoryAddress string, mainnetRouterAddress, testnetRouterAddress string) NetworkMetadata {
 	return NetworkMetadata{
 		networkName,
 		mainnetPrefix,
@@ -40,6 +42,10 @@ func NewNetworkMetadata(
 			mainnetPrefix: mainnetFactoryAddress,
 			testnetPrefix: testnetFactoryAddress,
 		},
+		map[string]string{
+			mainnetPrefix: mainnetRouterAddress,
+			testnetPrefix: testnetRouterAddress,
+		},
 	}
 }
 
@@ -66,6 +72,46 @@ func (i NetworkMetadata) GetFactoryAddress(chainId string) (string, error) {
 	return "", ErrUnsupportedNetwork
 }
 
+// GetRouterAddress returns the router contract of the chain, ErrUnregisteredRouterAddress if it is not registered yet
+func (i NetworkMetadata) GetRouterAddress(chainId string) (string, error) {
+	var addr string
+	switch {
+	case i.IsMainnet(chainId):
+		addr = i.routerAddresses[i.mainnetPrefix]
+	case i.IsTestnet(chainId):
+		addr = i.routerAddresses[i.testnetPrefix]
+	default:
+		return "", ErrUnsupportedNetwork
+	}
+	if addr == "" {
+		return "", ErrUnregisteredRouterAddress
+	}
+	return addr, nil
+}
+
+// WithRouterAddress returns the metadata with the router contract of the chain, which overrides the registered one
+func (i NetworkMetadata) WithRouterAddress(chainId string, addr string) (NetworkMetadata, error) {
+	if !i.IsMainnetOrTestnet(chainId) {
+		return i, ErrUnsupportedNetwork
+	}
+	if !strings.HasPrefix(addr, i.addrPrefix) {
+		return i, errors.Errorf("invalid router address(%s) of %s", addr, chainId)
+	}
+
+	// the addresses are shared with the metadata of the network list
+	routerAddresses := make(map[string]string, len(i.routerAddresses))
+	for k, v := range i.routerAddresses {
+		routerAddresses[k] = v
+	}
+	if i.IsMainnet(chainId) {
+		routerAddresses[i.mainnetPrefix] = addr
+	} else {
+		routerAddresses[i.testnetPrefix] = addr
+	}
+	i.routerAddresses = routerAddresses
+	return i, nil
+}
+
 func (i NetworkMetadata) IsCw20(addr string) bool {
 	if prefix, ok := i.tokenPrefixes[types.TokenTypeCW20]; ok {
 		addr, _ = strings.CutPrefix(addr, prefix)
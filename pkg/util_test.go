@@ -27,6 +27,47 @@ func Test_TruncateDecimal(t *testing.T) {
 	}
 }
 
+func Test_GetRouterAddress(t *testing.T) {
+	networkMetadata := NewNetworkMetadata("xpla", "dimension", "cube", "xpla1", nil, 5, 0, "", "", "", "xpla1router")
+
+	addr, err := networkMetadata.GetRouterAddress("cube_47-5")
+	if err != nil || addr != "xpla1router" {
+		t.Errorf("expected xpla1router but got %s, %v", addr, err)
+	}
+	if _, err := networkMetadata.GetRouterAddress("dimension_37-1"); err != ErrUnregisteredRouterAddress {
+		t.Errorf("expected %v but got %v", ErrUnregisteredRouterAddress, err)
+	}
+	if _, err := networkMetadata.GetRouterAddress("unknown-1"); err != ErrUnsupportedNetwork {
+		t.Errorf("expected %v but got %v", ErrUnsupportedNetwork, err)
+	}
+}
+
 func TestSuite(t *testing.T) {
 	t.Run("TestTruncateDecimal", Test_TruncateDecimal)
 }
+
+func Test_WithRouterAddress(t *testing.T) {
+	networkMetadata := NewNetworkMetadata("xpla", "dimension", "cube", "xpla1", nil, 5, 0, "", "", "", "")
+
+	configured, err := networkMetadata.WithRouterAddress("dimension_37-1", "xpla1router")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if addr, err := configured.GetRouterAddress("dimension_37-1"); err != nil || addr != "xpla1router" {
+		t.Errorf("expected xpla1router but got %s, %v", addr, err)
+	}
+	if _, err := configured.GetRouterAddress("cube_47-5"); err != ErrUnregisteredRouterAddress {
+		t.Errorf("expected %v of the testnet but got %v", ErrUnregisteredRouterAddress, err)
+	}
+	// the metadata of the network stays
+	if _, err := networkMetadata.GetRouterAddress("dimension_37-1"); err != ErrUnregisteredRouterAddress {
+		t.Errorf("expected %v but got %v", ErrUnregisteredRouterAddress, err)
+	}
+
+	if _, err := networkMetadata.WithRouterAddress("dimension_37-1", "fetch1router"); err == nil {
+		t.Errorf("expected an error of the address of another network")
+	}
+	if _, err := networkMetadata.WithRouterAddress("unknown-1", "xpla1router"); err != ErrUnsupportedNetwork {
+		t.Errorf("expected %v but got %v", ErrUnsupportedNetwork, err)
+	}
+}
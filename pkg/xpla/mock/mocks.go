@@ -2,6 +2,9 @@ package mock
 
 import (
 	"context"
+	"math/big"
+	"time"
+
 	ibctypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	"github.com/dezswap/dezswap-api/pkg"
 	"github.com/dezswap/dezswap-api/pkg/types"
@@ -30,12 +33,30 @@ func (g *GrpcClientMock) SyncedHeight() (uint64, error) {
 	return args.Get(0).(uint64), args.Error(1)
 }
 
+// BlockTime implements pkg.GrpcClient
+func (g *GrpcClientMock) BlockTime(height uint64) (time.Time, error) {
+	args := g.MethodCalled("BlockTime", height)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
 // QueryIbcDenomTrace implements pkg.GrpcClient
 func (g *GrpcClientMock) QueryIbcDenomTrace(hash string) (*ibctypes.Denom, error) {
 	args := g.MethodCalled("QueryIbcDenomTrace", hash)
 	return args.Get(0).(*ibctypes.Denom), args.Error(1)
 }
 
+// QuerySupplyOf implements pkg.GrpcClient
+func (g *GrpcClientMock) QuerySupplyOf(denom string) (string, error) {
+	args := g.MethodCalled("QuerySupplyOf", denom)
+	return args.String(0), args.Error(1)
+}
+
+// QueryBalance implements pkg.GrpcClient
+func (g *GrpcClientMock) QueryBalance(addr string, denom string) (string, error) {
+	args := g.MethodCalled("QueryBalance", addr, denom)
+	return args.String(0), args.Error(1)
+}
+
 type EthClientMock struct {
 	*mock.Mock
 }
@@ -53,6 +74,20 @@ func (e EthClientMock) QueryErc20Info(ctx context.Context, contractAddr string)
 	return args.Get(0).(pkg.ERC20Meta), args.Error(1)
 }
 
+// QueryErc20TotalSupply implements pkg.EthClient
+func (e EthClientMock) QueryErc20TotalSupply(ctx context.Context, contractAddr string) (*big.Int, error) {
+	args := e.MethodCalled("QueryErc20TotalSupply", ctx, contractAddr)
+
+	return args.Get(0).(*big.Int), args.Error(1)
+}
+
+// QueryErc20Balance implements pkg.EthClient
+func (e EthClientMock) QueryErc20Balance(ctx context.Context, contractAddr string, holderAddr string) (*big.Int, error) {
+	args := e.MethodCalled("QueryErc20Balance", ctx, contractAddr, holderAddr)
+
+	return args.Get(0).(*big.Int), args.Error(1)
+}
+
 type ClientMock struct {
 	*mock.Mock
 }
@@ -26,13 +26,17 @@ CREATE TABLE tokens (
     name       TEXT,
     decimals   SMALLINT,
     icon       TEXT,
-    verified   BOOLEAN                      DEFAULT FALSE NOT NULL
+    verified   BOOLEAN                      DEFAULT FALSE NOT NULL,
+    ibc_path         TEXT,
+    ibc_base_denom   TEXT,
+    ibc_origin_chain TEXT
 );
 
 CREATE INDEX idx_tokens_address ON tokens (address);
 CREATE INDEX idx_tokens_chain_id ON tokens (chain_id);
 CREATE UNIQUE INDEX idx_tokens_chain_id_address_key ON tokens (chain_id, address);
 CREATE INDEX idx_tokens_deleted_at ON tokens (deleted_at);
+CREATE INDEX idx_tokens_ibc_base_denom ON tokens (ibc_base_denom);
 
 
 CREATE TABLE pair_stats_30m (
@@ -119,3 +123,17 @@ CREATE TABLE pair_stats_recent (
     created_at           DOUBLE PRECISION NOT NULL DEFAULT date_part('epoch'::text, now()),
     modified_at          DOUBLE PRECISION NOT NULL DEFAULT date_part('epoch'::text, now())
 );
+
+CREATE TABLE token_supplies (
+    id                 BIGSERIAL                NOT NULL PRIMARY KEY,
+    created_at         TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at         TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at         TIMESTAMP WITH TIME ZONE,
+    chain_id           TEXT                     NOT NULL,
+    address            TEXT                     NOT NULL,
+    height             BIGINT                   NOT NULL,
+    total_supply       NUMERIC                  NOT NULL,
+    circulating_supply NUMERIC                  NOT NULL
+);
+
+CREATE INDEX token_supplies_chain_id_address_height_idx ON token_supplies (chain_id, address, height);